@@ -5,8 +5,9 @@ INSERT INTO accounts(
 	description,
 	parent_account_id,
 	currency_id,
+	account_status,
 	created_at
-) VALUES($1,$2,$3,$4,$5,$6);
+) VALUES($1,$2,$3,$4,$5,$6,$7);
 
 -- name: CreateAccountBalance :exec
 INSERT INTO accounts_balance(
@@ -35,7 +36,27 @@ SELECT ab.account_id,
 	ab.last_ledger_id,
 	ab.last_movement_id,
 	ab.created_at,
-	ab.updated_at
+	ab.updated_at,
+	ac.account_status,
+	ab.held_amount,
+	COALESCE((
+		SELECT SUM(pme.amount)
+		FROM pending_movement_entries pme,
+			pending_movements pm
+		WHERE pme.from_account_id = ab.account_id
+			AND pm.pending_movement_id = pme.pending_movement_id
+			AND pm.pending_status = 1
+			AND pm.expires_at > now()
+	), 0)::numeric AS reserved_amount,
+	ac.balance_shards,
+	CASE WHEN ac.balance_shards > 0 THEN COALESCE((
+		SELECT SUM(sab.balance)
+		FROM accounts_balance sab,
+			accounts sac
+		WHERE sab.parent_account_id = ab.account_id
+			AND sac.account_id = sab.account_id
+			AND sac.is_balance_shard
+	), 0) ELSE 0 END::numeric AS shards_balance
 FROM accounts_balance ab,
 	accounts ac
 WHERE ab.account_id = ANY($1::varchar[])
@@ -49,6 +70,12 @@ SELECT movement_id,
 FROM movements
 WHERE idempotency_key = $1;
 
+-- name: GetRecordedIdempotencyKeys :many
+-- GetRecordedIdempotencyKeys returns the idempotency keys which movements are already recorded.
+SELECT idempotency_key
+FROM movements
+WHERE idempotency_key = ANY($1::varchar[]);
+
 -- name: CreateMovement :exec
 INSERT INTO movements(
 	movement_id,
@@ -174,3 +201,510 @@ FROM sum_main main_acc,
     child_accounts child_acc
 WHERE main_acc.account_id = child_acc.account_id
 FOR UPDATE;
+
+-- name: GetMovementForUpdate :one
+SELECT * FROM movements
+WHERE movement_id = $1
+FOR UPDATE;
+
+-- name: GetMovementAccountsLedger :many
+SELECT *
+FROM accounts_ledger
+WHERE movement_id = $1
+ORDER BY internal_id;
+
+-- name: SetMovementReversed :exec
+UPDATE movements
+SET reversed_at = $2,
+	reversal_movement_id = $3,
+	updated_at = $4
+WHERE movement_id = $1;
+
+-- name: CreateReversedMovement :exec
+INSERT INTO reversed_movements(
+	movement_id,
+	reversal_movement_id,
+	reversal_reason,
+	created_at
+) VALUES($1,$2,$3,$4);
+
+-- name: GetAccountsBalanceHistoryByMovementID :many
+SELECT *
+FROM accounts_balance_history
+WHERE movement_id = $1
+ORDER BY history_id;
+
+-- name: GetAccountBalanceBeforeTime :one
+SELECT balance
+FROM accounts_balance_history
+WHERE account_id = $1
+	AND created_at < $2
+ORDER BY history_id DESC
+LIMIT 1;
+
+-- name: GetAccountLedgerSummary :one
+SELECT COALESCE(SUM(amount), 0)::numeric AS total_amount,
+	COALESCE(SUM(amount) FILTER (WHERE internal_id <= sqlc.arg(internal_id)), 0)::numeric AS cursor_amount
+FROM accounts_ledger
+WHERE account_id = sqlc.arg(account_id)
+	AND created_at >= sqlc.arg(from_time)
+	AND created_at < sqlc.arg(to_time);
+
+-- name: ListAccountLedger :many
+SELECT *
+FROM accounts_ledger
+WHERE account_id = sqlc.arg(account_id)
+	AND created_at >= sqlc.arg(from_time)
+	AND created_at < sqlc.arg(to_time)
+	AND internal_id > sqlc.arg(internal_id)
+ORDER BY internal_id
+LIMIT sqlc.arg(page_limit);
+
+-- name: GetAccountsBalanceAt :many
+SELECT DISTINCT ON (account_id) account_id,
+	movement_id,
+	ledger_id,
+	balance,
+	created_at
+FROM accounts_balance_history
+WHERE account_id = ANY(sqlc.arg(account_ids)::varchar[])
+	AND created_at <= sqlc.arg(at)
+ORDER BY account_id, history_id DESC;
+
+-- name: ListAccountsBalance :many
+SELECT *
+FROM accounts_balance
+WHERE account_id > $1
+ORDER BY account_id
+LIMIT $2;
+
+-- name: ListAccountLedgerByAccountID :many
+SELECT *
+FROM accounts_ledger
+WHERE account_id = $1
+ORDER BY internal_id;
+
+-- name: ListAccountBalanceHistoryByAccountID :many
+SELECT *
+FROM accounts_balance_history
+WHERE account_id = $1
+ORDER BY history_id;
+
+-- name: ListUnbalancedMovements :many
+SELECT movement_id,
+	currency_id,
+	SUM(amount)::numeric AS total_amount
+FROM accounts_ledger
+GROUP BY movement_id, currency_id
+HAVING SUM(amount) <> 0
+ORDER BY movement_id;
+
+-- name: GetAccountTreeBalances :many
+SELECT *
+FROM accounts_balance
+WHERE account_id = $1
+	OR parent_account_id = $1
+ORDER BY created_at, account_id;
+
+-- name: GetAccountsStatus :many
+SELECT account_id,
+	account_status
+FROM accounts
+WHERE account_id = ANY($1::varchar[]);
+
+-- name: GetAccountStatusForUpdate :one
+-- GetAccountStatusForUpdate locks both accounts and accounts_balance rows of the account. The accounts_balance row is locked
+-- so the status changes wait for the ongoing movements of the account. The accounts row is locked so the status changes wait
+-- for the ongoing movements of the balance shards of the account, see GetBalanceShardsParentStatusForShare.
+SELECT ac.account_id,
+	ac.account_status
+FROM accounts ac,
+	accounts_balance ab
+WHERE ac.account_id = $1
+	AND ab.account_id = ac.account_id
+FOR UPDATE;
+
+-- name: GetBalanceShardsParentStatusForShare :many
+-- GetBalanceShardsParentStatusForShare returns the status of the parent account of the balance shards, as the status of the
+-- balance shards is never changed. The parent accounts are locked with FOR SHARE, so the status of the parent accounts cannot
+-- be changed until the movements of the balance shards are committed.
+SELECT shard.account_id,
+	parent.account_status
+FROM accounts shard,
+	accounts parent
+WHERE shard.account_id = ANY($1::varchar[])
+	AND shard.is_balance_shard
+	AND parent.account_id = shard.parent_account_id
+FOR SHARE OF parent;
+
+-- name: UpdateAccountStatus :exec
+UPDATE accounts
+SET account_status = $1,
+	updated_at = $2
+WHERE account_id = $3;
+
+-- name: CreatePendingMovement :exec
+INSERT INTO pending_movements(
+	pending_movement_id,
+	idempotency_key,
+	pending_status,
+	expires_at,
+	created_at
+) VALUES($1,$2,$3,$4,$5);
+
+-- name: GetPendingMovement :one
+SELECT *
+FROM pending_movements
+WHERE pending_movement_id = $1;
+
+-- name: GetPendingMovementForUpdate :one
+SELECT *
+FROM pending_movements
+WHERE pending_movement_id = $1
+FOR UPDATE;
+
+-- name: GetPendingMovementByIdempotencyKey :one
+SELECT *
+FROM pending_movements
+WHERE idempotency_key = $1;
+
+-- name: GetPendingMovementEntries :many
+SELECT *
+FROM pending_movement_entries
+WHERE pending_movement_id = $1
+ORDER BY movement_sequence;
+
+-- name: UpdatePendingMovementStatus :exec
+UPDATE pending_movements
+SET pending_status = $1,
+	movement_id = $2,
+	updated_at = $3
+WHERE pending_movement_id = $4;
+
+-- name: ExpirePendingMovements :many
+-- ExpirePendingMovements expires the pending movements that are not captured before expires_at. The rows are locked
+-- with SKIP LOCKED so the pending movements being captured or voided are skipped.
+UPDATE pending_movements
+SET pending_status = 4,
+	updated_at = sqlc.arg(expired_at)::timestamptz
+WHERE pending_movement_id IN (
+	SELECT pm.pending_movement_id
+	FROM pending_movements pm
+	WHERE pm.pending_status = 1
+		AND pm.expires_at <= sqlc.arg(expired_at)::timestamptz
+	ORDER BY pm.expires_at
+	LIMIT sqlc.arg(expire_limit)::int
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING pending_movement_id;
+
+-- name: GetAccountsBalanceForPendingMovement :many
+-- GetAccountsBalanceForPendingMovement locks the accounts balance and returns the amount held and reserved by the pending
+-- movements of the accounts.
+SELECT ab.account_id,
+	ab.allow_negative,
+	ab.balance,
+	ab.held_amount,
+	ac.account_status,
+	COALESCE((
+		SELECT SUM(pme.amount)
+		FROM pending_movement_entries pme,
+			pending_movements pm
+		WHERE pme.from_account_id = ab.account_id
+			AND pm.pending_movement_id = pme.pending_movement_id
+			AND pm.pending_status = 1
+			AND pm.expires_at > sqlc.arg(at)::timestamptz
+	), 0)::numeric AS reserved_amount
+FROM accounts_balance ab,
+	accounts ac
+WHERE ab.account_id = ANY(sqlc.arg(account_ids)::varchar[])
+	AND ac.account_id = ab.account_id
+FOR UPDATE OF ab;
+
+-- name: GetAccountBalanceForHold :one
+-- GetAccountBalanceForHold locks the account balance so the available balance of the account cannot be changed while the
+-- hold is placed.
+SELECT ab.account_id,
+	ab.allow_negative,
+	ab.balance,
+	ab.held_amount,
+	ac.account_status,
+	COALESCE((
+		SELECT SUM(pme.amount)
+		FROM pending_movement_entries pme,
+			pending_movements pm
+		WHERE pme.from_account_id = ab.account_id
+			AND pm.pending_movement_id = pme.pending_movement_id
+			AND pm.pending_status = 1
+			AND pm.expires_at > sqlc.arg(at)::timestamptz
+	), 0)::numeric AS reserved_amount
+FROM accounts_balance ab,
+	accounts ac
+WHERE ab.account_id = sqlc.arg(account_id)
+	AND ac.account_id = ab.account_id
+FOR UPDATE OF ab;
+
+-- name: UpdateAccountHeldAmount :exec
+UPDATE accounts_balance
+SET held_amount = held_amount + sqlc.arg(amount)::numeric
+WHERE account_id = sqlc.arg(account_id);
+
+-- name: CreateAccountHold :exec
+INSERT INTO account_holds(
+	hold_id,
+	account_id,
+	client_reference,
+	amount,
+	reason,
+	hold_status,
+	created_at
+) VALUES($1,$2,$3,$4,$5,$6,$7);
+
+-- name: GetAccountHoldByClientReference :one
+SELECT *
+FROM account_holds
+WHERE account_id = $1
+	AND client_reference = $2;
+
+-- name: GetAccountHoldByClientReferenceForUpdate :one
+SELECT *
+FROM account_holds
+WHERE account_id = $1
+	AND client_reference = $2
+FOR UPDATE;
+
+-- name: ReleaseAccountHold :exec
+UPDATE account_holds
+SET hold_status = 2,
+	released_at = sqlc.arg(released_at),
+	updated_at = sqlc.arg(released_at)
+WHERE hold_id = sqlc.arg(hold_id);
+
+-- name: CreateMovementFX :exec
+INSERT INTO movements_fx(
+	movement_id,
+	from_account_id,
+	to_account_id,
+	from_currency_id,
+	to_currency_id,
+	from_amount,
+	to_amount,
+	rate,
+	created_at
+) VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9);
+
+-- name: GetMovementFX :one
+SELECT *
+FROM movements_fx
+WHERE movement_id = $1;
+
+-- name: ListCurrencies :many
+SELECT *
+FROM currencies
+ORDER BY currency_id;
+
+-- name: CreateCurrency :exec
+INSERT INTO currencies(
+	currency_id,
+	currency_code,
+	exponent,
+	rounding_mode,
+	is_active,
+	created_at
+) VALUES($1,$2,$3,$4,$5,$6);
+
+-- name: LockAccountsBalance :many
+-- LockAccountsBalance locks the accounts balance in the order of the account_id, so the locks are always acquired in the same
+-- order by the concurrent batch movements.
+SELECT account_id
+FROM accounts_balance
+WHERE account_id = ANY($1::varchar[])
+ORDER BY account_id
+FOR UPDATE;
+
+-- name: GetAccountForBalanceShards :one
+-- GetAccountForBalanceShards locks the account so the balance shards of the account cannot be created concurrently.
+SELECT ac.account_id,
+	ac.name,
+	ac.description,
+	ac.parent_account_id,
+	ac.currency_id,
+	ac.balance_shards,
+	ab.allow_negative
+FROM accounts ac,
+	accounts_balance ab
+WHERE ac.account_id = $1
+	AND ab.account_id = ac.account_id
+FOR UPDATE OF ac;
+
+-- name: UpdateAccountBalanceShards :exec
+UPDATE accounts
+SET balance_shards = $1,
+	updated_at = $2
+WHERE account_id = $3;
+
+-- name: MarkAccountsBalanceShard :exec
+UPDATE accounts
+SET is_balance_shard = true
+WHERE account_id = ANY($1::varchar[]);
+
+-- name: CreateOutboxEvent :exec
+INSERT INTO ledger_outbox(
+	event_type,
+	aggregate_id,
+	payload,
+	created_at
+) VALUES($1,$2,$3,$4);
+
+-- name: ListOutboxEvents :many
+-- ListOutboxEvents lists the events after the position of (transaction_id, event_id). Only the events of the transactions older
+-- than the oldest running transaction are listed, so the events of the running transactions are never skipped by the relay.
+SELECT event_id,
+	transaction_id,
+	event_type,
+	aggregate_id,
+	payload,
+	created_at
+FROM ledger_outbox
+WHERE (transaction_id, event_id) > (sqlc.arg(transaction_id)::bigint, sqlc.arg(event_id)::bigint)
+	AND transaction_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+ORDER BY transaction_id, event_id
+LIMIT sqlc.arg(limit_size);
+
+-- name: GetOutboxResumeToken :one
+SELECT resume_token
+FROM ledger_outbox_offsets
+WHERE relay_name = $1;
+
+-- name: SetOutboxResumeToken :exec
+INSERT INTO ledger_outbox_offsets(
+	relay_name,
+	resume_token,
+	updated_at
+) VALUES($1,$2,$3)
+ON CONFLICT (relay_name) DO UPDATE
+SET resume_token = EXCLUDED.resume_token,
+	updated_at = EXCLUDED.updated_at;
+
+-- name: ListOutboxResumeTokens :many
+SELECT resume_token
+FROM ledger_outbox_offsets;
+
+-- name: DeleteOutboxEvents :many
+-- DeleteOutboxEvents deletes the events up to the position of (transaction_id, event_id) which are created before the given time,
+-- and returns the id of the deleted events.
+DELETE FROM ledger_outbox
+WHERE event_id IN (
+	SELECT event_id
+	FROM ledger_outbox
+	WHERE (transaction_id, event_id) <= (sqlc.arg(transaction_id)::bigint, sqlc.arg(event_id)::bigint)
+		AND created_at < sqlc.arg(created_before)
+	ORDER BY transaction_id, event_id
+	LIMIT sqlc.arg(limit_size)
+)
+RETURNING event_id;
+
+-- name: GetOutboxLatestPosition :one
+SELECT transaction_id,
+	event_id
+FROM ledger_outbox
+WHERE transaction_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+ORDER BY transaction_id DESC, event_id DESC
+LIMIT 1;
+
+-- name: ListAccountBalanceHistoryFromLedger :many
+SELECT *
+FROM accounts_balance_history
+WHERE account_id = sqlc.arg(account_id)
+	AND history_id >= (
+		SELECT history_id
+		FROM accounts_balance_history
+		WHERE account_id = sqlc.arg(account_id)
+			AND ledger_id = sqlc.arg(ledger_id)
+	)
+ORDER BY history_id;
+
+-- name: CreateScheduledMovement :exec
+INSERT INTO scheduled_movements(
+	schedule_id,
+	idempotency_key,
+	schedule_status,
+	recurrence,
+	occurrence_at,
+	next_run_at,
+	end_at,
+	created_at
+) VALUES($1,$2,$3,$4,$5,$6,$7,$8);
+
+-- name: GetScheduledMovement :one
+SELECT *
+FROM scheduled_movements
+WHERE schedule_id = $1;
+
+-- name: GetScheduledMovementForUpdate :one
+SELECT *
+FROM scheduled_movements
+WHERE schedule_id = $1
+FOR UPDATE;
+
+-- name: GetScheduledMovementByIdempotencyKey :one
+SELECT *
+FROM scheduled_movements
+WHERE idempotency_key = $1;
+
+-- name: GetScheduledMovementEntries :many
+SELECT *
+FROM scheduled_movement_entries
+WHERE schedule_id = $1
+ORDER BY movement_sequence;
+
+-- name: ListScheduledMovements :many
+-- ListScheduledMovements lists the scheduled movements ordered by the schedule_id. The schedule_id is an UUIDv7, so the scheduled
+-- movements are ordered by their creation time. The zero schedule_status lists the scheduled movements of all statuses.
+SELECT *
+FROM scheduled_movements
+WHERE (sqlc.arg(schedule_status)::int = 0 OR schedule_status = sqlc.arg(schedule_status)::int)
+	AND schedule_id > sqlc.arg(after_schedule_id)::varchar
+ORDER BY schedule_id
+LIMIT sqlc.arg(limit_size)::int;
+
+-- name: ClaimDueScheduledMovements :many
+-- ClaimDueScheduledMovements claims the active scheduled movements that are due by moving their next_run_at to claimed_until. The
+-- claimed scheduled movements are not claimed again by the other schedulers until claimed_until is passed, so the occurrence is
+-- retried when the scheduler that claims it stops before the result is recorded.
+UPDATE scheduled_movements
+SET next_run_at = sqlc.arg(claimed_until)::timestamptz
+WHERE schedule_id IN (
+	SELECT sm.schedule_id
+	FROM scheduled_movements sm
+	WHERE sm.schedule_status = 1
+		AND sm.next_run_at <= sqlc.arg(due_at)::timestamptz
+	ORDER BY sm.next_run_at
+	LIMIT sqlc.arg(claim_limit)::int
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: UpdateScheduledMovementRun :one
+-- UpdateScheduledMovementRun records the result of the claimed occurrence. The update is skipped when the scheduled movement is changed
+-- after it is claimed, for example when it is cancelled or claimed by another scheduler after the claim expires.
+UPDATE scheduled_movements
+SET schedule_status = sqlc.arg(schedule_status),
+	occurrence_at = sqlc.arg(occurrence_at),
+	next_run_at = sqlc.arg(next_run_at),
+	run_count = sqlc.arg(run_count),
+	attempts = sqlc.arg(attempts),
+	last_error = sqlc.arg(last_error),
+	last_movement_id = sqlc.arg(last_movement_id),
+	updated_at = sqlc.arg(updated_at)
+WHERE schedule_id = sqlc.arg(schedule_id)
+	AND schedule_status = 1
+	AND run_count = sqlc.arg(claimed_run_count)::int
+	AND attempts = sqlc.arg(claimed_attempts)::int
+RETURNING schedule_id;
+
+-- name: UpdateScheduledMovementStatus :exec
+UPDATE scheduled_movements
+SET schedule_status = $1,
+	updated_at = $2
+WHERE schedule_id = $3;
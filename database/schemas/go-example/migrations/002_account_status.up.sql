@@ -0,0 +1,6 @@
+-- account_status is the lifecycle status of the account.
+--
+-- 1: active, the account can be debited and credited.
+-- 2: frozen, the account can only be credited. Usually used by compliance to stop the money from going out.
+-- 3: closed, the account cannot be debited nor credited.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS "account_status" int NOT NULL DEFAULT 1;
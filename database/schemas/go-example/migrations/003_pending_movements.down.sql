@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_unq_pending_movements_idempotency_key;
+DROP INDEX IF EXISTS idx_pending_movements_expires_at;
+DROP INDEX IF EXISTS idx_pending_movement_entries_from_account_id;
+
+DROP TABLE IF EXISTS pending_movements;
+DROP TABLE IF EXISTS pending_movement_entries;
@@ -0,0 +1,38 @@
+-- pending_movements is used to store the two-phase movements. A pending movement reserves the funds of the source accounts
+-- until it is captured into a movement, voided or expired.
+CREATE TABLE IF NOT EXISTS pending_movements (
+    "pending_movement_id" varchar PRIMARY KEY,
+    "idempotency_key" varchar NOT NULL,
+    -- pending_status is the status of the pending movement.
+    --
+    -- 1: pending, the funds are reserved.
+    -- 2: captured, the pending movement is posted as movement_id.
+    -- 3: voided, the reservation is released.
+    -- 4: expired, the reservation is released as the pending movement is not captured before expires_at.
+    "pending_status" int NOT NULL,
+    -- movement_id is the movement created when the pending movement is captured.
+    "movement_id" varchar,
+    "expires_at" timestamptz NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz
+);
+
+-- pending_movement_entries is the movement entries of the pending movement. The entries are posted as the ledger entries
+-- when the pending movement is captured.
+CREATE TABLE IF NOT EXISTS pending_movement_entries (
+    "pending_movement_id" varchar NOT NULL,
+    "movement_sequence" int NOT NULL,
+    "from_account_id" varchar NOT NULL,
+    "to_account_id" varchar NOT NULL,
+    "currency_id" int NOT NULL,
+    "amount" numeric NOT NULL,
+    "client_id" varchar,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("pending_movement_id", "movement_sequence")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unq_pending_movements_idempotency_key ON pending_movements ("idempotency_key");
+-- idx_pending_movements_expires_at is used to find the pending movements to be expired.
+CREATE INDEX IF NOT EXISTS idx_pending_movements_expires_at ON pending_movements ("expires_at") WHERE pending_status = 1;
+-- idx_pending_movement_entries_from_account_id is used to calculate the reserved amount of an account.
+CREATE INDEX IF NOT EXISTS idx_pending_movement_entries_from_account_id ON pending_movement_entries ("from_account_id");
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_unq_account_holds_client_reference;
+
+DROP TABLE IF EXISTS account_holds;
+
+ALTER TABLE accounts_balance DROP COLUMN IF EXISTS "held_amount";
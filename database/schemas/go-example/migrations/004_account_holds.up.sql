@@ -0,0 +1,23 @@
+-- held_amount is the total amount of the active holds of the account. The held amount cannot be used by the movements,
+-- so the available balance of the account is balance - held_amount.
+ALTER TABLE accounts_balance ADD COLUMN IF NOT EXISTS "held_amount" numeric NOT NULL DEFAULT 0;
+
+-- account_holds is used to store the holds placed on the accounts. For example, card authorizations and dispute holds.
+CREATE TABLE IF NOT EXISTS account_holds (
+    "hold_id" varchar PRIMARY KEY,
+    "account_id" varchar NOT NULL,
+    -- client_reference is the reference of the hold given by the client. The reference is used to release the hold.
+    "client_reference" varchar NOT NULL,
+    "amount" numeric NOT NULL,
+    "reason" text NOT NULL,
+    -- hold_status is the status of the hold.
+    --
+    -- 1: active, the amount is held.
+    -- 2: released, the amount is released back to the available balance.
+    "hold_status" int NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "released_at" timestamptz,
+    "updated_at" timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unq_account_holds_client_reference ON account_holds ("account_id", "client_reference");
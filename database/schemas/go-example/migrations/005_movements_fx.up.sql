@@ -0,0 +1,16 @@
+-- movements_fx is used to store the conversion information of a cross-currency movement. The movement is recorded as two
+-- legs routed through the FX clearing account of each currency, so each currency leg is balanced on its own.
+CREATE TABLE IF NOT EXISTS movements_fx (
+    "movement_id" varchar PRIMARY KEY,
+    "from_account_id" varchar NOT NULL,
+    "to_account_id" varchar NOT NULL,
+    "from_currency_id" int NOT NULL,
+    "to_currency_id" int NOT NULL,
+    -- from_amount is the amount debited from the source account in the source currency.
+    "from_amount" numeric NOT NULL,
+    -- to_amount is the amount credited to the destination account in the destination currency.
+    "to_amount" numeric NOT NULL,
+    -- rate is the conversion rate from the source currency to the destination currency, to_amount = from_amount * rate.
+    "rate" numeric NOT NULL,
+    "created_at" timestamptz NOT NULL
+);
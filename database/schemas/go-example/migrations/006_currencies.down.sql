@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_unq_currencies_currency_code;
+
+DROP TABLE IF EXISTS currencies;
@@ -0,0 +1,29 @@
+-- currencies is used to store the currencies supported by the ledger. The currencies are loaded into the currency registry
+-- at startup, so a new currency can be added without changing the code.
+CREATE TABLE IF NOT EXISTS currencies (
+    -- currency_id is the numeric id of the currency, the id is stored in the accounts.currency_id column.
+    "currency_id" int PRIMARY KEY,
+    -- currency_code is the ISO 4217 code of the currency, for example IDR and USD.
+    "currency_code" varchar NOT NULL,
+    -- exponent is the number of decimal places allowed for the currency.
+    "exponent" int NOT NULL,
+    -- rounding_mode is the rounding mode of the currency.
+    --
+    -- 1: truncate.
+    -- 2: half even.
+    -- 3: half up.
+    "rounding_mode" int NOT NULL,
+    -- is_active marks whether the currency can be used for new accounts.
+    "is_active" boolean NOT NULL,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unq_currencies_currency_code ON currencies ("currency_code");
+
+-- Register the built-in currencies.
+INSERT INTO currencies (currency_id, currency_code, exponent, rounding_mode, is_active, created_at)
+VALUES
+    (1, 'IDR', 0, 1, true, now()),
+    (2, 'USD', 2, 1, true, now())
+ON CONFLICT DO NOTHING;
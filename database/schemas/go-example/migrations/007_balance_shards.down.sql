@@ -0,0 +1,3 @@
+ALTER TABLE accounts DROP COLUMN IF EXISTS "is_balance_shard";
+
+ALTER TABLE accounts DROP COLUMN IF EXISTS "balance_shards";
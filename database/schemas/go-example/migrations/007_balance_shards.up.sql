@@ -0,0 +1,7 @@
+-- balance_shards is the number of the balance shards of the account. The movements of a sharded account are recorded to one of
+-- its balance shards, so the movements don't need to wait for the lock of a single accounts_balance row. The balance shards of
+-- accounts that are not allowed to have negative balance are credit-only, as the balance of a single shard cannot be used to
+-- check the balance of the account. 0 means the account is not sharded.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS "balance_shards" int NOT NULL DEFAULT 0;
+-- is_balance_shard marks the account as the balance shard of its parent account.
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS "is_balance_shard" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS ledger_outbox_offsets;
+
+DROP INDEX IF EXISTS idx_ledger_outbox_position;
+
+DROP TABLE IF EXISTS ledger_outbox;
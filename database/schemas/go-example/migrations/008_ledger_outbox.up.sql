@@ -0,0 +1,24 @@
+-- ledger_outbox stores the events of the ledger. The events are written in the same database transaction with the changes, so
+-- an event is only recorded when the changes are committed. The events are published by the relay to the sinks.
+CREATE TABLE IF NOT EXISTS ledger_outbox (
+    "event_id" bigserial PRIMARY KEY,
+    -- transaction_id is the id of the database transaction writing the event. The relay only reads the events of the finished
+    -- transactions, so an event committed later never has a lower position than the events already published.
+    "transaction_id" bigint NOT NULL DEFAULT pg_current_xact_id()::text::bigint,
+    -- event_type is the type of the event, for example movement_created and account_created.
+    "event_type" varchar NOT NULL,
+    -- aggregate_id is the id of the changed entity, for example the movement_id or the account_id.
+    "aggregate_id" varchar NOT NULL,
+    -- payload is the protobuf encoded event.
+    "payload" bytea NOT NULL,
+    "created_at" timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_ledger_outbox_position ON ledger_outbox ("transaction_id", "event_id");
+
+-- ledger_outbox_offsets stores the resume token of each relay, so the relay continues from the last published event after restart.
+CREATE TABLE IF NOT EXISTS ledger_outbox_offsets (
+    "relay_name" varchar PRIMARY KEY,
+    "resume_token" varchar NOT NULL,
+    "updated_at" timestamptz NOT NULL
+);
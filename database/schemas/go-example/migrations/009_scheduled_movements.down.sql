@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_unq_scheduled_movements_idempotency_key;
+DROP INDEX IF EXISTS idx_scheduled_movements_next_run_at;
+
+DROP TABLE IF EXISTS scheduled_movements;
+DROP TABLE IF EXISTS scheduled_movement_entries;
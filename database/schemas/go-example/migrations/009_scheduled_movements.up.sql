@@ -0,0 +1,47 @@
+-- scheduled_movements stores the movements that are posted in the future, either once at occurrence_at or repeatedly following
+-- the cron expression in the recurrence column. Each occurrence is posted via Transact with the idempotency key derived from the
+-- schedule_id and the occurrence number, so an occurrence is never posted twice.
+CREATE TABLE IF NOT EXISTS scheduled_movements (
+    "schedule_id" varchar PRIMARY KEY,
+    "idempotency_key" varchar NOT NULL,
+    -- schedule_status is the status of the scheduled movement.
+    --
+    -- 1: active, the next occurrence is posted at next_run_at.
+    -- 2: completed, all occurrences are posted.
+    -- 3: cancelled, the remaining occurrences are cancelled.
+    -- 4: failed, the occurrence of a one-off schedule still fails after all attempts.
+    "schedule_status" int NOT NULL,
+    -- recurrence is the five fields cron expression of the recurring schedule, the schedule is a one-off if it is NULL.
+    "recurrence" varchar,
+    -- occurrence_at is the planned time of the current occurrence. The next occurrence is calculated from occurrence_at and not
+    -- from the time it is posted, so a delayed occurrence doesn't shift the schedule.
+    "occurrence_at" timestamptz NOT NULL,
+    -- next_run_at is the time of when the current occurrence is attempted, it is later than occurrence_at when the attempt is retried.
+    "next_run_at" timestamptz NOT NULL,
+    -- end_at is the exclusive end time of the recurring schedule.
+    "end_at" timestamptz,
+    -- run_count is the number of occurrences that are already posted or skipped.
+    "run_count" int NOT NULL DEFAULT 0,
+    -- attempts is the number of failed attempts of the current occurrence.
+    "attempts" int NOT NULL DEFAULT 0,
+    "last_error" varchar,
+    "last_movement_id" varchar,
+    "created_at" timestamptz NOT NULL,
+    "updated_at" timestamptz
+);
+
+-- scheduled_movement_entries is the movement entries posted on each occurrence of the scheduled movement.
+CREATE TABLE IF NOT EXISTS scheduled_movement_entries (
+    "schedule_id" varchar NOT NULL,
+    "movement_sequence" int NOT NULL,
+    "from_account_id" varchar NOT NULL,
+    "to_account_id" varchar NOT NULL,
+    "amount" numeric NOT NULL,
+    "client_id" varchar,
+    "created_at" timestamptz NOT NULL,
+    PRIMARY KEY ("schedule_id", "movement_sequence")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unq_scheduled_movements_idempotency_key ON scheduled_movements ("idempotency_key");
+-- idx_scheduled_movements_next_run_at is used to find the active scheduled movements that are due.
+CREATE INDEX IF NOT EXISTS idx_scheduled_movements_next_run_at ON scheduled_movements ("next_run_at") WHERE schedule_status = 1;
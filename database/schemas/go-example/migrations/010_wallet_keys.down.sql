@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_wallet_accounts_user_id;
+DROP INDEX IF EXISTS idx_unq_wallet_accounts_user_id_wallet_type;
+
+ALTER TABLE wallet_users DROP CONSTRAINT IF EXISTS wallet_users_pkey;
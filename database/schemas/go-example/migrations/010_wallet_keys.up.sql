@@ -0,0 +1,8 @@
+-- A wallet user is only registered once, together with its intermediary and chargeback wallets.
+ALTER TABLE wallet_users ADD CONSTRAINT wallet_users_pkey PRIMARY KEY (user_id);
+
+CREATE INDEX IF NOT EXISTS idx_wallet_accounts_user_id ON wallet_accounts ("user_id");
+-- idx_unq_wallet_accounts_user_id_wallet_type ensures a user only has one intermediary, main and chargeback wallet. The user
+-- can still have more than one savings wallet.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unq_wallet_accounts_user_id_wallet_type ON wallet_accounts ("user_id", "wallet_type")
+WHERE wallet_type IN (1, 10, 1000);
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS idx_unq_wallet_deposits_channel_reference;
+DROP INDEX IF EXISTS idx_unq_wallet_transactions_idempotency_key;
+DROP INDEX IF EXISTS idx_unq_wallet_accounts_system_wallet;
+
+ALTER TABLE wallet_deposits DROP COLUMN IF EXISTS finished_at;
+ALTER TABLE wallet_deposits DROP COLUMN IF EXISTS movement_id;
+ALTER TABLE wallet_deposits DROP COLUMN IF EXISTS channel_name;
+ALTER TABLE wallet_deposits DROP COLUMN IF EXISTS channel_reference;
+ALTER TABLE wallet_deposits DROP COLUMN IF EXISTS deposit_channel;
+ALTER TABLE wallet_deposits DROP COLUMN IF EXISTS deposit_status;
+
+ALTER TABLE wallet_accounts DROP COLUMN IF EXISTS currency_id;
@@ -0,0 +1,55 @@
+-- currency_id is the currency of the wallet, which is the same with the currency of its ledger account. The currency is needed
+-- to find the system wallets as the system has one wallet for each type and currency.
+ALTER TABLE wallet_accounts ADD COLUMN IF NOT EXISTS currency_id int;
+UPDATE wallet_accounts wa
+SET currency_id = a.currency_id
+FROM accounts a
+WHERE a.account_id = wa.ledger_account_id
+    AND wa.currency_id IS NULL;
+ALTER TABLE wallet_accounts ALTER COLUMN currency_id SET NOT NULL;
+
+-- idx_unq_wallet_accounts_system_wallet ensures the system only has one wallet for each type and currency, for example one
+-- deposit wallet for IDR.
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unq_wallet_accounts_system_wallet ON wallet_accounts ("wallet_type", "currency_id")
+WHERE wallet_owner = 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unq_wallet_transactions_idempotency_key ON wallet_transactions ("transaction_type", "idempotency_key");
+
+-- deposit_status is the status of the deposit.
+--
+-- 1: success.
+-- 40: cancelled.
+-- 50: failed.
+ALTER TABLE wallet_deposits ADD COLUMN IF NOT EXISTS deposit_status int;
+-- deposit_channel is where the deposit is coming from.
+--
+-- 1: payment gateway.
+-- 50: banks.
+-- 100: loan product.
+ALTER TABLE wallet_deposits ADD COLUMN IF NOT EXISTS deposit_channel int;
+-- channel_reference is the id of the deposit in the channel, for example the payment id of the payment gateway. The reference
+-- is unique for each channel so the same deposit is never credited twice.
+ALTER TABLE wallet_deposits ADD COLUMN IF NOT EXISTS channel_reference varchar;
+-- channel_name is the name of the payment gateway vendor, the bank or the loan product of the deposit.
+ALTER TABLE wallet_deposits ADD COLUMN IF NOT EXISTS channel_name varchar;
+-- movement_id is the ledger movement that moves the money from the deposit wallet to the user wallet.
+ALTER TABLE wallet_deposits ADD COLUMN IF NOT EXISTS movement_id varchar;
+ALTER TABLE wallet_deposits ADD COLUMN IF NOT EXISTS finished_at timestamptz;
+-- The deposits recorded before the deposit channels are considered as successful bank transfers, and the transaction id is used
+-- as the channel reference as it is already unique.
+UPDATE wallet_deposits
+SET deposit_status = COALESCE(deposit_status, 1),
+    deposit_channel = COALESCE(deposit_channel, 50),
+    channel_reference = COALESCE(channel_reference, transaction_id),
+    channel_name = COALESCE(channel_name, 'unknown'),
+    finished_at = COALESCE(finished_at, updated_at, created_at)
+WHERE deposit_status IS NULL
+    OR deposit_channel IS NULL
+    OR channel_reference IS NULL
+    OR channel_name IS NULL;
+ALTER TABLE wallet_deposits ALTER COLUMN deposit_status SET NOT NULL;
+ALTER TABLE wallet_deposits ALTER COLUMN deposit_channel SET NOT NULL;
+ALTER TABLE wallet_deposits ALTER COLUMN channel_reference SET NOT NULL;
+ALTER TABLE wallet_deposits ALTER COLUMN channel_name SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_unq_wallet_deposits_channel_reference ON wallet_deposits ("deposit_channel", "channel_reference");
@@ -4,6 +4,164 @@ INSERT INTO wallet_accounts(
 	ledger_account_id,
 	user_id,
 	wallet_status,
+	wallet_owner,
 	wallet_type,
+	currency_id,
 	created_at
-) VALUES($1,$2,$3,$4,$5, $6);
+) VALUES($1,$2,$3,$4,$5,$6,$7,$8);
+
+-- name: CreateWalletUser :exec
+INSERT INTO wallet_users(
+	user_id,
+	user_type,
+	user_status,
+	intermediary_wallet_id,
+	chargeback_wallet_id,
+	created_at
+) VALUES($1,$2,$3,$4,$5,$6);
+
+-- name: GetWalletUser :one
+SELECT *
+FROM wallet_users
+WHERE user_id = $1;
+
+-- name: GetWallet :one
+SELECT *
+FROM wallet_accounts
+WHERE wallet_id = $1;
+
+-- name: GetWalletForShare :one
+-- GetWalletForShare returns the wallet and locks it from being updated, so the wallet status cannot be changed until the
+-- transaction is finished.
+SELECT *
+FROM wallet_accounts
+WHERE wallet_id = $1
+FOR SHARE;
+
+-- name: GetUserWalletByType :one
+-- GetUserWalletByType returns the wallet of the user with the type that only allowed once for each user, which are the
+-- intermediary, main and chargeback wallets.
+SELECT *
+FROM wallet_accounts
+WHERE user_id = $1
+	AND wallet_type = $2;
+
+-- name: GetUserWallets :many
+SELECT *
+FROM wallet_accounts
+WHERE user_id = $1
+ORDER BY created_at;
+
+-- name: GetSystemWallet :one
+-- GetSystemWallet returns the wallet owned by the system for the given type and currency, for example the deposit wallet.
+SELECT *
+FROM wallet_accounts
+WHERE wallet_type = $1
+	AND currency_id = $2
+	AND wallet_owner = 1;
+
+-- name: CreateWalletTransaction :exec
+INSERT INTO wallet_transactions(
+	transaction_id,
+	transaction_type,
+	transaction_status,
+	idempotency_key,
+	created_at,
+	finished_at
+) VALUES($1,$2,$3,$4,$5,$6);
+
+-- name: CreateWalletDeposit :exec
+INSERT INTO wallet_deposits(
+	transaction_id,
+	deposit_wallet_id,
+	user_wallet_id,
+	amount,
+	deposit_status,
+	deposit_channel,
+	channel_reference,
+	channel_name,
+	movement_id,
+	created_at,
+	finished_at
+) VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11);
+
+-- name: GetWalletDepositByChannelReference :one
+SELECT *
+FROM wallet_deposits
+WHERE deposit_channel = $1
+	AND channel_reference = $2;
+
+-- name: GetWalletTransactionByIdempotencyKey :one
+SELECT *
+FROM wallet_transactions
+WHERE transaction_type = $1
+	AND idempotency_key = $2;
+
+-- name: UpdateWalletTransactionStatus :exec
+UPDATE wallet_transactions
+SET transaction_status = $1,
+	updated_at = $2,
+	finished_at = $3
+WHERE transaction_id = $4;
+
+-- name: CreateWalletWithdrawal :exec
+INSERT INTO wallet_withdrawals(
+	transaction_id,
+	withdrawal_wallet_id,
+	user_wallet_id,
+	user_intermediary_wallet_id,
+	amount,
+	withdrawal_fee,
+	final_amount,
+	withdrawal_status,
+	withdrawal_channel,
+	withdrawal_via_pg,
+	withdrawal_pg_vendor,
+	created_at
+) VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12);
+
+-- name: CreateWalletBankWithdrawal :exec
+INSERT INTO wallet_bank_withdrawals(
+	transaction_id,
+	bank_name,
+	created_at
+) VALUES($1,$2,$3);
+
+-- name: CreateWalletEwalletWithdrawal :exec
+INSERT INTO wallet_ewallet_withdrawals(
+	transaction_id,
+	ewallet_name,
+	created_at
+) VALUES($1,$2,$3);
+
+-- name: GetWalletWithdrawal :one
+SELECT *
+FROM wallet_withdrawals
+WHERE transaction_id = $1;
+
+-- name: GetWalletWithdrawalForUpdate :one
+SELECT *
+FROM wallet_withdrawals
+WHERE transaction_id = $1
+FOR UPDATE;
+
+-- name: UpdateWalletWithdrawalStatus :exec
+UPDATE wallet_withdrawals
+SET withdrawal_status = $1,
+	updated_at = $2,
+	finished_at = $3
+WHERE transaction_id = $4;
+
+-- name: CreateWalletTransfer :exec
+INSERT INTO wallet_transfers(
+	transaction_id,
+	from_wallet_id,
+	to_wallet_id,
+	amount,
+	created_at
+) VALUES($1,$2,$3,$4,$5);
+
+-- name: GetWalletTransfer :one
+SELECT *
+FROM wallet_transfers
+WHERE transaction_id = $1;
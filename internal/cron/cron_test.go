@@ -0,0 +1,134 @@
+package cron
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{name: "empty", expr: ""},
+		{name: "four fields", expr: "* * * *"},
+		{name: "minute out of range", expr: "60 * * * *"},
+		{name: "day of month zero", expr: "* * 0 * *"},
+		{name: "month out of range", expr: "* * * 13 *"},
+		{name: "day of week out of range", expr: "* * * * 8"},
+		{name: "invalid range", expr: "10-5 * * * *"},
+		{name: "zero step", expr: "*/0 * * * *"},
+		{name: "invalid value", expr: "a * * * *"},
+		{name: "unknown macro", expr: "@every"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.expr)
+			if !errors.Is(err, ErrInvalidExpression) {
+				t.Fatalf("expecting error %v but got %v", ErrInvalidExpression, err)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	// Sunday, 15 December 2024.
+	from := time.Date(2024, 12, 15, 10, 30, 20, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		expr   string
+		from   time.Time
+		expect []time.Time
+	}{
+		{
+			name: "every minute",
+			expr: "* * * * *",
+			from: from,
+			expect: []time.Time{
+				time.Date(2024, 12, 15, 10, 31, 0, 0, time.UTC),
+				time.Date(2024, 12, 15, 10, 32, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "every 15 minutes",
+			expr: "*/15 * * * *",
+			from: from,
+			expect: []time.Time{
+				time.Date(2024, 12, 15, 10, 45, 0, 0, time.UTC),
+				time.Date(2024, 12, 15, 11, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "daily macro",
+			expr: "@daily",
+			from: from,
+			expect: []time.Time{
+				time.Date(2024, 12, 16, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 12, 17, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "monthly crossing the year",
+			expr: "0 9 1 * *",
+			from: from,
+			expect: []time.Time{
+				time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "end of month skips short months",
+			expr: "0 0 31 * *",
+			from: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+			expect: []time.Time{
+				time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 5, 31, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "weekdays with 7 as sunday",
+			expr: "30 8 * * 1-5,7",
+			from: time.Date(2024, 12, 13, 9, 0, 0, 0, time.UTC),
+			expect: []time.Time{
+				time.Date(2024, 12, 15, 8, 30, 0, 0, time.UTC),
+				time.Date(2024, 12, 16, 8, 30, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "day of month or day of week",
+			expr: "0 0 1 * 1",
+			from: from,
+			expect: []time.Time{
+				time.Date(2024, 12, 16, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 12, 23, 0, 0, 0, 0, time.UTC),
+				time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:   "never matches",
+			expr:   "0 0 30 2 *",
+			from:   from,
+			expect: []time.Time{{}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedule, err := Parse(test.expr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			next := test.from
+			for _, expect := range test.expect {
+				next = schedule.Next(next)
+				if !next.Equal(expect) {
+					t.Fatalf("expecting next time %s but got %s", expect, next)
+				}
+			}
+		})
+	}
+}
@@ -3,11 +3,31 @@ package currency
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"sync"
 
 	"github.com/shopspring/decimal"
 )
 
-var ErrCurrencyNotfound = errors.New("currency not found")
+var (
+	ErrCurrencyNotfound = errors.New("currency not found")
+	ErrCurrencyInactive = errors.New("currency is not active")
+	ErrInvalidCurrency  = errors.New("invalid currency")
+)
+
+// RoundingMode is the rounding mode used to round the amount towards the allowed exponent of the currency. The value is persisted
+// in the currencies.rounding_mode column.
+type RoundingMode int32
+
+const (
+	// RoundingModeTruncate truncates the decimal places beyond the exponent, this is the default rounding mode.
+	RoundingModeTruncate RoundingMode = 1
+	// RoundingModeHalfEven rounds half to the nearest even number, also known as banker's rounding.
+	RoundingModeHalfEven RoundingMode = 2
+	// RoundingModeHalfUp rounds half away from zero.
+	RoundingModeHalfUp RoundingMode = 3
+)
 
 // List of name of currencies.
 const (
@@ -25,6 +45,10 @@ type Currency struct {
 	// Exp is the exponent allowed for decimal places for the currency. If the exponent is 0, then no
 	// decimal places are allowed for the currency.
 	Exp int32
+	// RoundingMode is the rounding mode used by Round. Zero value means RoundingModeTruncate.
+	RoundingMode RoundingMode
+	// Active marks whether the currency can be used for new accounts.
+	Active bool
 }
 
 // NewDecimal creates a new decimal from string. The function will automatically normalize/truncates the decimal.
@@ -44,17 +68,140 @@ func (c *Currency) NormalizeDecimal(d decimal.Decimal) decimal.Decimal {
 	return d
 }
 
+// Round rounds the decimal towards the allowed exponent for the currency using the rounding mode of the currency.
+func (c *Currency) Round(d decimal.Decimal) decimal.Decimal {
+	switch c.RoundingMode {
+	case RoundingModeHalfEven:
+		return c.RoundHalfEven(d)
+	case RoundingModeHalfUp:
+		return c.RoundHalfUp(d)
+	default:
+		return c.NormalizeDecimal(d)
+	}
+}
+
+// RoundHalfEven rounds the decimal towards the allowed exponent for the currency, half is rounded to the nearest even number.
+func (c *Currency) RoundHalfEven(d decimal.Decimal) decimal.Decimal {
+	if c.Exp < d.Exponent()*-1 {
+		d = d.RoundBank(c.Exp)
+	}
+	return d
+}
+
+// RoundHalfUp rounds the decimal towards the allowed exponent for the currency, half is rounded away from zero.
+func (c *Currency) RoundHalfUp(d decimal.Decimal) decimal.Decimal {
+	if c.Exp < d.Exponent()*-1 {
+		d = d.Round(c.Exp)
+	}
+	return d
+}
+
+func (c *Currency) validate() error {
+	if c.ID <= 0 {
+		return fmt.Errorf("%w: id must be positive", ErrInvalidCurrency)
+	}
+	if c.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidCurrency)
+	}
+	if c.Exp < 0 {
+		return fmt.Errorf("%w: exponent cannot be negative", ErrInvalidCurrency)
+	}
+	switch c.RoundingMode {
+	case 0, RoundingModeTruncate, RoundingModeHalfEven, RoundingModeHalfUp:
+	default:
+		return fmt.Errorf("%w: unknown rounding mode %d", ErrInvalidCurrency, c.RoundingMode)
+	}
+	return nil
+}
+
 type currencies struct {
+	mu           sync.RWMutex
 	c            []*Currency
 	mappedByID   map[int32]*Currency
 	mappedByName map[string]*Currency
+	// globals maps the name of the currency to the global variable of the currency, so the variable always points to the
+	// registered currency when the currency is replaced.
+	globals map[string]**Currency
 }
 
 func (c *currencies) List() []*Currency {
-	return c.c
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return slices.Clone(c.c)
+}
+
+// Register adds the currency to the registry, or replaces the currency with the same id. The name of the currency must be
+// unique across the registry.
+//
+// Register is safe to be called at runtime, thus it never touches the global variables of the currencies as they are read
+// without the lock. Only Load replaces the global variables.
+func (c *currencies) Register(curr *Currency) error {
+	if err := curr.validate(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.register(curr)
+}
+
+// Load registers the list of currencies to the registry. The function is used to load the currencies stored in the database
+// at startup, the built-in currencies are replaced by the stored ones with the same id. The currencies are either all registered
+// or none of them are registered when any of them is invalid.
+//
+// The global variables of the currencies are replaced along with the registry, so Load must only be called at startup before
+// the variables are used concurrently.
+func (c *currencies) Load(list []*Currency) error {
+	for _, curr := range list {
+		if err := curr.validate(); err != nil {
+			return err
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// Register the currencies to a copy of the registry, and only swap the registry when all currencies are registered.
+	next := &currencies{
+		c:            slices.Clone(c.c),
+		mappedByID:   maps.Clone(c.mappedByID),
+		mappedByName: maps.Clone(c.mappedByName),
+	}
+	for _, curr := range list {
+		if err := next.register(curr); err != nil {
+			return err
+		}
+	}
+	c.c, c.mappedByID, c.mappedByName = next.c, next.mappedByID, next.mappedByName
+	c.refreshGlobals()
+	return nil
+}
+
+// refreshGlobals points the global variables to the registered currencies. The function must be called under the lock.
+func (c *currencies) refreshGlobals() {
+	for name, global := range c.globals {
+		if curr, ok := c.mappedByName[name]; ok {
+			*global = curr
+		}
+	}
+}
+
+func (c *currencies) register(curr *Currency) error {
+	if existing, ok := c.mappedByName[curr.Name]; ok && existing.ID != curr.ID {
+		return fmt.Errorf("%w: name %s is already used by currency with id %d", ErrInvalidCurrency, curr.Name, existing.ID)
+	}
+	if existing, ok := c.mappedByID[curr.ID]; ok {
+		delete(c.mappedByName, existing.Name)
+		idx := slices.Index(c.c, existing)
+		c.c[idx] = curr
+	} else {
+		c.c = append(c.c, curr)
+	}
+	c.mappedByID[curr.ID] = curr
+	c.mappedByName[curr.Name] = curr
+	return nil
 }
 
 func (c *currencies) GetByID(id int32) (*Currency, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	curr, ok := c.mappedByID[id]
 	if !ok {
 		err := fmt.Errorf("%w: with id %d", ErrCurrencyNotfound, id)
@@ -64,6 +211,8 @@ func (c *currencies) GetByID(id int32) (*Currency, error) {
 }
 
 func (c *currencies) GetByName(name string) (curr *Currency, err error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	curr, ok := c.mappedByName[name]
 	if !ok {
 		err := fmt.Errorf("%w: with name %s", ErrCurrencyNotfound, name)
@@ -82,22 +231,33 @@ func (c *currencies) mustByName(name string) *Currency {
 
 // Currencies contains all assets that supported inside the ledger. We use pointer for the asset list because we want
 // to reference the asset to additional maps to index the asset by its id and name.
+//
+// The list below is the built-in currencies, more currencies are loaded from the database at startup via Load.
 var Currencies = currencies{
 	c: []*Currency{
 		{
-			ID:   1,
-			Name: CurrencyNameIDR,
-			Exp:  0,
+			ID:           1,
+			Name:         CurrencyNameIDR,
+			Exp:          0,
+			RoundingMode: RoundingModeTruncate,
+			Active:       true,
 		},
 		{
-			ID:   2,
-			Name: CurrencyNameUSD,
-			Exp:  2,
+			ID:           2,
+			Name:         CurrencyNameUSD,
+			Exp:          2,
+			RoundingMode: RoundingModeTruncate,
+			Active:       true,
 		},
 	},
+	globals: map[string]**Currency{
+		CurrencyNameIDR: &IDR,
+		CurrencyNameUSD: &USD,
+	},
 }
 
-// List of global currencies by name.
+// List of global currencies by name. The variables point to the registered currencies, which are the built-in currencies until
+// the currencies are replaced via Load.
 var (
 	IDR *Currency
 	USD *Currency
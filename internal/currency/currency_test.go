@@ -1,9 +1,11 @@
 package currency
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/shopspring/decimal"
 )
 
 func TestNewDecimal(t *testing.T) {
@@ -97,3 +99,137 @@ func TestCurrency(t *testing.T) {
 		t.Fatal("invalid ussd currency")
 	}
 }
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    RoundingMode
+		inputs  []string
+		expects []string
+	}{
+		{
+			name:    "truncate",
+			mode:    RoundingModeTruncate,
+			inputs:  []string{"1.005", "1.015", "1.019", "-1.019"},
+			expects: []string{"1", "1.01", "1.01", "-1.01"},
+		},
+		{
+			name:    "half even",
+			mode:    RoundingModeHalfEven,
+			inputs:  []string{"1.005", "1.015", "1.019", "-1.015"},
+			expects: []string{"1", "1.02", "1.02", "-1.02"},
+		},
+		{
+			name:    "half up",
+			mode:    RoundingModeHalfUp,
+			inputs:  []string{"1.005", "1.015", "1.014", "-1.005"},
+			expects: []string{"1.01", "1.02", "1.01", "-1.01"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			curr := &Currency{ID: 2, Name: CurrencyNameUSD, Exp: 2, RoundingMode: test.mode}
+			for idx, input := range test.inputs {
+				got := curr.Round(decimal.RequireFromString(input))
+				if got.String() != test.expects[idx] {
+					t.Fatalf("expecting %s but got %s", test.expects[idx], got.String())
+				}
+			}
+		})
+	}
+}
+
+func TestRegister(t *testing.T) {
+	c := currencies{
+		mappedByID:   make(map[int32]*Currency),
+		mappedByName: make(map[string]*Currency),
+	}
+	sgd := &Currency{ID: 3, Name: "SGD", Exp: 2, RoundingMode: RoundingModeHalfEven, Active: true}
+	if err := c.Register(sgd); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetByName("SGD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(sgd, got); diff != "" {
+		t.Fatalf("(-want/+got)\n%s", diff)
+	}
+
+	// Replace the currency with the same id.
+	inactive := &Currency{ID: 3, Name: "SGD", Exp: 2, RoundingMode: RoundingModeHalfEven, Active: false}
+	if err := c.Register(inactive); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.List()) != 1 {
+		t.Fatalf("expecting 1 currency but got %d", len(c.List()))
+	}
+	got, err = c.GetByID(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Active {
+		t.Fatal("expecting inactive currency")
+	}
+
+	// The name must be unique.
+	if err := c.Register(&Currency{ID: 4, Name: "SGD", Exp: 2}); !errors.Is(err, ErrInvalidCurrency) {
+		t.Fatalf("expecting error %v but got %v", ErrInvalidCurrency, err)
+	}
+	if err := c.Register(&Currency{ID: 0, Name: "JPY"}); !errors.Is(err, ErrInvalidCurrency) {
+		t.Fatalf("expecting error %v but got %v", ErrInvalidCurrency, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	builtin := &Currency{ID: 1, Name: CurrencyNameIDR, Exp: 0, RoundingMode: RoundingModeTruncate, Active: true}
+	global := builtin
+	c := currencies{
+		c:            []*Currency{builtin},
+		mappedByID:   map[int32]*Currency{builtin.ID: builtin},
+		mappedByName: map[string]*Currency{builtin.Name: builtin},
+		globals:      map[string]**Currency{CurrencyNameIDR: &global},
+	}
+
+	// The built-in currency is replaced by the loaded currency with the same id, along with its global variable.
+	idr := &Currency{ID: 1, Name: CurrencyNameIDR, Exp: 0, RoundingMode: RoundingModeHalfEven, Active: true}
+	sgd := &Currency{ID: 3, Name: "SGD", Exp: 2, RoundingMode: RoundingModeHalfEven, Active: true}
+	if err := c.Load([]*Currency{idr, sgd}); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetByName(CurrencyNameIDR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != idr || global != idr {
+		t.Fatal("expecting the registry and the global variable to point to the loaded currency")
+	}
+
+	// None of the currencies are registered when one of them cannot be registered.
+	err = c.Load([]*Currency{
+		{ID: 4, Name: "JPY", Exp: 0},
+		{ID: 5, Name: "SGD", Exp: 2},
+	})
+	if !errors.Is(err, ErrInvalidCurrency) {
+		t.Fatalf("expecting error %v but got %v", ErrInvalidCurrency, err)
+	}
+	if _, err := c.GetByID(4); !errors.Is(err, ErrCurrencyNotfound) {
+		t.Fatalf("expecting error %v but got %v", ErrCurrencyNotfound, err)
+	}
+	if len(c.List()) != 2 {
+		t.Fatalf("expecting 2 currencies but got %d", len(c.List()))
+	}
+	if global != idr {
+		t.Fatal("expecting the global variable to be unchanged")
+	}
+
+	// Register is called at runtime, so it must not replace the global variable.
+	halfUp := &Currency{ID: 1, Name: CurrencyNameIDR, Exp: 0, RoundingMode: RoundingModeHalfUp, Active: true}
+	if err := c.Register(halfUp); err != nil {
+		t.Fatal(err)
+	}
+	if global != idr {
+		t.Fatal("expecting the global variable to be unchanged by register")
+	}
+}
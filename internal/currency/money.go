@@ -0,0 +1,228 @@
+package currency
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	moneypb "github.com/studio-asd/go-example/proto/types/money"
+)
+
+var (
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	ErrInvalidMoney     = errors.New("invalid money")
+)
+
+// Money is an amount of money in a specific currency. The arithmetic of Money fails when the currencies of the operands are
+// different, so we cannot accidentally add IDR to USD.
+//
+// The zero value of Money has no currency and is treated as zero in the currency of the other operand.
+type Money struct {
+	amount   decimal.Decimal
+	currency *Currency
+}
+
+// NewMoney creates money of the currency. The amount is not normalized, use ParseMoney or Currency.NormalizeDecimal to ensure
+// the amount is within the exponent of the currency.
+func NewMoney(amount decimal.Decimal, curr *Currency) Money {
+	return Money{amount: amount, currency: curr}
+}
+
+// ParseMoney parses the amount string to money of the currency. The function returns error if the amount has more decimal
+// places than the allowed exponent of the currency.
+func ParseMoney(amount string, curr *Currency) (Money, error) {
+	if curr == nil {
+		return Money{}, fmt.Errorf("%w: currency is required", ErrInvalidMoney)
+	}
+	d, err := decimal.NewFromString(amount)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %v", ErrInvalidMoney, err)
+	}
+	if !d.Equal(curr.NormalizeDecimal(d)) {
+		return Money{}, fmt.Errorf("%w: %s only allows %d decimal places", ErrInvalidMoney, curr.Name, curr.Exp)
+	}
+	return Money{amount: d, currency: curr}, nil
+}
+
+// MoneyFromProto converts the protobuf money to money. The currency is retrieved from the currency registry.
+func MoneyFromProto(m *moneypb.Money) (Money, error) {
+	curr, err := Currencies.GetByID(m.GetCurrencyId())
+	if err != nil {
+		return Money{}, err
+	}
+	return ParseMoney(m.GetAmount(), curr)
+}
+
+// Proto converts the money to protobuf money.
+func (m Money) Proto() *moneypb.Money {
+	var currencyID int32
+	if m.currency != nil {
+		currencyID = m.currency.ID
+	}
+	return &moneypb.Money{
+		CurrencyId: currencyID,
+		Amount:     m.amount.String(),
+	}
+}
+
+func (m Money) Amount() decimal.Decimal {
+	return m.amount
+}
+
+func (m Money) Currency() *Currency {
+	return m.currency
+}
+
+// CurrencyID returns the id of the currency of the money, or zero if the money has no currency.
+func (m Money) CurrencyID() int32 {
+	if m.currency == nil {
+		return 0
+	}
+	return m.currency.ID
+}
+
+// Add returns m + o.
+func (m Money) Add(o Money) (Money, error) {
+	curr, err := m.sameCurrency(o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.amount.Add(o.amount), currency: curr}, nil
+}
+
+// Sub returns m - o.
+func (m Money) Sub(o Money) (Money, error) {
+	curr, err := m.sameCurrency(o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{amount: m.amount.Sub(o.amount), currency: curr}, nil
+}
+
+// Cmp compares m and o and returns -1 if m < o, 0 if m == o and 1 if m > o.
+func (m Money) Cmp(o Money) (int, error) {
+	if _, err := m.sameCurrency(o); err != nil {
+		return 0, err
+	}
+	return m.amount.Cmp(o.amount), nil
+}
+
+// Neg returns -m.
+func (m Money) Neg() Money {
+	return Money{amount: m.amount.Neg(), currency: m.currency}
+}
+
+// Abs returns |m|.
+func (m Money) Abs() Money {
+	return Money{amount: m.amount.Abs(), currency: m.currency}
+}
+
+func (m Money) IsZero() bool {
+	return m.amount.IsZero()
+}
+
+func (m Money) IsNegative() bool {
+	return m.amount.IsNegative()
+}
+
+func (m Money) IsPositive() bool {
+	return m.amount.IsPositive()
+}
+
+// Equal returns true if both money have the same amount and currency.
+func (m Money) Equal(o Money) bool {
+	return m.CurrencyID() == o.CurrencyID() && m.amount.Equal(o.amount)
+}
+
+// Allocate distributes the money based on the ratios. The remainder is distributed one minor unit at a time starting from
+// the first part with non-zero ratio, so the sum of the parts is always equal to the money and parts with zero ratio are always
+// zero.
+func (m Money) Allocate(ratios ...int64) ([]Money, error) {
+	if len(ratios) == 0 {
+		return nil, fmt.Errorf("%w: ratios are required", ErrInvalidMoney)
+	}
+	if m.currency == nil {
+		return nil, fmt.Errorf("%w: cannot allocate money without currency", ErrInvalidMoney)
+	}
+	var total int64
+	for _, ratio := range ratios {
+		if ratio < 0 {
+			return nil, fmt.Errorf("%w: ratio cannot be negative", ErrInvalidMoney)
+		}
+		total += ratio
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("%w: sum of ratios must be positive", ErrInvalidMoney)
+	}
+
+	// Allocate the money in minor units, so we never create amount beyond the exponent of the currency.
+	minorUnits := m.currency.NormalizeDecimal(m.amount).Shift(m.currency.Exp)
+	if !minorUnits.Equal(m.amount.Shift(m.currency.Exp)) {
+		return nil, fmt.Errorf("%w: amount %s exceeds the exponent of %s", ErrInvalidMoney, m.amount, m.currency.Name)
+	}
+	totalRatio := decimal.NewFromInt(total)
+	parts := make([]Money, len(ratios))
+	remainder := minorUnits
+	for idx, ratio := range ratios {
+		share := minorUnits.Mul(decimal.NewFromInt(ratio)).Div(totalRatio).Truncate(0)
+		parts[idx] = Money{amount: share, currency: m.currency}
+		remainder = remainder.Sub(share)
+	}
+	unit := decimal.NewFromInt(1)
+	if remainder.IsNegative() {
+		unit = unit.Neg()
+	}
+	for idx := 0; !remainder.IsZero(); idx = (idx + 1) % len(parts) {
+		if ratios[idx] == 0 {
+			continue
+		}
+		parts[idx].amount = parts[idx].amount.Add(unit)
+		remainder = remainder.Sub(unit)
+	}
+	for idx := range parts {
+		parts[idx].amount = parts[idx].amount.Shift(-m.currency.Exp)
+	}
+	return parts, nil
+}
+
+// Split splits the money into n equal parts. The remainder is distributed one minor unit at a time starting from the first
+// part.
+func (m Money) Split(n int) ([]Money, error) {
+	if n <= 0 {
+		return nil, fmt.Errorf("%w: number of parts must be positive", ErrInvalidMoney)
+	}
+	ratios := make([]int64, n)
+	for idx := range ratios {
+		ratios[idx] = 1
+	}
+	return m.Allocate(ratios...)
+}
+
+// Format formats the amount with the exact decimal places of the currency, for example "10.50" for USD.
+func (m Money) Format() string {
+	if m.currency == nil {
+		return m.amount.String()
+	}
+	return m.amount.StringFixed(m.currency.Exp)
+}
+
+// String returns the currency name and the formatted amount, for example "USD 10.50".
+func (m Money) String() string {
+	if m.currency == nil {
+		return m.Format()
+	}
+	return m.currency.Name + " " + m.Format()
+}
+
+func (m Money) sameCurrency(o Money) (*Currency, error) {
+	switch {
+	case m.currency == nil:
+		return o.currency, nil
+	case o.currency == nil:
+		return m.currency, nil
+	case m.currency.ID != o.currency.ID:
+		return nil, fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency.Name, o.currency.Name)
+	}
+	return m.currency, nil
+}
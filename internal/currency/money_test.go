@@ -0,0 +1,218 @@
+package currency
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	moneypb "github.com/studio-asd/go-example/proto/types/money"
+)
+
+func TestMoneyArithmetic(t *testing.T) {
+	usd := NewMoney(decimal.RequireFromString("10.50"), USD)
+
+	got, err := usd.Add(NewMoney(decimal.RequireFromString("0.25"), USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(NewMoney(decimal.RequireFromString("10.75"), USD)) {
+		t.Fatalf("expecting USD 10.75 but got %s", got)
+	}
+	got, err = got.Sub(NewMoney(decimal.RequireFromString("11"), USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(NewMoney(decimal.RequireFromString("-0.25"), USD)) {
+		t.Fatalf("expecting USD -0.25 but got %s", got)
+	}
+	if !got.Neg().Equal(NewMoney(decimal.RequireFromString("0.25"), USD)) {
+		t.Fatalf("expecting USD 0.25 but got %s", got.Neg())
+	}
+	cmpResult, err := usd.Cmp(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cmpResult != 1 {
+		t.Fatalf("expecting 1 but got %d", cmpResult)
+	}
+
+	// The zero value of money adopts the currency of the other operand.
+	got, err = Money{}.Add(usd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(usd) {
+		t.Fatalf("expecting %s but got %s", usd, got)
+	}
+
+	idr := NewMoney(decimal.NewFromInt(1000), IDR)
+	if _, err := usd.Add(idr); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expecting error %v but got %v", ErrCurrencyMismatch, err)
+	}
+	if _, err := usd.Sub(idr); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expecting error %v but got %v", ErrCurrencyMismatch, err)
+	}
+	if _, err := usd.Cmp(idr); !errors.Is(err, ErrCurrencyMismatch) {
+		t.Fatalf("expecting error %v but got %v", ErrCurrencyMismatch, err)
+	}
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		money   Money
+		ratios  []int64
+		expects []string
+	}{
+		{
+			name:    "usd equal ratios",
+			money:   NewMoney(decimal.RequireFromString("100"), USD),
+			ratios:  []int64{1, 1, 1},
+			expects: []string{"33.34", "33.33", "33.33"},
+		},
+		{
+			name:    "idr uneven ratios",
+			money:   NewMoney(decimal.RequireFromString("1000"), IDR),
+			ratios:  []int64{70, 20, 10},
+			expects: []string{"700", "200", "100"},
+		},
+		{
+			name:    "idr remainder",
+			money:   NewMoney(decimal.RequireFromString("5"), IDR),
+			ratios:  []int64{3, 7},
+			expects: []string{"2", "3"},
+		},
+		{
+			name:    "negative amount",
+			money:   NewMoney(decimal.RequireFromString("-0.05"), USD),
+			ratios:  []int64{1, 1},
+			expects: []string{"-0.03", "-0.02"},
+		},
+		{
+			name:    "zero ratio",
+			money:   NewMoney(decimal.RequireFromString("0.01"), USD),
+			ratios:  []int64{0, 1, 1},
+			expects: []string{"0", "0.01", "0"},
+		},
+		{
+			name:    "zero ratio negative amount",
+			money:   NewMoney(decimal.RequireFromString("-0.05"), USD),
+			ratios:  []int64{1, 0, 1},
+			expects: []string{"-0.03", "0", "-0.02"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parts, err := test.money.Allocate(test.ratios...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var (
+				got []string
+				sum Money
+			)
+			for _, part := range parts {
+				got = append(got, part.Amount().String())
+				sum, err = sum.Add(part)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if diff := cmp.Diff(test.expects, got); diff != "" {
+				t.Fatalf("(-want/+got)\n%s", diff)
+			}
+			if !sum.Equal(test.money) {
+				t.Fatalf("expecting sum of parts %s but got %s", test.money, sum)
+			}
+		})
+	}
+}
+
+func TestMoneySplit(t *testing.T) {
+	parts, err := NewMoney(decimal.RequireFromString("0.10"), USD).Split(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, part := range parts {
+		got = append(got, part.Format())
+	}
+	if diff := cmp.Diff([]string{"0.04", "0.03", "0.03"}, got); diff != "" {
+		t.Fatalf("(-want/+got)\n%s", diff)
+	}
+	if _, err := NewMoney(decimal.NewFromInt(1), USD).Split(0); !errors.Is(err, ErrInvalidMoney) {
+		t.Fatalf("expecting error %v but got %v", ErrInvalidMoney, err)
+	}
+}
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    string
+		currency  *Currency
+		expect    string
+		expectErr error
+	}{
+		{
+			name:     "usd",
+			amount:   "10.5",
+			currency: USD,
+			expect:   "USD 10.50",
+		},
+		{
+			name:     "idr",
+			amount:   "1000",
+			currency: IDR,
+			expect:   "IDR 1000",
+		},
+		{
+			name:      "exceeds exponent",
+			amount:    "10.001",
+			currency:  USD,
+			expectErr: ErrInvalidMoney,
+		},
+		{
+			name:      "invalid amount",
+			amount:    "ten",
+			currency:  USD,
+			expectErr: ErrInvalidMoney,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseMoney(test.amount, test.currency)
+			if !errors.Is(err, test.expectErr) {
+				t.Fatalf("expecting error %v but got %v", test.expectErr, err)
+			}
+			if test.expectErr != nil {
+				return
+			}
+			if got.String() != test.expect {
+				t.Fatalf("expecting %s but got %s", test.expect, got.String())
+			}
+		})
+	}
+}
+
+func TestMoneyProto(t *testing.T) {
+	m := NewMoney(decimal.RequireFromString("10.5"), USD)
+	expect := &moneypb.Money{
+		CurrencyId: USD.ID,
+		Amount:     "10.5",
+	}
+	if diff := cmp.Diff(expect, m.Proto(), protocmp.Transform()); diff != "" {
+		t.Fatalf("(-want/+got)\n%s", diff)
+	}
+	got, err := MoneyFromProto(expect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(m) {
+		t.Fatalf("expecting %s but got %s", m, got)
+	}
+}
@@ -2,7 +2,11 @@ package main
 
 import (
 	"context"
+	"flag"
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/studio-asd/pkg/srun"
 	"gopkg.in/yaml.v3"
@@ -14,14 +18,68 @@ import (
 	"github.com/studio-asd/go-example/server"
 	"github.com/studio-asd/go-example/services/bootstrap"
 	ledgerapi "github.com/studio-asd/go-example/services/ledger/api"
+	"github.com/studio-asd/go-example/services/ledger/outbox"
+	"github.com/studio-asd/go-example/services/ledger/verifier"
 	userapi "github.com/studio-asd/go-example/services/user/api"
+	walletapi "github.com/studio-asd/go-example/services/wallet/api"
+	"github.com/studio-asd/go-example/services/wallet/gateway"
 )
 
 type Config struct {
-	RS resources.Config `yaml:"resources"`
+	RS     resources.Config  `yaml:"resources"`
+	Ledger ledgerapi.Options `yaml:"ledger"`
+	Wallet WalletConfig      `yaml:"wallet"`
+	// LedgerOutbox configures the relays and the retention of the ledger outbox.
+	LedgerOutbox LedgerOutboxConfig `yaml:"ledger_outbox"`
+}
+
+const (
+	// paymentGatewaySimulatorSecretEnv is the environment variable of the payment gateway simulator webhook secret.
+	paymentGatewaySimulatorSecretEnv = "PAYMENT_GATEWAY_SIMULATOR_SECRET"
+	// paymentGatewaySimulatorSampleSecret is the sample secret that used to be shipped with the configuration, it is rejected as
+	// anyone can sign the webhooks with it.
+	paymentGatewaySimulatorSampleSecret = "simulator-secret"
+)
+
+type WalletConfig struct {
+	// PaymentGatewaySimulator enables the in-process payment gateway simulator for local development. The simulator is disabled
+	// when the configuration is empty, and only configured in config.dev.yaml as the webhook endpoint doesn't need authentication.
+	// The webhook secret is read from the PAYMENT_GATEWAY_SIMULATOR_SECRET environment variable.
+	PaymentGatewaySimulator *gateway.SimulatorConfig `yaml:"payment_gateway_simulator"`
+	// DepositBanks are the banks that receive the deposits transferred by the users. The deposits from any bank are accepted when
+	// the banks are not set.
+	DepositBanks []string `yaml:"deposit_banks"`
+}
+
+type LedgerOutboxConfig struct {
+	// Relays publish the events of the outbox to the file sinks. The relays are opt-in, and the events are only pruned after the
+	// retention when there are no relays.
+	Relays []LedgerOutboxRelayConfig `yaml:"relays"`
+	// Retention is the minimum duration of the events kept in the outbox, the default retention is 24 hours.
+	Retention time.Duration `yaml:"retention"`
+	// PruneInterval is the interval to delete the events of the outbox, the default interval is 1 hour.
+	PruneInterval time.Duration `yaml:"prune_interval"`
+}
+
+type LedgerOutboxRelayConfig struct {
+	Name string `yaml:"name"`
+	// File is the path of the file sink, the events are appended to the file as JSON lines.
+	File        string        `yaml:"file"`
+	Interval    time.Duration `yaml:"interval"`
+	BatchSize   int32         `yaml:"batch_size"`
+	ResumeToken string        `yaml:"resume_token"`
 }
 
 func main() {
+	// verify-ledger is a one-off command to verify the ledger integrity, so we don't need to run the whole services.
+	if len(os.Args) > 1 && os.Args[1] == "verify-ledger" {
+		if err := verifyLedger(context.Background(), os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	srun.New(srun.Config{
 		Name: "go_example",
 	}).
@@ -57,18 +115,137 @@ func run(ctx context.Context, runner srun.ServiceRunner) error {
 		return err
 	}
 
-	ledgerAPI := ledgerapi.New(goExamplePG)
+	ledgerAPI := ledgerapi.New(goExamplePG, conf.Ledger)
 	userAPI := userapi.New(userPG)
+	var walletAPI *walletapi.API
+	walletOptions := walletapi.Options{
+		DepositBanks: conf.Wallet.DepositBanks,
+	}
+	if conf.Wallet.PaymentGatewaySimulator != nil {
+		simulatorConfig := *conf.Wallet.PaymentGatewaySimulator
+		simulatorConfig.Secret = os.Getenv(paymentGatewaySimulatorSecretEnv)
+		if simulatorConfig.Secret == "" || simulatorConfig.Secret == paymentGatewaySimulatorSampleSecret {
+			return fmt.Errorf("payment gateway simulator: %s must be set to a secret other than the sample secret", paymentGatewaySimulatorSecretEnv)
+		}
+		// The simulator runs in the same process, so the webhooks are delivered to the wallet api directly.
+		simulatorConfig.Webhook = func(ctx context.Context, vendor string, payload []byte, signature string) error {
+			return walletAPI.HandlePaymentGatewayWebhook(ctx, vendor, payload, signature)
+		}
+		simulator := gateway.NewSimulator(simulatorConfig)
+		// Stop the delayed disbursements on shutdown, so the webhooks are not delivered to the stopped wallet api.
+		go func() {
+			<-ctx.Done()
+			simulator.Close()
+		}()
+		walletOptions.PaymentGateways = append(walletOptions.PaymentGateways, simulator)
+	}
+	walletAPI = walletapi.New(goExamplePG, ledgerAPI, walletOptions)
 	grpcServer := resources.MustGet[*grpcserver.GRPCServer](res.Container(), "main")
 
-	svc := server.New(ledgerAPI, userAPI)
+	svc := server.New(ledgerAPI, userAPI, walletAPI)
 	svc.RegisterAPIServices(grpcServer)
 
+	pruneInterval := conf.LedgerOutbox.PruneInterval
+	if pruneInterval <= 0 {
+		pruneInterval = time.Hour
+	}
+	runnerServices := []srun.ServiceRunnerAware{
+		res,
+		ledgerapi.NewPendingMovementExpirer(ledgerAPI, time.Minute),
+		ledgerapi.NewAccountBalanceWatcher(ledgerAPI, time.Second),
+		ledgerapi.NewMovementScheduler(ledgerAPI, time.Minute),
+		outbox.NewPruner(goExamplePG, outbox.PrunerConfig{
+			Interval:  pruneInterval,
+			Retention: conf.LedgerOutbox.Retention,
+		}),
+	}
+	for _, relayConfig := range conf.LedgerOutbox.Relays {
+		sink, err := outbox.NewFileSink(relayConfig.File)
+		if err != nil {
+			return err
+		}
+		go func() {
+			<-ctx.Done()
+			sink.Close()
+		}()
+		interval := relayConfig.Interval
+		if interval <= 0 {
+			interval = time.Second
+		}
+		runnerServices = append(runnerServices, outbox.NewRelay(goExamplePG, outbox.Config{
+			Name:        relayConfig.Name,
+			Sink:        sink,
+			Interval:    interval,
+			BatchSize:   relayConfig.BatchSize,
+			ResumeToken: relayConfig.ResumeToken,
+		}))
+	}
+
 	return runner.Register(
 		srun.RegisterInitServices(
 			ledgerAPI,
 			userAPI,
+			walletAPI,
 		),
-		srun.RegisterRunnerServices(res),
+		srun.RegisterRunnerServices(runnerServices...),
 	)
 }
+
+// verifyLedger verifies the integrity of the ledger and writes the JSON report to the output. The command returns an error
+// if there are discrepancies found so it can be used inside a scheduled job.
+//
+// Usage: go-example verify-ledger --config=config.yaml --accounts=account_1,account_2 --output=report.json
+func verifyLedger(ctx context.Context, args []string) error {
+	var (
+		configFile string
+		accounts   string
+		output     string
+	)
+	fset := flag.NewFlagSet("verify-ledger", flag.ExitOnError)
+	fset.StringVar(&configFile, "config", "config.yaml", "configuration file")
+	fset.StringVar(&accounts, "accounts", "", "comma separated account ids to verify, all accounts are verified if empty")
+	fset.StringVar(&output, "output", "", "report output file, the report is written to stdout if empty")
+	if err := fset.Parse(args); err != nil {
+		return err
+	}
+
+	conf := Config{}
+	out, err := os.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(out, &conf); err != nil {
+		return err
+	}
+	res, err := resources.New(ctx, conf.RS)
+	if err != nil {
+		return err
+	}
+	goExamplePG := resources.MustGet[pgdb.PostgresDB](res.Container(), "go_example").Primary()
+
+	var params verifier.Params
+	if accounts != "" {
+		params.AccountIDs = strings.Split(accounts, ",")
+	}
+	report, err := verifier.New(goExamplePG).Verify(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	w := os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := report.WriteJSON(w); err != nil {
+		return err
+	}
+	if !report.OK() {
+		return fmt.Errorf("ledger integrity check failed with %d discrepancies", len(report.Discrepancies))
+	}
+	return nil
+}
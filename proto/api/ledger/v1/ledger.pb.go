@@ -23,6 +23,131 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// PendingMovementStatus is the status of a two-phase movement.
+type PendingMovementStatus int32
+
+const (
+	PendingMovementStatus_PENDING_MOVEMENT_STATUS_UNSPECIFIED PendingMovementStatus = 0
+	// PENDING_MOVEMENT_STATUS_PENDING reserves the funds of the source accounts.
+	PendingMovementStatus_PENDING_MOVEMENT_STATUS_PENDING PendingMovementStatus = 1
+	// PENDING_MOVEMENT_STATUS_CAPTURED means the pending movement is posted as a
+	// movement.
+	PendingMovementStatus_PENDING_MOVEMENT_STATUS_CAPTURED PendingMovementStatus = 2
+	// PENDING_MOVEMENT_STATUS_VOIDED means the reserved funds are released.
+	PendingMovementStatus_PENDING_MOVEMENT_STATUS_VOIDED PendingMovementStatus = 3
+	// PENDING_MOVEMENT_STATUS_EXPIRED means the reserved funds are released
+	// because the pending movement is not captured before the expire_time.
+	PendingMovementStatus_PENDING_MOVEMENT_STATUS_EXPIRED PendingMovementStatus = 4
+)
+
+// Enum value maps for PendingMovementStatus.
+var (
+	PendingMovementStatus_name = map[int32]string{
+		0: "PENDING_MOVEMENT_STATUS_UNSPECIFIED",
+		1: "PENDING_MOVEMENT_STATUS_PENDING",
+		2: "PENDING_MOVEMENT_STATUS_CAPTURED",
+		3: "PENDING_MOVEMENT_STATUS_VOIDED",
+		4: "PENDING_MOVEMENT_STATUS_EXPIRED",
+	}
+	PendingMovementStatus_value = map[string]int32{
+		"PENDING_MOVEMENT_STATUS_UNSPECIFIED": 0,
+		"PENDING_MOVEMENT_STATUS_PENDING":     1,
+		"PENDING_MOVEMENT_STATUS_CAPTURED":    2,
+		"PENDING_MOVEMENT_STATUS_VOIDED":      3,
+		"PENDING_MOVEMENT_STATUS_EXPIRED":     4,
+	}
+)
+
+func (x PendingMovementStatus) Enum() *PendingMovementStatus {
+	p := new(PendingMovementStatus)
+	*p = x
+	return p
+}
+
+func (x PendingMovementStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PendingMovementStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ledger_v1_ledger_proto_enumTypes[0].Descriptor()
+}
+
+func (PendingMovementStatus) Type() protoreflect.EnumType {
+	return &file_api_ledger_v1_ledger_proto_enumTypes[0]
+}
+
+func (x PendingMovementStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PendingMovementStatus.Descriptor instead.
+func (PendingMovementStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{0}
+}
+
+// ScheduledMovementStatus is the status of a scheduled movement.
+type ScheduledMovementStatus int32
+
+const (
+	ScheduledMovementStatus_SCHEDULED_MOVEMENT_STATUS_UNSPECIFIED ScheduledMovementStatus = 0
+	// SCHEDULED_MOVEMENT_STATUS_ACTIVE means the next occurrence is posted at
+	// the next_occurrence_time.
+	ScheduledMovementStatus_SCHEDULED_MOVEMENT_STATUS_ACTIVE ScheduledMovementStatus = 1
+	// SCHEDULED_MOVEMENT_STATUS_COMPLETED means all occurrences are posted.
+	ScheduledMovementStatus_SCHEDULED_MOVEMENT_STATUS_COMPLETED ScheduledMovementStatus = 2
+	// SCHEDULED_MOVEMENT_STATUS_CANCELLED means the remaining occurrences are
+	// cancelled.
+	ScheduledMovementStatus_SCHEDULED_MOVEMENT_STATUS_CANCELLED ScheduledMovementStatus = 3
+	// SCHEDULED_MOVEMENT_STATUS_FAILED means the one-off scheduled movement
+	// still fails to be posted after all attempts.
+	ScheduledMovementStatus_SCHEDULED_MOVEMENT_STATUS_FAILED ScheduledMovementStatus = 4
+)
+
+// Enum value maps for ScheduledMovementStatus.
+var (
+	ScheduledMovementStatus_name = map[int32]string{
+		0: "SCHEDULED_MOVEMENT_STATUS_UNSPECIFIED",
+		1: "SCHEDULED_MOVEMENT_STATUS_ACTIVE",
+		2: "SCHEDULED_MOVEMENT_STATUS_COMPLETED",
+		3: "SCHEDULED_MOVEMENT_STATUS_CANCELLED",
+		4: "SCHEDULED_MOVEMENT_STATUS_FAILED",
+	}
+	ScheduledMovementStatus_value = map[string]int32{
+		"SCHEDULED_MOVEMENT_STATUS_UNSPECIFIED": 0,
+		"SCHEDULED_MOVEMENT_STATUS_ACTIVE":      1,
+		"SCHEDULED_MOVEMENT_STATUS_COMPLETED":   2,
+		"SCHEDULED_MOVEMENT_STATUS_CANCELLED":   3,
+		"SCHEDULED_MOVEMENT_STATUS_FAILED":      4,
+	}
+)
+
+func (x ScheduledMovementStatus) Enum() *ScheduledMovementStatus {
+	p := new(ScheduledMovementStatus)
+	*p = x
+	return p
+}
+
+func (x ScheduledMovementStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledMovementStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_ledger_v1_ledger_proto_enumTypes[1].Descriptor()
+}
+
+func (ScheduledMovementStatus) Type() protoreflect.EnumType {
+	return &file_api_ledger_v1_ledger_proto_enumTypes[1]
+}
+
+func (x ScheduledMovementStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledMovementStatus.Descriptor instead.
+func (ScheduledMovementStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{1}
+}
+
 type MovementEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	FromAccountId string                 `protobuf:"bytes,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
@@ -235,40 +360,31 @@ func (x *TransactResponse) GetTransactTime() *timestamppb.Timestamp {
 	return nil
 }
 
-type TransactResponse_Balance struct {
+type TransactBatchResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// account_id is the affected account_id for the balance output of the
-	// transaction.
-	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	// ledger_id is the new ledger_id for the new balance.
-	LedgerId string `protobuf:"bytes,2,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
-	// new_balance is the latest balance after transaction.
-	NewBalance string `protobuf:"bytes,3,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
-	// previous_balance is the previous locked balance before transaction is
-	// completed.
-	PreviousBalance string `protobuf:"bytes,4,opt,name=previous_balance,json=previousBalance,proto3" json:"previous_balance,omitempty"`
-	// previous_ledger_id is the ledger_id for the previous_balance.
-	PreviousLedgerId string `protobuf:"bytes,5,opt,name=previous_ledger_id,json=previousLedgerId,proto3" json:"previous_ledger_id,omitempty"`
-	// previous_movement is the movement_id for the previous balance.
-	PreviousMovementId string `protobuf:"bytes,6,opt,name=previous_movement_id,json=previousMovementId,proto3" json:"previous_movement_id,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// results is the result of each transact request ordered by the index of
+	// the request in the stream.
+	Results       []*TransactBatchResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Succeeded     int32                           `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int32                           `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransactResponse_Balance) Reset() {
-	*x = TransactResponse_Balance{}
+func (x *TransactBatchResponse) Reset() {
+	*x = TransactBatchResponse{}
 	mi := &file_api_ledger_v1_ledger_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactResponse_Balance) String() string {
+func (x *TransactBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactResponse_Balance) ProtoMessage() {}
+func (*TransactBatchResponse) ProtoMessage() {}
 
-func (x *TransactResponse_Balance) ProtoReflect() protoreflect.Message {
+func (x *TransactBatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_ledger_v1_ledger_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -280,77 +396,207 @@ func (x *TransactResponse_Balance) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactResponse_Balance.ProtoReflect.Descriptor instead.
-func (*TransactResponse_Balance) Descriptor() ([]byte, []int) {
-	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{2, 0}
+// Deprecated: Use TransactBatchResponse.ProtoReflect.Descriptor instead.
+func (*TransactBatchResponse) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *TransactResponse_Balance) GetAccountId() string {
+func (x *TransactBatchResponse) GetResults() []*TransactBatchResponse_Result {
 	if x != nil {
-		return x.AccountId
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *TransactResponse_Balance) GetLedgerId() string {
+func (x *TransactBatchResponse) GetSucceeded() int32 {
 	if x != nil {
-		return x.LedgerId
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *TransactBatchResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type ReverseMovementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// movement_id is the id of the movement to be reversed. A movement can only
+	// be reversed once.
+	MovementId string `protobuf:"bytes,1,opt,name=movement_id,json=movementId,proto3" json:"movement_id,omitempty"`
+	// reversal_reason is the reason on why the movement is being reversed. The
+	// reason is recorded for audit purposes.
+	ReversalReason string `protobuf:"bytes,2,opt,name=reversal_reason,json=reversalReason,proto3" json:"reversal_reason,omitempty"`
+	// idempotency_key is used as the idempotency key of the reversal movement.
+	// The retried request with the same idempotency_key returns the recorded
+	// reversal.
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReverseMovementRequest) Reset() {
+	*x = ReverseMovementRequest{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseMovementRequest) ProtoMessage() {}
+
+func (x *ReverseMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseMovementRequest.ProtoReflect.Descriptor instead.
+func (*ReverseMovementRequest) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReverseMovementRequest) GetMovementId() string {
+	if x != nil {
+		return x.MovementId
 	}
 	return ""
 }
 
-func (x *TransactResponse_Balance) GetNewBalance() string {
+func (x *ReverseMovementRequest) GetReversalReason() string {
 	if x != nil {
-		return x.NewBalance
+		return x.ReversalReason
 	}
 	return ""
 }
 
-func (x *TransactResponse_Balance) GetPreviousBalance() string {
+func (x *ReverseMovementRequest) GetIdempotencyKey() string {
 	if x != nil {
-		return x.PreviousBalance
+		return x.IdempotencyKey
 	}
 	return ""
 }
 
-func (x *TransactResponse_Balance) GetPreviousLedgerId() string {
+type ReverseMovementResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// movement_id is the id of the reversed movement.
+	MovementId string `protobuf:"bytes,1,opt,name=movement_id,json=movementId,proto3" json:"movement_id,omitempty"`
+	// reversal_movement_id is the id of the new movement that reverses the
+	// movement_id. Every ledger entry of the reversal movement points back to the
+	// reversed ledger entry.
+	ReversalMovementId string                          `protobuf:"bytes,2,opt,name=reversal_movement_id,json=reversalMovementId,proto3" json:"reversal_movement_id,omitempty"`
+	LedgerEntries      []*TransactResponse_LedgerEntry `protobuf:"bytes,3,rep,name=ledger_entries,json=ledgerEntries,proto3" json:"ledger_entries,omitempty"`
+	EndingBalances     []*TransactResponse_Balance     `protobuf:"bytes,4,rep,name=ending_balances,json=endingBalances,proto3" json:"ending_balances,omitempty"`
+	ReversedAt         *timestamppb.Timestamp          `protobuf:"bytes,10,opt,name=reversed_at,json=reversedAt,proto3" json:"reversed_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ReverseMovementResponse) Reset() {
+	*x = ReverseMovementResponse{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReverseMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReverseMovementResponse) ProtoMessage() {}
+
+func (x *ReverseMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[5]
 	if x != nil {
-		return x.PreviousLedgerId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReverseMovementResponse.ProtoReflect.Descriptor instead.
+func (*ReverseMovementResponse) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReverseMovementResponse) GetMovementId() string {
+	if x != nil {
+		return x.MovementId
 	}
 	return ""
 }
 
-func (x *TransactResponse_Balance) GetPreviousMovementId() string {
+func (x *ReverseMovementResponse) GetReversalMovementId() string {
 	if x != nil {
-		return x.PreviousMovementId
+		return x.ReversalMovementId
 	}
 	return ""
 }
 
-type TransactResponse_LedgerEntry struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	LedgerId         string                 `protobuf:"bytes,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
-	ClientId         string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	MovementSequence int32                  `protobuf:"varint,3,opt,name=movement_sequence,json=movementSequence,proto3" json:"movement_sequence,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *ReverseMovementResponse) GetLedgerEntries() []*TransactResponse_LedgerEntry {
+	if x != nil {
+		return x.LedgerEntries
+	}
+	return nil
 }
 
-func (x *TransactResponse_LedgerEntry) Reset() {
-	*x = TransactResponse_LedgerEntry{}
-	mi := &file_api_ledger_v1_ledger_proto_msgTypes[4]
+func (x *ReverseMovementResponse) GetEndingBalances() []*TransactResponse_Balance {
+	if x != nil {
+		return x.EndingBalances
+	}
+	return nil
+}
+
+func (x *ReverseMovementResponse) GetReversedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReversedAt
+	}
+	return nil
+}
+
+type AuthorizeMovementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// idempotency_key is used as the idempotency key of the pending movement.
+	// The captured movement uses the pending_movement_id as its idempotency key.
+	IdempotencyKey string `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// expire_time is the time of when the reserved funds are automatically
+	// released if the pending movement is not captured.
+	ExpireTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	MovementEntries []*MovementEntry       `protobuf:"bytes,20,rep,name=movement_entries,json=movementEntries,proto3" json:"movement_entries,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AuthorizeMovementRequest) Reset() {
+	*x = AuthorizeMovementRequest{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransactResponse_LedgerEntry) String() string {
+func (x *AuthorizeMovementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransactResponse_LedgerEntry) ProtoMessage() {}
+func (*AuthorizeMovementRequest) ProtoMessage() {}
 
-func (x *TransactResponse_LedgerEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_api_ledger_v1_ledger_proto_msgTypes[4]
+func (x *AuthorizeMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -361,30 +607,1240 @@ func (x *TransactResponse_LedgerEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransactResponse_LedgerEntry.ProtoReflect.Descriptor instead.
-func (*TransactResponse_LedgerEntry) Descriptor() ([]byte, []int) {
-	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{2, 1}
+// Deprecated: Use AuthorizeMovementRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizeMovementRequest) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *TransactResponse_LedgerEntry) GetLedgerId() string {
+func (x *AuthorizeMovementRequest) GetIdempotencyKey() string {
 	if x != nil {
-		return x.LedgerId
+		return x.IdempotencyKey
 	}
 	return ""
 }
 
-func (x *TransactResponse_LedgerEntry) GetClientId() string {
+func (x *AuthorizeMovementRequest) GetExpireTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ClientId
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *AuthorizeMovementRequest) GetMovementEntries() []*MovementEntry {
+	if x != nil {
+		return x.MovementEntries
+	}
+	return nil
+}
+
+type AuthorizeMovementResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// pending_movement_id is the identifier to capture or void the pending
+	// movement.
+	PendingMovementId string                 `protobuf:"bytes,1,opt,name=pending_movement_id,json=pendingMovementId,proto3" json:"pending_movement_id,omitempty"`
+	Status            PendingMovementStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=go_example.api.ledger.v1.PendingMovementStatus" json:"status,omitempty"`
+	ExpireTime        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	AuthorizeTime     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=authorize_time,json=authorizeTime,proto3" json:"authorize_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AuthorizeMovementResponse) Reset() {
+	*x = AuthorizeMovementResponse{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizeMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeMovementResponse) ProtoMessage() {}
+
+func (x *AuthorizeMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeMovementResponse.ProtoReflect.Descriptor instead.
+func (*AuthorizeMovementResponse) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AuthorizeMovementResponse) GetPendingMovementId() string {
+	if x != nil {
+		return x.PendingMovementId
 	}
 	return ""
 }
 
-func (x *TransactResponse_LedgerEntry) GetMovementSequence() int32 {
+func (x *AuthorizeMovementResponse) GetStatus() PendingMovementStatus {
 	if x != nil {
-		return x.MovementSequence
+		return x.Status
 	}
-	return 0
+	return PendingMovementStatus_PENDING_MOVEMENT_STATUS_UNSPECIFIED
+}
+
+func (x *AuthorizeMovementResponse) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *AuthorizeMovementResponse) GetAuthorizeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AuthorizeTime
+	}
+	return nil
+}
+
+type CaptureMovementRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PendingMovementId string                 `protobuf:"bytes,1,opt,name=pending_movement_id,json=pendingMovementId,proto3" json:"pending_movement_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CaptureMovementRequest) Reset() {
+	*x = CaptureMovementRequest{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureMovementRequest) ProtoMessage() {}
+
+func (x *CaptureMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureMovementRequest.ProtoReflect.Descriptor instead.
+func (*CaptureMovementRequest) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CaptureMovementRequest) GetPendingMovementId() string {
+	if x != nil {
+		return x.PendingMovementId
+	}
+	return ""
+}
+
+type CaptureMovementResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PendingMovementId string                 `protobuf:"bytes,1,opt,name=pending_movement_id,json=pendingMovementId,proto3" json:"pending_movement_id,omitempty"`
+	// movement_id is the id of the movement posted from the pending movement.
+	MovementId     string                          `protobuf:"bytes,2,opt,name=movement_id,json=movementId,proto3" json:"movement_id,omitempty"`
+	LedgerEntries  []*TransactResponse_LedgerEntry `protobuf:"bytes,3,rep,name=ledger_entries,json=ledgerEntries,proto3" json:"ledger_entries,omitempty"`
+	EndingBalances []*TransactResponse_Balance     `protobuf:"bytes,4,rep,name=ending_balances,json=endingBalances,proto3" json:"ending_balances,omitempty"`
+	CaptureTime    *timestamppb.Timestamp          `protobuf:"bytes,10,opt,name=capture_time,json=captureTime,proto3" json:"capture_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CaptureMovementResponse) Reset() {
+	*x = CaptureMovementResponse{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CaptureMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptureMovementResponse) ProtoMessage() {}
+
+func (x *CaptureMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptureMovementResponse.ProtoReflect.Descriptor instead.
+func (*CaptureMovementResponse) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CaptureMovementResponse) GetPendingMovementId() string {
+	if x != nil {
+		return x.PendingMovementId
+	}
+	return ""
+}
+
+func (x *CaptureMovementResponse) GetMovementId() string {
+	if x != nil {
+		return x.MovementId
+	}
+	return ""
+}
+
+func (x *CaptureMovementResponse) GetLedgerEntries() []*TransactResponse_LedgerEntry {
+	if x != nil {
+		return x.LedgerEntries
+	}
+	return nil
+}
+
+func (x *CaptureMovementResponse) GetEndingBalances() []*TransactResponse_Balance {
+	if x != nil {
+		return x.EndingBalances
+	}
+	return nil
+}
+
+func (x *CaptureMovementResponse) GetCaptureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CaptureTime
+	}
+	return nil
+}
+
+type VoidMovementRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PendingMovementId string                 `protobuf:"bytes,1,opt,name=pending_movement_id,json=pendingMovementId,proto3" json:"pending_movement_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VoidMovementRequest) Reset() {
+	*x = VoidMovementRequest{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidMovementRequest) ProtoMessage() {}
+
+func (x *VoidMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidMovementRequest.ProtoReflect.Descriptor instead.
+func (*VoidMovementRequest) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *VoidMovementRequest) GetPendingMovementId() string {
+	if x != nil {
+		return x.PendingMovementId
+	}
+	return ""
+}
+
+type VoidMovementResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PendingMovementId string                 `protobuf:"bytes,1,opt,name=pending_movement_id,json=pendingMovementId,proto3" json:"pending_movement_id,omitempty"`
+	Status            PendingMovementStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=go_example.api.ledger.v1.PendingMovementStatus" json:"status,omitempty"`
+	VoidTime          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=void_time,json=voidTime,proto3" json:"void_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *VoidMovementResponse) Reset() {
+	*x = VoidMovementResponse{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidMovementResponse) ProtoMessage() {}
+
+func (x *VoidMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidMovementResponse.ProtoReflect.Descriptor instead.
+func (*VoidMovementResponse) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VoidMovementResponse) GetPendingMovementId() string {
+	if x != nil {
+		return x.PendingMovementId
+	}
+	return ""
+}
+
+func (x *VoidMovementResponse) GetStatus() PendingMovementStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PendingMovementStatus_PENDING_MOVEMENT_STATUS_UNSPECIFIED
+}
+
+func (x *VoidMovementResponse) GetVoidTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.VoidTime
+	}
+	return nil
+}
+
+// TransactFXRequest moves money between accounts with different currencies.
+// The movement is routed through the FX clearing account of each currency, so
+// each currency leg of the movement is balanced.
+type TransactFXRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	IdempotencyKey string                 `protobuf:"bytes,1,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	FromAccountId  string                 `protobuf:"bytes,2,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId    string                 `protobuf:"bytes,3,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	// amount is the amount debited from the source account in the source
+	// currency.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// rate is the conversion rate from the source currency to the destination
+	// currency. The rate provider of the ledger is used if the rate is empty.
+	Rate          string `protobuf:"bytes,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	ClientId      string `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactFXRequest) Reset() {
+	*x = TransactFXRequest{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactFXRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactFXRequest) ProtoMessage() {}
+
+func (x *TransactFXRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactFXRequest.ProtoReflect.Descriptor instead.
+func (*TransactFXRequest) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TransactFXRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *TransactFXRequest) GetFromAccountId() string {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return ""
+}
+
+func (x *TransactFXRequest) GetToAccountId() string {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return ""
+}
+
+func (x *TransactFXRequest) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *TransactFXRequest) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *TransactFXRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+type TransactFXResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	MovementId     string                 `protobuf:"bytes,1,opt,name=movement_id,json=movementId,proto3" json:"movement_id,omitempty"`
+	FromCurrencyId int32                  `protobuf:"varint,2,opt,name=from_currency_id,json=fromCurrencyId,proto3" json:"from_currency_id,omitempty"`
+	ToCurrencyId   int32                  `protobuf:"varint,3,opt,name=to_currency_id,json=toCurrencyId,proto3" json:"to_currency_id,omitempty"`
+	FromAmount     string                 `protobuf:"bytes,4,opt,name=from_amount,json=fromAmount,proto3" json:"from_amount,omitempty"`
+	// to_amount is the amount credited to the destination account in the
+	// destination currency.
+	ToAmount      string                          `protobuf:"bytes,5,opt,name=to_amount,json=toAmount,proto3" json:"to_amount,omitempty"`
+	Rate          string                          `protobuf:"bytes,6,opt,name=rate,proto3" json:"rate,omitempty"`
+	LedgerEntries []*TransactResponse_LedgerEntry `protobuf:"bytes,7,rep,name=ledger_entries,json=ledgerEntries,proto3" json:"ledger_entries,omitempty"`
+	TransactTime  *timestamppb.Timestamp          `protobuf:"bytes,10,opt,name=transact_time,json=transactTime,proto3" json:"transact_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactFXResponse) Reset() {
+	*x = TransactFXResponse{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactFXResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactFXResponse) ProtoMessage() {}
+
+func (x *TransactFXResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactFXResponse.ProtoReflect.Descriptor instead.
+func (*TransactFXResponse) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransactFXResponse) GetMovementId() string {
+	if x != nil {
+		return x.MovementId
+	}
+	return ""
+}
+
+func (x *TransactFXResponse) GetFromCurrencyId() int32 {
+	if x != nil {
+		return x.FromCurrencyId
+	}
+	return 0
+}
+
+func (x *TransactFXResponse) GetToCurrencyId() int32 {
+	if x != nil {
+		return x.ToCurrencyId
+	}
+	return 0
+}
+
+func (x *TransactFXResponse) GetFromAmount() string {
+	if x != nil {
+		return x.FromAmount
+	}
+	return ""
+}
+
+func (x *TransactFXResponse) GetToAmount() string {
+	if x != nil {
+		return x.ToAmount
+	}
+	return ""
+}
+
+func (x *TransactFXResponse) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *TransactFXResponse) GetLedgerEntries() []*TransactResponse_LedgerEntry {
+	if x != nil {
+		return x.LedgerEntries
+	}
+	return nil
+}
+
+func (x *TransactFXResponse) GetTransactTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TransactTime
+	}
+	return nil
+}
+
+type ScheduleMovementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// transact is the movement posted on each occurrence. The idempotency_key
+	// of the request is used as the idempotency key of the scheduled movement,
+	// each occurrence is posted with the idempotency key of
+	// schedule_id:occurrence_number.
+	Transact *TransactRequest `protobuf:"bytes,1,opt,name=transact,proto3" json:"transact,omitempty"`
+	// start_time is the time of the first occurrence. For the recurring
+	// scheduled movement, the first occurrence is the first time matching the
+	// recurrence at or after the start_time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// recurrence is the five fields cron expression(minute, hour, day of month,
+	// month and day of week) of the recurring scheduled movement, for example
+	// "0 9 1 * *" for every 1st day of the month at 09:00 UTC. The scheduled
+	// movement only occurs once at the start_time if the recurrence is empty.
+	// When the scheduler is late by more than one occurrence, only the due
+	// occurrence is posted and the other missed occurrences are skipped.
+	Recurrence string `protobuf:"bytes,3,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// end_time is the exclusive end time of the recurring scheduled movement.
+	// The recurring scheduled movement never ends if the end_time is empty.
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleMovementRequest) Reset() {
+	*x = ScheduleMovementRequest{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMovementRequest) ProtoMessage() {}
+
+func (x *ScheduleMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMovementRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleMovementRequest) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ScheduleMovementRequest) GetTransact() *TransactRequest {
+	if x != nil {
+		return x.Transact
+	}
+	return nil
+}
+
+func (x *ScheduleMovementRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ScheduleMovementRequest) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *ScheduleMovementRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type ScheduledMovement struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	ScheduleId      string                  `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	IdempotencyKey  string                  `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	Status          ScheduledMovementStatus `protobuf:"varint,3,opt,name=status,proto3,enum=go_example.api.ledger.v1.ScheduledMovementStatus" json:"status,omitempty"`
+	Recurrence      string                  `protobuf:"bytes,4,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	MovementEntries []*MovementEntry        `protobuf:"bytes,5,rep,name=movement_entries,json=movementEntries,proto3" json:"movement_entries,omitempty"`
+	// next_occurrence_time is the planned time of the next occurrence. The
+	// occurrence might be posted later when the previous attempt is failed.
+	NextOccurrenceTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=next_occurrence_time,json=nextOccurrenceTime,proto3" json:"next_occurrence_time,omitempty"`
+	EndTime            *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// run_count is the number of occurrences that are already posted or
+	// skipped after all attempts are failed.
+	RunCount int32 `protobuf:"varint,8,opt,name=run_count,json=runCount,proto3" json:"run_count,omitempty"`
+	// attempts is the number of failed attempts of the next occurrence.
+	Attempts  int32  `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError string `protobuf:"bytes,10,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// last_movement_id is the movement_id of the last posted occurrence.
+	LastMovementId string                 `protobuf:"bytes,11,opt,name=last_movement_id,json=lastMovementId,proto3" json:"last_movement_id,omitempty"`
+	CreateTime     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	UpdateTime     *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ScheduledMovement) Reset() {
+	*x = ScheduledMovement{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledMovement) ProtoMessage() {}
+
+func (x *ScheduledMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledMovement.ProtoReflect.Descriptor instead.
+func (*ScheduledMovement) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ScheduledMovement) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *ScheduledMovement) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *ScheduledMovement) GetStatus() ScheduledMovementStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduledMovementStatus_SCHEDULED_MOVEMENT_STATUS_UNSPECIFIED
+}
+
+func (x *ScheduledMovement) GetRecurrence() string {
+	if x != nil {
+		return x.Recurrence
+	}
+	return ""
+}
+
+func (x *ScheduledMovement) GetMovementEntries() []*MovementEntry {
+	if x != nil {
+		return x.MovementEntries
+	}
+	return nil
+}
+
+func (x *ScheduledMovement) GetNextOccurrenceTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextOccurrenceTime
+	}
+	return nil
+}
+
+func (x *ScheduledMovement) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ScheduledMovement) GetRunCount() int32 {
+	if x != nil {
+		return x.RunCount
+	}
+	return 0
+}
+
+func (x *ScheduledMovement) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ScheduledMovement) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ScheduledMovement) GetLastMovementId() string {
+	if x != nil {
+		return x.LastMovementId
+	}
+	return ""
+}
+
+func (x *ScheduledMovement) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *ScheduledMovement) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type ScheduleMovementResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMovement *ScheduledMovement     `protobuf:"bytes,1,opt,name=scheduled_movement,json=scheduledMovement,proto3" json:"scheduled_movement,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScheduleMovementResponse) Reset() {
+	*x = ScheduleMovementResponse{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleMovementResponse) ProtoMessage() {}
+
+func (x *ScheduleMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleMovementResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleMovementResponse) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ScheduleMovementResponse) GetScheduledMovement() *ScheduledMovement {
+	if x != nil {
+		return x.ScheduledMovement
+	}
+	return nil
+}
+
+type ListScheduledMovementsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status filters the scheduled movements by their status. The scheduled
+	// movements of all statuses are listed if the status is unspecified.
+	Status ScheduledMovementStatus `protobuf:"varint,1,opt,name=status,proto3,enum=go_example.api.ledger.v1.ScheduledMovementStatus" json:"status,omitempty"`
+	// page_size is the maximum number of scheduled movements returned in a
+	// single page. The default page size is 100.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token returned from the previous page. Leave
+	// it empty to retrieve the first page.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledMovementsRequest) Reset() {
+	*x = ListScheduledMovementsRequest{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledMovementsRequest) ProtoMessage() {}
+
+func (x *ListScheduledMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListScheduledMovementsRequest) GetStatus() ScheduledMovementStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduledMovementStatus_SCHEDULED_MOVEMENT_STATUS_UNSPECIFIED
+}
+
+func (x *ListScheduledMovementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListScheduledMovementsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListScheduledMovementsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledMovements []*ScheduledMovement   `protobuf:"bytes,1,rep,name=scheduled_movements,json=scheduledMovements,proto3" json:"scheduled_movements,omitempty"`
+	// next_page_token is empty when there are no more scheduled movements.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledMovementsResponse) Reset() {
+	*x = ListScheduledMovementsResponse{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledMovementsResponse) ProtoMessage() {}
+
+func (x *ListScheduledMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListScheduledMovementsResponse) GetScheduledMovements() []*ScheduledMovement {
+	if x != nil {
+		return x.ScheduledMovements
+	}
+	return nil
+}
+
+func (x *ListScheduledMovementsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CancelScheduledMovementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledMovementRequest) Reset() {
+	*x = CancelScheduledMovementRequest{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMovementRequest) ProtoMessage() {}
+
+func (x *CancelScheduledMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMovementRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMovementRequest) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelScheduledMovementRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type CancelScheduledMovementResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ScheduleId    string                  `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Status        ScheduledMovementStatus `protobuf:"varint,2,opt,name=status,proto3,enum=go_example.api.ledger.v1.ScheduledMovementStatus" json:"status,omitempty"`
+	CancelTime    *timestamppb.Timestamp  `protobuf:"bytes,10,opt,name=cancel_time,json=cancelTime,proto3" json:"cancel_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledMovementResponse) Reset() {
+	*x = CancelScheduledMovementResponse{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledMovementResponse) ProtoMessage() {}
+
+func (x *CancelScheduledMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledMovementResponse.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMovementResponse) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CancelScheduledMovementResponse) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *CancelScheduledMovementResponse) GetStatus() ScheduledMovementStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ScheduledMovementStatus_SCHEDULED_MOVEMENT_STATUS_UNSPECIFIED
+}
+
+func (x *CancelScheduledMovementResponse) GetCancelTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelTime
+	}
+	return nil
+}
+
+type TransactResponse_Balance struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// account_id is the affected account_id for the balance output of the
+	// transaction.
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// ledger_id is the new ledger_id for the new balance.
+	LedgerId string `protobuf:"bytes,2,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	// new_balance is the latest balance after transaction.
+	NewBalance string `protobuf:"bytes,3,opt,name=new_balance,json=newBalance,proto3" json:"new_balance,omitempty"`
+	// previous_balance is the previous locked balance before transaction is
+	// completed.
+	PreviousBalance string `protobuf:"bytes,4,opt,name=previous_balance,json=previousBalance,proto3" json:"previous_balance,omitempty"`
+	// previous_ledger_id is the ledger_id for the previous_balance.
+	PreviousLedgerId string `protobuf:"bytes,5,opt,name=previous_ledger_id,json=previousLedgerId,proto3" json:"previous_ledger_id,omitempty"`
+	// previous_movement is the movement_id for the previous balance.
+	PreviousMovementId string `protobuf:"bytes,6,opt,name=previous_movement_id,json=previousMovementId,proto3" json:"previous_movement_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *TransactResponse_Balance) Reset() {
+	*x = TransactResponse_Balance{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactResponse_Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactResponse_Balance) ProtoMessage() {}
+
+func (x *TransactResponse_Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactResponse_Balance.ProtoReflect.Descriptor instead.
+func (*TransactResponse_Balance) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *TransactResponse_Balance) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *TransactResponse_Balance) GetLedgerId() string {
+	if x != nil {
+		return x.LedgerId
+	}
+	return ""
+}
+
+func (x *TransactResponse_Balance) GetNewBalance() string {
+	if x != nil {
+		return x.NewBalance
+	}
+	return ""
+}
+
+func (x *TransactResponse_Balance) GetPreviousBalance() string {
+	if x != nil {
+		return x.PreviousBalance
+	}
+	return ""
+}
+
+func (x *TransactResponse_Balance) GetPreviousLedgerId() string {
+	if x != nil {
+		return x.PreviousLedgerId
+	}
+	return ""
+}
+
+func (x *TransactResponse_Balance) GetPreviousMovementId() string {
+	if x != nil {
+		return x.PreviousMovementId
+	}
+	return ""
+}
+
+type TransactResponse_LedgerEntry struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	LedgerId         string                 `protobuf:"bytes,1,opt,name=ledger_id,json=ledgerId,proto3" json:"ledger_id,omitempty"`
+	ClientId         string                 `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	MovementSequence int32                  `protobuf:"varint,3,opt,name=movement_sequence,json=movementSequence,proto3" json:"movement_sequence,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *TransactResponse_LedgerEntry) Reset() {
+	*x = TransactResponse_LedgerEntry{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactResponse_LedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactResponse_LedgerEntry) ProtoMessage() {}
+
+func (x *TransactResponse_LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactResponse_LedgerEntry.ProtoReflect.Descriptor instead.
+func (*TransactResponse_LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{2, 1}
+}
+
+func (x *TransactResponse_LedgerEntry) GetLedgerId() string {
+	if x != nil {
+		return x.LedgerId
+	}
+	return ""
+}
+
+func (x *TransactResponse_LedgerEntry) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *TransactResponse_LedgerEntry) GetMovementSequence() int32 {
+	if x != nil {
+		return x.MovementSequence
+	}
+	return 0
+}
+
+type TransactBatchResponse_Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// index is the position of the transact request in the stream, starting
+	// from 0.
+	Index          int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// response is the result of the movement, only set when the movement is
+	// recorded.
+	Response *TransactResponse `protobuf:"bytes,3,opt,name=response,proto3" json:"response,omitempty"`
+	// error is the reason of the failed movement, only set when the movement
+	// is not recorded.
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransactBatchResponse_Result) Reset() {
+	*x = TransactBatchResponse_Result{}
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransactBatchResponse_Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransactBatchResponse_Result) ProtoMessage() {}
+
+func (x *TransactBatchResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_api_ledger_v1_ledger_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransactBatchResponse_Result.ProtoReflect.Descriptor instead.
+func (*TransactBatchResponse_Result) Descriptor() ([]byte, []int) {
+	return file_api_ledger_v1_ledger_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *TransactBatchResponse_Result) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *TransactBatchResponse_Result) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *TransactBatchResponse_Result) GetResponse() *TransactResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *TransactBatchResponse_Result) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
 }
 
 var File_api_ledger_v1_ledger_proto protoreflect.FileDescriptor
@@ -460,11 +1916,314 @@ var file_api_ledger_v1_ledger_proto_rawDesc = string([]byte{
 	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
 	0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
 	0x10, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x73, 0x74, 0x75, 0x64, 0x69, 0x6f, 0x2d, 0x61, 0x73, 0x64, 0x2f, 0x67, 0x6f, 0x2d, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x65, 0x22, 0xc7, 0x02, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67,
+	0x6f, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x73, 0x75, 0x63, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x1a, 0xa5, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69,
+	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x46, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x67, 0x6f, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xa3, 0x01, 0x0a, 0x16,
+	0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0b, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03,
+	0xc8, 0x01, 0x01, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x2f, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x2f, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x22, 0xe5, 0x02, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x4d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x30,
+	0x0a, 0x14, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x5d, 0x0a, 0x0e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x5f, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x5b, 0x0a, 0x0f, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x5f, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0e, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0b,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72,
+	0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x64, 0x41, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x18, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x5e, 0x0a, 0x10,
+	0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x64, 0x52, 0x0f, 0x6d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x94, 0x02, 0x0a,
+	0x19, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x5f,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x41, 0x0a, 0x0e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0x50, 0x0a, 0x16, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x4d, 0x6f,
+	0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a,
+	0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xe5, 0x02, 0x0a, 0x17, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72,
+	0x65, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x5d, 0x0a, 0x0e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x5f,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x5b, 0x0a, 0x0f, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x5f,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0e,
+	0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3d,
+	0x0a, 0x0c, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x4d, 0x0a,
+	0x13, 0x56, 0x6f, 0x69, 0x64, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f,
+	0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xc8, 0x01, 0x0a,
+	0x14, 0x56, 0x6f, 0x69, 0x64, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x67, 0x6f, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37,
+	0x0a, 0x09, 0x76, 0x6f, 0x69, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x76,
+	0x6f, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x46, 0x58, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x2e,
+	0x0a, 0x0f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2a,
+	0x0a, 0x0d, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0b, 0x74,
+	0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
+	0x01, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xf7, 0x02, 0x0a, 0x12,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x46, 0x58, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x66,
+	0x72, 0x6f, 0x6d, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x49, 0x64, 0x12, 0x24, 0x0a,
+	0x0e, 0x74, 0x6f, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x74, 0x6f, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x79, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x6f, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6f, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x67, 0x6f, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x82, 0x02, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x4d, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x6f, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x12, 0x41, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9d, 0x05, 0x0a, 0x11, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d,
+	0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x67, 0x6f, 0x5f,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x10, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x67, 0x6f, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x14, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x75, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x72, 0x75, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x3b, 0x0a, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a,
+	0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x76, 0x0a, 0x18, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x6f, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x11, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e, 0x67, 0x6f, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x27, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x1a, 0x05, 0x18, 0xe8, 0x07, 0x28, 0x00, 0x52, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xa6, 0x01, 0x0a, 0x1e, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x13, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x5f, 0x6d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x6f, 0x5f, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x6f, 0x76, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
+	0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x49, 0x0a, 0x1e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x27, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x22, 0xca, 0x01, 0x0a, 0x1f,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d,
+	0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64,
+	0x12, 0x49, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x31, 0x2e, 0x67, 0x6f, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x63,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x2a, 0xd4, 0x01, 0x0a, 0x15, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x27, 0x0a, 0x23, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f,
+	0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x50,
+	0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01,
+	0x12, 0x24, 0x0a, 0x20, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x56, 0x45,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x50, 0x54,
+	0x55, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e,
+	0x47, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x56, 0x4f, 0x49, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x50, 0x45,
+	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x2a,
+	0xe2, 0x01, 0x0a, 0x17, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x6f, 0x76,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x25, 0x53,
+	0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55,
+	0x4c, 0x45, 0x44, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x27, 0x0a, 0x23,
+	0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x27, 0x0a, 0x23, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c,
+	0x45, 0x44, 0x5f, 0x4d, 0x4f, 0x56, 0x45, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x24,
+	0x0a, 0x20, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44, 0x5f, 0x4d, 0x4f, 0x56, 0x45,
+	0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x10, 0x04, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x75, 0x64, 0x69, 0x6f, 0x2d, 0x61, 0x73, 0x64, 0x2f, 0x67, 0x6f,
+	0x2d, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -479,25 +2238,78 @@ func file_api_ledger_v1_ledger_proto_rawDescGZIP() []byte {
 	return file_api_ledger_v1_ledger_proto_rawDescData
 }
 
-var file_api_ledger_v1_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_api_ledger_v1_ledger_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_ledger_v1_ledger_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_api_ledger_v1_ledger_proto_goTypes = []any{
-	(*MovementEntry)(nil),                // 0: go_example.api.ledger.v1.MovementEntry
-	(*TransactRequest)(nil),              // 1: go_example.api.ledger.v1.TransactRequest
-	(*TransactResponse)(nil),             // 2: go_example.api.ledger.v1.TransactResponse
-	(*TransactResponse_Balance)(nil),     // 3: go_example.api.ledger.v1.TransactResponse.Balance
-	(*TransactResponse_LedgerEntry)(nil), // 4: go_example.api.ledger.v1.TransactResponse.LedgerEntry
-	(*timestamppb.Timestamp)(nil),        // 5: google.protobuf.Timestamp
+	(PendingMovementStatus)(0),              // 0: go_example.api.ledger.v1.PendingMovementStatus
+	(ScheduledMovementStatus)(0),            // 1: go_example.api.ledger.v1.ScheduledMovementStatus
+	(*MovementEntry)(nil),                   // 2: go_example.api.ledger.v1.MovementEntry
+	(*TransactRequest)(nil),                 // 3: go_example.api.ledger.v1.TransactRequest
+	(*TransactResponse)(nil),                // 4: go_example.api.ledger.v1.TransactResponse
+	(*TransactBatchResponse)(nil),           // 5: go_example.api.ledger.v1.TransactBatchResponse
+	(*ReverseMovementRequest)(nil),          // 6: go_example.api.ledger.v1.ReverseMovementRequest
+	(*ReverseMovementResponse)(nil),         // 7: go_example.api.ledger.v1.ReverseMovementResponse
+	(*AuthorizeMovementRequest)(nil),        // 8: go_example.api.ledger.v1.AuthorizeMovementRequest
+	(*AuthorizeMovementResponse)(nil),       // 9: go_example.api.ledger.v1.AuthorizeMovementResponse
+	(*CaptureMovementRequest)(nil),          // 10: go_example.api.ledger.v1.CaptureMovementRequest
+	(*CaptureMovementResponse)(nil),         // 11: go_example.api.ledger.v1.CaptureMovementResponse
+	(*VoidMovementRequest)(nil),             // 12: go_example.api.ledger.v1.VoidMovementRequest
+	(*VoidMovementResponse)(nil),            // 13: go_example.api.ledger.v1.VoidMovementResponse
+	(*TransactFXRequest)(nil),               // 14: go_example.api.ledger.v1.TransactFXRequest
+	(*TransactFXResponse)(nil),              // 15: go_example.api.ledger.v1.TransactFXResponse
+	(*ScheduleMovementRequest)(nil),         // 16: go_example.api.ledger.v1.ScheduleMovementRequest
+	(*ScheduledMovement)(nil),               // 17: go_example.api.ledger.v1.ScheduledMovement
+	(*ScheduleMovementResponse)(nil),        // 18: go_example.api.ledger.v1.ScheduleMovementResponse
+	(*ListScheduledMovementsRequest)(nil),   // 19: go_example.api.ledger.v1.ListScheduledMovementsRequest
+	(*ListScheduledMovementsResponse)(nil),  // 20: go_example.api.ledger.v1.ListScheduledMovementsResponse
+	(*CancelScheduledMovementRequest)(nil),  // 21: go_example.api.ledger.v1.CancelScheduledMovementRequest
+	(*CancelScheduledMovementResponse)(nil), // 22: go_example.api.ledger.v1.CancelScheduledMovementResponse
+	(*TransactResponse_Balance)(nil),        // 23: go_example.api.ledger.v1.TransactResponse.Balance
+	(*TransactResponse_LedgerEntry)(nil),    // 24: go_example.api.ledger.v1.TransactResponse.LedgerEntry
+	(*TransactBatchResponse_Result)(nil),    // 25: go_example.api.ledger.v1.TransactBatchResponse.Result
+	(*timestamppb.Timestamp)(nil),           // 26: google.protobuf.Timestamp
 }
 var file_api_ledger_v1_ledger_proto_depIdxs = []int32{
-	0, // 0: go_example.api.ledger.v1.TransactRequest.movement_entries:type_name -> go_example.api.ledger.v1.MovementEntry
-	4, // 1: go_example.api.ledger.v1.TransactResponse.ledger_entries:type_name -> go_example.api.ledger.v1.TransactResponse.LedgerEntry
-	3, // 2: go_example.api.ledger.v1.TransactResponse.ending_balances:type_name -> go_example.api.ledger.v1.TransactResponse.Balance
-	5, // 3: go_example.api.ledger.v1.TransactResponse.transact_time:type_name -> google.protobuf.Timestamp
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2,  // 0: go_example.api.ledger.v1.TransactRequest.movement_entries:type_name -> go_example.api.ledger.v1.MovementEntry
+	24, // 1: go_example.api.ledger.v1.TransactResponse.ledger_entries:type_name -> go_example.api.ledger.v1.TransactResponse.LedgerEntry
+	23, // 2: go_example.api.ledger.v1.TransactResponse.ending_balances:type_name -> go_example.api.ledger.v1.TransactResponse.Balance
+	26, // 3: go_example.api.ledger.v1.TransactResponse.transact_time:type_name -> google.protobuf.Timestamp
+	25, // 4: go_example.api.ledger.v1.TransactBatchResponse.results:type_name -> go_example.api.ledger.v1.TransactBatchResponse.Result
+	24, // 5: go_example.api.ledger.v1.ReverseMovementResponse.ledger_entries:type_name -> go_example.api.ledger.v1.TransactResponse.LedgerEntry
+	23, // 6: go_example.api.ledger.v1.ReverseMovementResponse.ending_balances:type_name -> go_example.api.ledger.v1.TransactResponse.Balance
+	26, // 7: go_example.api.ledger.v1.ReverseMovementResponse.reversed_at:type_name -> google.protobuf.Timestamp
+	26, // 8: go_example.api.ledger.v1.AuthorizeMovementRequest.expire_time:type_name -> google.protobuf.Timestamp
+	2,  // 9: go_example.api.ledger.v1.AuthorizeMovementRequest.movement_entries:type_name -> go_example.api.ledger.v1.MovementEntry
+	0,  // 10: go_example.api.ledger.v1.AuthorizeMovementResponse.status:type_name -> go_example.api.ledger.v1.PendingMovementStatus
+	26, // 11: go_example.api.ledger.v1.AuthorizeMovementResponse.expire_time:type_name -> google.protobuf.Timestamp
+	26, // 12: go_example.api.ledger.v1.AuthorizeMovementResponse.authorize_time:type_name -> google.protobuf.Timestamp
+	24, // 13: go_example.api.ledger.v1.CaptureMovementResponse.ledger_entries:type_name -> go_example.api.ledger.v1.TransactResponse.LedgerEntry
+	23, // 14: go_example.api.ledger.v1.CaptureMovementResponse.ending_balances:type_name -> go_example.api.ledger.v1.TransactResponse.Balance
+	26, // 15: go_example.api.ledger.v1.CaptureMovementResponse.capture_time:type_name -> google.protobuf.Timestamp
+	0,  // 16: go_example.api.ledger.v1.VoidMovementResponse.status:type_name -> go_example.api.ledger.v1.PendingMovementStatus
+	26, // 17: go_example.api.ledger.v1.VoidMovementResponse.void_time:type_name -> google.protobuf.Timestamp
+	24, // 18: go_example.api.ledger.v1.TransactFXResponse.ledger_entries:type_name -> go_example.api.ledger.v1.TransactResponse.LedgerEntry
+	26, // 19: go_example.api.ledger.v1.TransactFXResponse.transact_time:type_name -> google.protobuf.Timestamp
+	3,  // 20: go_example.api.ledger.v1.ScheduleMovementRequest.transact:type_name -> go_example.api.ledger.v1.TransactRequest
+	26, // 21: go_example.api.ledger.v1.ScheduleMovementRequest.start_time:type_name -> google.protobuf.Timestamp
+	26, // 22: go_example.api.ledger.v1.ScheduleMovementRequest.end_time:type_name -> google.protobuf.Timestamp
+	1,  // 23: go_example.api.ledger.v1.ScheduledMovement.status:type_name -> go_example.api.ledger.v1.ScheduledMovementStatus
+	2,  // 24: go_example.api.ledger.v1.ScheduledMovement.movement_entries:type_name -> go_example.api.ledger.v1.MovementEntry
+	26, // 25: go_example.api.ledger.v1.ScheduledMovement.next_occurrence_time:type_name -> google.protobuf.Timestamp
+	26, // 26: go_example.api.ledger.v1.ScheduledMovement.end_time:type_name -> google.protobuf.Timestamp
+	26, // 27: go_example.api.ledger.v1.ScheduledMovement.create_time:type_name -> google.protobuf.Timestamp
+	26, // 28: go_example.api.ledger.v1.ScheduledMovement.update_time:type_name -> google.protobuf.Timestamp
+	17, // 29: go_example.api.ledger.v1.ScheduleMovementResponse.scheduled_movement:type_name -> go_example.api.ledger.v1.ScheduledMovement
+	1,  // 30: go_example.api.ledger.v1.ListScheduledMovementsRequest.status:type_name -> go_example.api.ledger.v1.ScheduledMovementStatus
+	17, // 31: go_example.api.ledger.v1.ListScheduledMovementsResponse.scheduled_movements:type_name -> go_example.api.ledger.v1.ScheduledMovement
+	1,  // 32: go_example.api.ledger.v1.CancelScheduledMovementResponse.status:type_name -> go_example.api.ledger.v1.ScheduledMovementStatus
+	26, // 33: go_example.api.ledger.v1.CancelScheduledMovementResponse.cancel_time:type_name -> google.protobuf.Timestamp
+	4,  // 34: go_example.api.ledger.v1.TransactBatchResponse.Result.response:type_name -> go_example.api.ledger.v1.TransactResponse
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_api_ledger_v1_ledger_proto_init() }
@@ -510,13 +2322,14 @@ func file_api_ledger_v1_ledger_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_ledger_v1_ledger_proto_rawDesc), len(file_api_ledger_v1_ledger_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_api_ledger_v1_ledger_proto_goTypes,
 		DependencyIndexes: file_api_ledger_v1_ledger_proto_depIdxs,
+		EnumInfos:         file_api_ledger_v1_ledger_proto_enumTypes,
 		MessageInfos:      file_api_ledger_v1_ledger_proto_msgTypes,
 	}.Build()
 	File_api_ledger_v1_ledger_proto = out.File
@@ -19,7 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LedgerService_Transact_FullMethodName = "/go_example.api.ledger.v1.LedgerService/Transact"
+	LedgerService_Transact_FullMethodName                = "/go_example.api.ledger.v1.LedgerService/Transact"
+	LedgerService_TransactBatch_FullMethodName           = "/go_example.api.ledger.v1.LedgerService/TransactBatch"
+	LedgerService_WatchAccounts_FullMethodName           = "/go_example.api.ledger.v1.LedgerService/WatchAccounts"
+	LedgerService_CreateAccounts_FullMethodName          = "/go_example.api.ledger.v1.LedgerService/CreateAccounts"
+	LedgerService_GetAccountsBalance_FullMethodName      = "/go_example.api.ledger.v1.LedgerService/GetAccountsBalance"
+	LedgerService_ReverseMovement_FullMethodName         = "/go_example.api.ledger.v1.LedgerService/ReverseMovement"
+	LedgerService_ListAccountLedger_FullMethodName       = "/go_example.api.ledger.v1.LedgerService/ListAccountLedger"
+	LedgerService_GetAccountsBalanceAt_FullMethodName    = "/go_example.api.ledger.v1.LedgerService/GetAccountsBalanceAt"
+	LedgerService_GetAccountTree_FullMethodName          = "/go_example.api.ledger.v1.LedgerService/GetAccountTree"
+	LedgerService_UpdateAccountStatus_FullMethodName     = "/go_example.api.ledger.v1.LedgerService/UpdateAccountStatus"
+	LedgerService_AuthorizeMovement_FullMethodName       = "/go_example.api.ledger.v1.LedgerService/AuthorizeMovement"
+	LedgerService_CaptureMovement_FullMethodName         = "/go_example.api.ledger.v1.LedgerService/CaptureMovement"
+	LedgerService_VoidMovement_FullMethodName            = "/go_example.api.ledger.v1.LedgerService/VoidMovement"
+	LedgerService_PlaceHold_FullMethodName               = "/go_example.api.ledger.v1.LedgerService/PlaceHold"
+	LedgerService_ReleaseHold_FullMethodName             = "/go_example.api.ledger.v1.LedgerService/ReleaseHold"
+	LedgerService_CreateBalanceShards_FullMethodName     = "/go_example.api.ledger.v1.LedgerService/CreateBalanceShards"
+	LedgerService_TransactFX_FullMethodName              = "/go_example.api.ledger.v1.LedgerService/TransactFX"
+	LedgerService_CreateCurrency_FullMethodName          = "/go_example.api.ledger.v1.LedgerService/CreateCurrency"
+	LedgerService_ListCurrencies_FullMethodName          = "/go_example.api.ledger.v1.LedgerService/ListCurrencies"
+	LedgerService_ScheduleMovement_FullMethodName        = "/go_example.api.ledger.v1.LedgerService/ScheduleMovement"
+	LedgerService_ListScheduledMovements_FullMethodName  = "/go_example.api.ledger.v1.LedgerService/ListScheduledMovements"
+	LedgerService_CancelScheduledMovement_FullMethodName = "/go_example.api.ledger.v1.LedgerService/CancelScheduledMovement"
 )
 
 // LedgerServiceClient is the client API for LedgerService service.
@@ -27,6 +48,33 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LedgerServiceClient interface {
 	Transact(ctx context.Context, in *TransactRequest, opts ...grpc.CallOption) (*TransactResponse, error)
+	// TransactBatch records many independent movements streamed by the client.
+	// The movements are recorded in groups to reduce the number of accounts
+	// balance locks, and the result of each movement is reported separately.
+	TransactBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[TransactRequest, TransactBatchResponse], error)
+	// WatchAccounts streams the balance changes of the accounts. The stream is
+	// closed with an error when the client can't keep up with the changes, the
+	// client should reconnect with the last received ledger id of each account.
+	WatchAccounts(ctx context.Context, in *WatchAccountsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AccountBalanceChange], error)
+	CreateAccounts(ctx context.Context, in *CreateLedgerAccountsRequest, opts ...grpc.CallOption) (*CreateLedgerAccountsResponse, error)
+	GetAccountsBalance(ctx context.Context, in *GetAccountsBalanceRequest, opts ...grpc.CallOption) (*GetAccountsBalanceResponse, error)
+	ReverseMovement(ctx context.Context, in *ReverseMovementRequest, opts ...grpc.CallOption) (*ReverseMovementResponse, error)
+	ListAccountLedger(ctx context.Context, in *ListAccountLedgerRequest, opts ...grpc.CallOption) (*ListAccountLedgerResponse, error)
+	GetAccountsBalanceAt(ctx context.Context, in *GetAccountsBalanceAtRequest, opts ...grpc.CallOption) (*GetAccountsBalanceAtResponse, error)
+	GetAccountTree(ctx context.Context, in *GetAccountTreeRequest, opts ...grpc.CallOption) (*GetAccountTreeResponse, error)
+	UpdateAccountStatus(ctx context.Context, in *UpdateAccountStatusRequest, opts ...grpc.CallOption) (*UpdateAccountStatusResponse, error)
+	AuthorizeMovement(ctx context.Context, in *AuthorizeMovementRequest, opts ...grpc.CallOption) (*AuthorizeMovementResponse, error)
+	CaptureMovement(ctx context.Context, in *CaptureMovementRequest, opts ...grpc.CallOption) (*CaptureMovementResponse, error)
+	VoidMovement(ctx context.Context, in *VoidMovementRequest, opts ...grpc.CallOption) (*VoidMovementResponse, error)
+	PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error)
+	ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error)
+	CreateBalanceShards(ctx context.Context, in *CreateBalanceShardsRequest, opts ...grpc.CallOption) (*CreateBalanceShardsResponse, error)
+	TransactFX(ctx context.Context, in *TransactFXRequest, opts ...grpc.CallOption) (*TransactFXResponse, error)
+	CreateCurrency(ctx context.Context, in *CreateCurrencyRequest, opts ...grpc.CallOption) (*CreateCurrencyResponse, error)
+	ListCurrencies(ctx context.Context, in *CurrencyListRequest, opts ...grpc.CallOption) (*CurrencyListResponse, error)
+	ScheduleMovement(ctx context.Context, in *ScheduleMovementRequest, opts ...grpc.CallOption) (*ScheduleMovementResponse, error)
+	ListScheduledMovements(ctx context.Context, in *ListScheduledMovementsRequest, opts ...grpc.CallOption) (*ListScheduledMovementsResponse, error)
+	CancelScheduledMovement(ctx context.Context, in *CancelScheduledMovementRequest, opts ...grpc.CallOption) (*CancelScheduledMovementResponse, error)
 }
 
 type ledgerServiceClient struct {
@@ -47,11 +95,260 @@ func (c *ledgerServiceClient) Transact(ctx context.Context, in *TransactRequest,
 	return out, nil
 }
 
+func (c *ledgerServiceClient) TransactBatch(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[TransactRequest, TransactBatchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LedgerService_ServiceDesc.Streams[0], LedgerService_TransactBatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TransactRequest, TransactBatchResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LedgerService_TransactBatchClient = grpc.ClientStreamingClient[TransactRequest, TransactBatchResponse]
+
+func (c *ledgerServiceClient) WatchAccounts(ctx context.Context, in *WatchAccountsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AccountBalanceChange], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &LedgerService_ServiceDesc.Streams[1], LedgerService_WatchAccounts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchAccountsRequest, AccountBalanceChange]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LedgerService_WatchAccountsClient = grpc.ServerStreamingClient[AccountBalanceChange]
+
+func (c *ledgerServiceClient) CreateAccounts(ctx context.Context, in *CreateLedgerAccountsRequest, opts ...grpc.CallOption) (*CreateLedgerAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateLedgerAccountsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetAccountsBalance(ctx context.Context, in *GetAccountsBalanceRequest, opts ...grpc.CallOption) (*GetAccountsBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountsBalanceResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetAccountsBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ReverseMovement(ctx context.Context, in *ReverseMovementRequest, opts ...grpc.CallOption) (*ReverseMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReverseMovementResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ReverseMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListAccountLedger(ctx context.Context, in *ListAccountLedgerRequest, opts ...grpc.CallOption) (*ListAccountLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountLedgerResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListAccountLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetAccountsBalanceAt(ctx context.Context, in *GetAccountsBalanceAtRequest, opts ...grpc.CallOption) (*GetAccountsBalanceAtResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountsBalanceAtResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetAccountsBalanceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) GetAccountTree(ctx context.Context, in *GetAccountTreeRequest, opts ...grpc.CallOption) (*GetAccountTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountTreeResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetAccountTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) UpdateAccountStatus(ctx context.Context, in *UpdateAccountStatusRequest, opts ...grpc.CallOption) (*UpdateAccountStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAccountStatusResponse)
+	err := c.cc.Invoke(ctx, LedgerService_UpdateAccountStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) AuthorizeMovement(ctx context.Context, in *AuthorizeMovementRequest, opts ...grpc.CallOption) (*AuthorizeMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizeMovementResponse)
+	err := c.cc.Invoke(ctx, LedgerService_AuthorizeMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CaptureMovement(ctx context.Context, in *CaptureMovementRequest, opts ...grpc.CallOption) (*CaptureMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CaptureMovementResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CaptureMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) VoidMovement(ctx context.Context, in *VoidMovementRequest, opts ...grpc.CallOption) (*VoidMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VoidMovementResponse)
+	err := c.cc.Invoke(ctx, LedgerService_VoidMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) PlaceHold(ctx context.Context, in *PlaceHoldRequest, opts ...grpc.CallOption) (*PlaceHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaceHoldResponse)
+	err := c.cc.Invoke(ctx, LedgerService_PlaceHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ReleaseHold(ctx context.Context, in *ReleaseHoldRequest, opts ...grpc.CallOption) (*ReleaseHoldResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseHoldResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ReleaseHold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateBalanceShards(ctx context.Context, in *CreateBalanceShardsRequest, opts ...grpc.CallOption) (*CreateBalanceShardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBalanceShardsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateBalanceShards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) TransactFX(ctx context.Context, in *TransactFXRequest, opts ...grpc.CallOption) (*TransactFXResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransactFXResponse)
+	err := c.cc.Invoke(ctx, LedgerService_TransactFX_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CreateCurrency(ctx context.Context, in *CreateCurrencyRequest, opts ...grpc.CallOption) (*CreateCurrencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCurrencyResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CreateCurrency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListCurrencies(ctx context.Context, in *CurrencyListRequest, opts ...grpc.CallOption) (*CurrencyListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CurrencyListResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListCurrencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ScheduleMovement(ctx context.Context, in *ScheduleMovementRequest, opts ...grpc.CallOption) (*ScheduleMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleMovementResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ScheduleMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListScheduledMovements(ctx context.Context, in *ListScheduledMovementsRequest, opts ...grpc.CallOption) (*ListScheduledMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledMovementsResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListScheduledMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) CancelScheduledMovement(ctx context.Context, in *CancelScheduledMovementRequest, opts ...grpc.CallOption) (*CancelScheduledMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelScheduledMovementResponse)
+	err := c.cc.Invoke(ctx, LedgerService_CancelScheduledMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LedgerServiceServer is the server API for LedgerService service.
 // All implementations must embed UnimplementedLedgerServiceServer
 // for forward compatibility.
 type LedgerServiceServer interface {
 	Transact(context.Context, *TransactRequest) (*TransactResponse, error)
+	// TransactBatch records many independent movements streamed by the client.
+	// The movements are recorded in groups to reduce the number of accounts
+	// balance locks, and the result of each movement is reported separately.
+	TransactBatch(grpc.ClientStreamingServer[TransactRequest, TransactBatchResponse]) error
+	// WatchAccounts streams the balance changes of the accounts. The stream is
+	// closed with an error when the client can't keep up with the changes, the
+	// client should reconnect with the last received ledger id of each account.
+	WatchAccounts(*WatchAccountsRequest, grpc.ServerStreamingServer[AccountBalanceChange]) error
+	CreateAccounts(context.Context, *CreateLedgerAccountsRequest) (*CreateLedgerAccountsResponse, error)
+	GetAccountsBalance(context.Context, *GetAccountsBalanceRequest) (*GetAccountsBalanceResponse, error)
+	ReverseMovement(context.Context, *ReverseMovementRequest) (*ReverseMovementResponse, error)
+	ListAccountLedger(context.Context, *ListAccountLedgerRequest) (*ListAccountLedgerResponse, error)
+	GetAccountsBalanceAt(context.Context, *GetAccountsBalanceAtRequest) (*GetAccountsBalanceAtResponse, error)
+	GetAccountTree(context.Context, *GetAccountTreeRequest) (*GetAccountTreeResponse, error)
+	UpdateAccountStatus(context.Context, *UpdateAccountStatusRequest) (*UpdateAccountStatusResponse, error)
+	AuthorizeMovement(context.Context, *AuthorizeMovementRequest) (*AuthorizeMovementResponse, error)
+	CaptureMovement(context.Context, *CaptureMovementRequest) (*CaptureMovementResponse, error)
+	VoidMovement(context.Context, *VoidMovementRequest) (*VoidMovementResponse, error)
+	PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error)
+	ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error)
+	CreateBalanceShards(context.Context, *CreateBalanceShardsRequest) (*CreateBalanceShardsResponse, error)
+	TransactFX(context.Context, *TransactFXRequest) (*TransactFXResponse, error)
+	CreateCurrency(context.Context, *CreateCurrencyRequest) (*CreateCurrencyResponse, error)
+	ListCurrencies(context.Context, *CurrencyListRequest) (*CurrencyListResponse, error)
+	ScheduleMovement(context.Context, *ScheduleMovementRequest) (*ScheduleMovementResponse, error)
+	ListScheduledMovements(context.Context, *ListScheduledMovementsRequest) (*ListScheduledMovementsResponse, error)
+	CancelScheduledMovement(context.Context, *CancelScheduledMovementRequest) (*CancelScheduledMovementResponse, error)
 	mustEmbedUnimplementedLedgerServiceServer()
 }
 
@@ -65,6 +362,69 @@ type UnimplementedLedgerServiceServer struct{}
 func (UnimplementedLedgerServiceServer) Transact(context.Context, *TransactRequest) (*TransactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Transact not implemented")
 }
+func (UnimplementedLedgerServiceServer) TransactBatch(grpc.ClientStreamingServer[TransactRequest, TransactBatchResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method TransactBatch not implemented")
+}
+func (UnimplementedLedgerServiceServer) WatchAccounts(*WatchAccountsRequest, grpc.ServerStreamingServer[AccountBalanceChange]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAccounts not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateAccounts(context.Context, *CreateLedgerAccountsRequest) (*CreateLedgerAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccounts not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetAccountsBalance(context.Context, *GetAccountsBalanceRequest) (*GetAccountsBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountsBalance not implemented")
+}
+func (UnimplementedLedgerServiceServer) ReverseMovement(context.Context, *ReverseMovementRequest) (*ReverseMovementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseMovement not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListAccountLedger(context.Context, *ListAccountLedgerRequest) (*ListAccountLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAccountLedger not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetAccountsBalanceAt(context.Context, *GetAccountsBalanceAtRequest) (*GetAccountsBalanceAtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountsBalanceAt not implemented")
+}
+func (UnimplementedLedgerServiceServer) GetAccountTree(context.Context, *GetAccountTreeRequest) (*GetAccountTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountTree not implemented")
+}
+func (UnimplementedLedgerServiceServer) UpdateAccountStatus(context.Context, *UpdateAccountStatusRequest) (*UpdateAccountStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountStatus not implemented")
+}
+func (UnimplementedLedgerServiceServer) AuthorizeMovement(context.Context, *AuthorizeMovementRequest) (*AuthorizeMovementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeMovement not implemented")
+}
+func (UnimplementedLedgerServiceServer) CaptureMovement(context.Context, *CaptureMovementRequest) (*CaptureMovementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureMovement not implemented")
+}
+func (UnimplementedLedgerServiceServer) VoidMovement(context.Context, *VoidMovementRequest) (*VoidMovementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidMovement not implemented")
+}
+func (UnimplementedLedgerServiceServer) PlaceHold(context.Context, *PlaceHoldRequest) (*PlaceHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceHold not implemented")
+}
+func (UnimplementedLedgerServiceServer) ReleaseHold(context.Context, *ReleaseHoldRequest) (*ReleaseHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateBalanceShards(context.Context, *CreateBalanceShardsRequest) (*CreateBalanceShardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBalanceShards not implemented")
+}
+func (UnimplementedLedgerServiceServer) TransactFX(context.Context, *TransactFXRequest) (*TransactFXResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransactFX not implemented")
+}
+func (UnimplementedLedgerServiceServer) CreateCurrency(context.Context, *CreateCurrencyRequest) (*CreateCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCurrency not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListCurrencies(context.Context, *CurrencyListRequest) (*CurrencyListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrencies not implemented")
+}
+func (UnimplementedLedgerServiceServer) ScheduleMovement(context.Context, *ScheduleMovementRequest) (*ScheduleMovementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleMovement not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListScheduledMovements(context.Context, *ListScheduledMovementsRequest) (*ListScheduledMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledMovements not implemented")
+}
+func (UnimplementedLedgerServiceServer) CancelScheduledMovement(context.Context, *CancelScheduledMovementRequest) (*CancelScheduledMovementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMovement not implemented")
+}
 func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
 func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
 
@@ -104,6 +464,366 @@ func _LedgerService_Transact_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LedgerService_TransactBatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LedgerServiceServer).TransactBatch(&grpc.GenericServerStream[TransactRequest, TransactBatchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LedgerService_TransactBatchServer = grpc.ClientStreamingServer[TransactRequest, TransactBatchResponse]
+
+func _LedgerService_WatchAccounts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAccountsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LedgerServiceServer).WatchAccounts(m, &grpc.GenericServerStream[WatchAccountsRequest, AccountBalanceChange]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type LedgerService_WatchAccountsServer = grpc.ServerStreamingServer[AccountBalanceChange]
+
+func _LedgerService_CreateAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLedgerAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateAccounts(ctx, req.(*CreateLedgerAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetAccountsBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetAccountsBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetAccountsBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetAccountsBalance(ctx, req.(*GetAccountsBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ReverseMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ReverseMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ReverseMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ReverseMovement(ctx, req.(*ReverseMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListAccountLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListAccountLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListAccountLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListAccountLedger(ctx, req.(*ListAccountLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetAccountsBalanceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsBalanceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetAccountsBalanceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetAccountsBalanceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetAccountsBalanceAt(ctx, req.(*GetAccountsBalanceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_GetAccountTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetAccountTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetAccountTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetAccountTree(ctx, req.(*GetAccountTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_UpdateAccountStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).UpdateAccountStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_UpdateAccountStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).UpdateAccountStatus(ctx, req.(*UpdateAccountStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_AuthorizeMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).AuthorizeMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_AuthorizeMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).AuthorizeMovement(ctx, req.(*AuthorizeMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CaptureMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CaptureMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CaptureMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CaptureMovement(ctx, req.(*CaptureMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_VoidMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).VoidMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_VoidMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).VoidMovement(ctx, req.(*VoidMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_PlaceHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).PlaceHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_PlaceHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).PlaceHold(ctx, req.(*PlaceHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ReleaseHold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ReleaseHold(ctx, req.(*ReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateBalanceShards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBalanceShardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateBalanceShards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateBalanceShards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateBalanceShards(ctx, req.(*CreateBalanceShardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_TransactFX_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransactFXRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).TransactFX(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_TransactFX_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).TransactFX(ctx, req.(*TransactFXRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CreateCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CreateCurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CreateCurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CreateCurrency(ctx, req.(*CreateCurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListCurrencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListCurrencies(ctx, req.(*CurrencyListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ScheduleMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ScheduleMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ScheduleMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ScheduleMovement(ctx, req.(*ScheduleMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListScheduledMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListScheduledMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListScheduledMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListScheduledMovements(ctx, req.(*ListScheduledMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_CancelScheduledMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).CancelScheduledMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_CancelScheduledMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).CancelScheduledMovement(ctx, req.(*CancelScheduledMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LedgerService_ServiceDesc is the grpc.ServiceDesc for LedgerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,7 +835,94 @@ var LedgerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Transact",
 			Handler:    _LedgerService_Transact_Handler,
 		},
+		{
+			MethodName: "CreateAccounts",
+			Handler:    _LedgerService_CreateAccounts_Handler,
+		},
+		{
+			MethodName: "GetAccountsBalance",
+			Handler:    _LedgerService_GetAccountsBalance_Handler,
+		},
+		{
+			MethodName: "ReverseMovement",
+			Handler:    _LedgerService_ReverseMovement_Handler,
+		},
+		{
+			MethodName: "ListAccountLedger",
+			Handler:    _LedgerService_ListAccountLedger_Handler,
+		},
+		{
+			MethodName: "GetAccountsBalanceAt",
+			Handler:    _LedgerService_GetAccountsBalanceAt_Handler,
+		},
+		{
+			MethodName: "GetAccountTree",
+			Handler:    _LedgerService_GetAccountTree_Handler,
+		},
+		{
+			MethodName: "UpdateAccountStatus",
+			Handler:    _LedgerService_UpdateAccountStatus_Handler,
+		},
+		{
+			MethodName: "AuthorizeMovement",
+			Handler:    _LedgerService_AuthorizeMovement_Handler,
+		},
+		{
+			MethodName: "CaptureMovement",
+			Handler:    _LedgerService_CaptureMovement_Handler,
+		},
+		{
+			MethodName: "VoidMovement",
+			Handler:    _LedgerService_VoidMovement_Handler,
+		},
+		{
+			MethodName: "PlaceHold",
+			Handler:    _LedgerService_PlaceHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _LedgerService_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "CreateBalanceShards",
+			Handler:    _LedgerService_CreateBalanceShards_Handler,
+		},
+		{
+			MethodName: "TransactFX",
+			Handler:    _LedgerService_TransactFX_Handler,
+		},
+		{
+			MethodName: "CreateCurrency",
+			Handler:    _LedgerService_CreateCurrency_Handler,
+		},
+		{
+			MethodName: "ListCurrencies",
+			Handler:    _LedgerService_ListCurrencies_Handler,
+		},
+		{
+			MethodName: "ScheduleMovement",
+			Handler:    _LedgerService_ScheduleMovement_Handler,
+		},
+		{
+			MethodName: "ListScheduledMovements",
+			Handler:    _LedgerService_ListScheduledMovements_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMovement",
+			Handler:    _LedgerService_CancelScheduledMovement_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TransactBatch",
+			Handler:       _LedgerService_TransactBatch_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "WatchAccounts",
+			Handler:       _LedgerService_WatchAccounts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/ledger/v1/service.proto",
 }
@@ -12,8 +12,10 @@ import (
 
 	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
 	userv1 "github.com/studio-asd/go-example/proto/api/user/v1"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
 	ledgerapi "github.com/studio-asd/go-example/services/ledger/api"
 	userapi "github.com/studio-asd/go-example/services/user/api"
+	walletapi "github.com/studio-asd/go-example/services/wallet/api"
 )
 
 //go:embed pattern.yaml
@@ -25,17 +27,22 @@ func init() {
 type Server struct {
 	ledger *ledgerapi.API
 	user   *userapi.API
+	wallet *walletapi.API
 	auth   *serviceAuth
 }
 
-func New(ledger *ledgerapi.API, user *userapi.API) *Server {
+func New(ledger *ledgerapi.API, user *userapi.API, wallet *walletapi.API) *Server {
 	return &Server{
 		ledger: ledger,
 		user:   user,
+		wallet: wallet,
 		auth: &serviceAuth{
 			noAuthPatterns: map[string]string{
 				// For debugging.
 				"/v1/user/info": http.MethodGet,
+				// The payment gateway webhook is not sent by the user, the webhook is authenticated by verifying the signature of
+				// the payment gateway instead.
+				"/v1/wallet/webhooks/{vendor=*}": http.MethodPost,
 			},
 		},
 	}
@@ -53,6 +60,12 @@ func (s *Server) RegisterAPIServices(grpcServer *grpcserver.GRPCServer) error {
 			if err := userv1.RegisterUserServiceHandlerServer(context.Background(), mux, s.user.GRPC()); err != nil {
 				return err
 			}
+			if err := walletv1.RegisterWalletServiceHandlerServer(context.Background(), mux, s.wallet.GRPC()); err != nil {
+				return err
+			}
+			if err := mux.HandlePath(http.MethodPost, "/v1/wallet/webhooks/{vendor}", s.paymentGatewayWebhook); err != nil {
+				return err
+			}
 			return nil
 		})
 		return nil
@@ -0,0 +1,39 @@
+package server
+
+import (
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/studio-asd/go-example/services/wallet"
+)
+
+const (
+	// headerPaymentGatewaySignature is the header of the signature of the payment gateway webhook payload.
+	headerPaymentGatewaySignature = "X-Signature"
+	// maxWebhookPayloadSize is the maximum size of the webhook payload, the payload is small so 1MB is more than enough.
+	maxWebhookPayloadSize = 1 << 20
+)
+
+// paymentGatewayWebhook receives the webhooks of the payment gateways. The payment gateway retries the webhook when the response
+// is not 2xx, so the error that can't be fixed by retrying is responded with 4xx.
+func (s *Server) paymentGatewayWebhook(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+	payload, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	err = s.wallet.HandlePaymentGatewayWebhook(r.Context(), pathParams["vendor"], payload, r.Header.Get(headerPaymentGatewaySignature))
+	switch {
+	case err == nil:
+		w.WriteHeader(http.StatusOK)
+	case errors.Is(err, wallet.ErrInvalidWebhookSignature):
+		w.WriteHeader(http.StatusUnauthorized)
+	case errors.Is(err, wallet.ErrPaymentGatewayNotFound), errors.Is(err, wallet.ErrWithdrawalNotFound):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Is(err, wallet.ErrInvalidWebhookEvent), errors.Is(err, wallet.ErrInvalidWithdrawalTransition):
+		w.WriteHeader(http.StatusUnprocessableEntity)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
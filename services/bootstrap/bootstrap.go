@@ -60,6 +60,10 @@ func New(params Params) (*Bootstrap, error) {
 			goExampleDBMigrator: goExampleDBMigrator,
 			userDBMigrator:      userDBMigrator,
 		},
+		&v1Bootstrapper{
+			goExamplePG:         params.GoExampleDB,
+			goExampleDBMigrator: goExampleDBMigrator,
+		},
 	}
 	checkAndSortBootstrappers(b)
 
@@ -2,9 +2,11 @@ package bootstrap
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/golang-migrate/migrate/v4"
 	"github.com/studio-asd/pkg/postgres"
 )
 
@@ -19,34 +21,37 @@ func PostgresCheckTables(tables []string, columns []map[string]string) {
 
 // PostgresCheckMigrations checks the golang-migrate migrations via schema_midgrations table.
 //
+// The golang-migrate only keeps the latest migration version in the schema_migrations table. As the migrations are applied
+// sequentially, a version is treated as migrated if the latest version is greater or equal to the version.
+//
 // The check will failed on two conditions:
 // 1. If there is any dirty migration, then the check will failed.
 // 2. If there is any missing migration, then the check will failed.
 func PostgresCheckMigrations(ctx context.Context, pg *postgres.Postgres, versions []int64) error {
-	type SchemaMigrations struct {
-		Version int64 `db:"version"`
-		Dirty   bool  `db:"dirty"`
-	}
-
 	query, params, err := squirrel.Select("version", "dirty").
 		From("schema_migrations").
-		Where(squirrel.Eq{"version": versions}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
 		return err
 	}
 
-	schemaMigrations := make(map[int64]SchemaMigrations)
+	var (
+		latestVersion int64
+		dirty         bool
+	)
 	if err := pg.RunQuery(ctx, query, func(rows *postgres.RowsCompat) error {
 		var (
-			version int64
-			dirty   bool
+			version      int64
+			versionDirty bool
 		)
-		if err := rows.Scan(&version, &dirty); err != nil {
+		if err := rows.Scan(&version, &versionDirty); err != nil {
 			return err
 		}
-		schemaMigrations[version] = SchemaMigrations{Version: version, Dirty: dirty}
+		if version > latestVersion {
+			latestVersion = version
+			dirty = versionDirty
+		}
 		return nil
 	}, params...); err != nil {
 		return err
@@ -54,12 +59,32 @@ func PostgresCheckMigrations(ctx context.Context, pg *postgres.Postgres, version
 
 	// Check all the migrations.
 	for _, version := range versions {
-		if _, ok := schemaMigrations[version]; !ok {
+		if latestVersion < version {
 			return fmt.Errorf("missing migration version %d", version)
 		}
-		if schemaMigrations[version].Dirty {
-			return fmt.Errorf("dirty migration version %d", version)
+		if dirty {
+			return fmt.Errorf("dirty migration version %d", latestVersion)
 		}
 	}
 	return nil
 }
+
+// PostgresMigrateUp migrates the database up to the given version. Unlike migrate.Migrate, the function never migrates the
+// database down if the database is already in a higher version. So the older bootstrapper won't rollback the migrations
+// applied by the newer bootstrapper.
+func PostgresMigrateUp(migrator *migrate.Migrate, version uint) error {
+	current, dirty, err := migrator.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("dirty migration version %d", current)
+	}
+	if err == nil && current >= version {
+		return nil
+	}
+	if err := migrator.Migrate(version); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+	return nil
+}
@@ -20,10 +20,10 @@ func (b *v0Bootstrapper) Version() string {
 }
 
 func (b *v0Bootstrapper) Upgrade(ctx context.Context) error {
-	if err := b.goExampleDBMigrator.Migrate(1); err != nil {
+	if err := PostgresMigrateUp(b.goExampleDBMigrator, 1); err != nil {
 		return err
 	}
-	if err := b.userDBMigrator.Migrate(1); err != nil {
+	if err := PostgresMigrateUp(b.userDBMigrator, 1); err != nil {
 		return err
 	}
 	return nil
@@ -0,0 +1,38 @@
+package bootstrap
+
+import (
+	"context"
+
+	"github.com/golang-migrate/migrate/v4"
+
+	"github.com/studio-asd/pkg/postgres"
+)
+
+// v1Bootstrapper migrates the go_example database to the latest schema of v0.2.
+type v1Bootstrapper struct {
+	goExamplePG         *postgres.Postgres
+	goExampleDBMigrator *migrate.Migrate
+}
+
+// goExampleV1MigrationVersion is the latest migration version of the go_example database for v0.2.
+const goExampleV1MigrationVersion = 11
+
+func (b *v1Bootstrapper) Version() string {
+	return "v0.2"
+}
+
+func (b *v1Bootstrapper) Upgrade(ctx context.Context) error {
+	return PostgresMigrateUp(b.goExampleDBMigrator, goExampleV1MigrationVersion)
+}
+
+func (b *v1Bootstrapper) CheckUpgrade(ctx context.Context) error {
+	return PostgresCheckMigrations(ctx, b.goExamplePG, []int64{goExampleV1MigrationVersion})
+}
+
+func (b *v1Bootstrapper) Rollback(ctx context.Context) error {
+	return nil
+}
+
+func (b *v1Bootstrapper) CheckRollback(ctx context.Context) error {
+	return nil
+}
@@ -1,15 +1,58 @@
 package ledger
 
+import (
+	"fmt"
+	"slices"
+)
+
 const (
 	// Account types.
 	AccountTypeDeposit    = "deposit"
 	AccountTypeWithdrawal = "withdrawal"
 	AccountTypeUser       = "user"
-	// Account statuses.
-	AccountStatusActive   = "active"
-	AccountStatusInactive = "inactive"
 )
 
+// Account statuses, the status is persisted in the accounts.account_status column and shares the same value with
+// the ledgerv1.AccountStatus enum.
+const (
+	// AccountStatusActive allows the account to be debited and credited.
+	AccountStatusActive int32 = 1
+	// AccountStatusFrozen only allows the account to be credited, the money cannot go out from a frozen account.
+	AccountStatusFrozen int32 = 2
+	// AccountStatusClosed doesn't allow the account to be debited nor credited.
+	AccountStatusClosed int32 = 3
+)
+
+// accountStatusTransitions is the list of allowed status transitions of an account.
+var accountStatusTransitions = map[int32][]int32{
+	AccountStatusActive: {AccountStatusFrozen, AccountStatusClosed},
+	AccountStatusFrozen: {AccountStatusActive, AccountStatusClosed},
+	// Closed account can only be reopened as an active account.
+	AccountStatusClosed: {AccountStatusActive},
+}
+
+// CheckAccountStatusTransition checks whether the account status can be changed from one status to another.
+func CheckAccountStatusTransition(from, to int32) error {
+	if !slices.Contains(accountStatusTransitions[from], to) {
+		return fmt.Errorf("%w: cannot change account status from %d to %d", ErrInvalidAccountStatusTransition, from, to)
+	}
+	return nil
+}
+
+// CheckAccountStatusForMovement checks whether the account can be a part of a movement based on its status. The debit
+// flag is true if the money goes out from the account.
+func CheckAccountStatusForMovement(status int32, debit bool) error {
+	switch status {
+	case AccountStatusClosed:
+		return ErrAccountClosed
+	case AccountStatusFrozen:
+		if debit {
+			return ErrAccountFrozen
+		}
+	}
+	return nil
+}
+
 type AccountInfo struct {
 	AccountID       string
 	ParentAccountID string
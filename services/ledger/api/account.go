@@ -4,13 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"maps"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/studio-asd/pkg/postgres"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
-	"github.com/studio-asd/go-example/internal/await"
 	"github.com/studio-asd/go-example/internal/currency"
 	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
 	"github.com/studio-asd/go-example/services/ledger"
@@ -23,6 +26,8 @@ func (a *API) CreateAccounts(ctx context.Context, request *ledgerv1.CreateLedger
 	if err := validator.Validate(request); err != nil {
 		return nil, err
 	}
+	ctx, cancel := withDeadline(ctx, a.options.Deadlines.CreateAccounts)
+	defer cancel()
 
 	createdAt := time.Now()
 	// Define the timestamppb here because all of data will have the same timestamp of craeted at.
@@ -46,6 +51,9 @@ func (a *API) CreateAccounts(ctx context.Context, request *ledgerv1.CreateLedger
 		if err != nil {
 			return nil, err
 		}
+		if !cur.Active {
+			return nil, fmt.Errorf("%w: %s", currency.ErrCurrencyInactive, cur.Name)
+		}
 		// Create the request upfront so we don't have to loop all over again.
 		createReqs[idx] = ledgerpg.CreateLedgerAccount{
 			AccountID:       accID,
@@ -70,53 +78,337 @@ func (a *API) CreateAccounts(ctx context.Context, request *ledgerv1.CreateLedger
 		if err != nil {
 			return nil, err
 		}
+		if len(accs) != len(slices.Compact(slices.Sorted(slices.Values(parentAccountIDs)))) {
+			return nil, fmt.Errorf("%w: parent account does not exist", ledger.ErrAccountNotFound)
+		}
 		for _, acc := range accs {
-			if !acc.ParentAccountID.Valid {
+			if acc.ParentAccountID.Valid {
 				return nil, fmt.Errorf("%w: cannot use account %s as the parent account. The account is registered as a sub-account", ledger.ErrAccountHasParent, acc.AccountID)
 			}
+			if acc.AccountStatus != ledger.AccountStatusActive {
+				return nil, fmt.Errorf("%w: cannot use account %s as the parent account. The account is not active", ledger.ErrAccountInactive, acc.AccountID)
+			}
+		}
+	}
+	// The function scope of the request is invoked as the last pre-commit hook, after the hooks registered to the API.
+	preCommitHooks, postCommitHooks := a.hooks.accountsHooks()
+	if fn != nil {
+		preCommitHooks = append(preCommitHooks, PreCommitHook[[]ledger.AccountInfo]{
+			Name: "create_accounts",
+			Fn:   fn,
+		})
+	}
+	if len(preCommitHooks) == 0 {
+		if err := a.queries.CreateLedgerAccounts(ctx, createReqs...); err != nil {
+			return nil, err
+		}
+	} else {
+		err := a.queries.Postgres().Transact(ctx, sql.LevelReadCommitted, func(ctx context.Context, p *postgres.Postgres) error {
+			if err := ledgerpg.New(p).CreateLedgerAccounts(ctx, createReqs...); err != nil {
+				return err
+			}
+			return runPreCommitHooks(ctx, a.hooks, hookEventAccounts, p, preCommitHooks, accountInfo)
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
-	if fn == nil {
-		// Nil out the account info as soon as possible because we don't need this in non-callback case.
-		accountInfo = nil
-		return resp, a.queries.CreateLedgerAccounts(ctx, createReqs...)
+	runPostCommitHooks(ctx, a.hooks, a.logger, hookEventAccounts, postCommitHooks, accountInfo)
+	return resp, nil
+}
+
+func (a *API) GetAccountsBalance(ctx context.Context, req *ledgerv1.GetAccountsBalanceRequest) (*ledgerv1.GetAccountsBalanceResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	balances, err := a.queries.GetAccountsBalance(ctx, req.AccountIds)
+	if err != nil {
+		return nil, err
+	}
+	// The balance of the sharded account is the total balance of the account and its balance shards.
+	addBalanceShards(balances)
+	// Use the length of the balances rather than the request because unknown accounts are not returned by the query, and nil
+	// message inside the response cannot be marshaled by the rpc framework.
+	resp := &ledgerv1.GetAccountsBalanceResponse{
+		Balances: make([]*ledgerv1.AccountBalance, len(balances)),
 	}
+	for idx, balance := range balances {
+		resp.Balances[idx] = newAccountBalance(balance)
+	}
+	return resp, nil
+}
 
-	err := a.queries.Postgres().Transact(ctx, sql.LevelReadCommitted, func(ctx context.Context, p *postgres.Postgres) error {
-		if err := ledgerpg.New(p).CreateLedgerAccounts(ctx, createReqs...); err != nil {
-			return err
+func newAccountBalance(balance ledgerpg.GetAccountsBalanceRow) *ledgerv1.AccountBalance {
+	return &ledgerv1.AccountBalance{
+		AccountId:        balance.AccountID,
+		Balance:          balance.Balance.String(),
+		AllowNegative:    balance.AllowNegative,
+		LastMovementId:   balance.LastMovementID,
+		LastLedgerId:     balance.LastLedgerID,
+		UpdatedAt:        timestamppb.New(balance.UpdatedAt.Time),
+		CurrencyId:       balance.CurrencyID,
+		ParentAccountId:  balance.ParentAccountID.String,
+		Status:           ledgerv1.AccountStatus(balance.AccountStatus),
+		HeldAmount:       balance.HeldAmount.String(),
+		AvailableBalance: balance.AvailableBalance().String(),
+		BalanceShards:    balance.BalanceShards,
+	}
+}
+
+// UpdateAccountStatus changes the status of an account. A frozen account can only receive money, while a closed account
+// cannot be a part of any movement. The status is changed after the ongoing movements of the account are finished, so no
+// movement can debit the account once the account is frozen.
+func (a *API) UpdateAccountStatus(ctx context.Context, req *ledgerv1.UpdateAccountStatusRequest) (*ledgerv1.UpdateAccountStatusResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	updatedAt := time.Now()
+	previousStatus, err := a.queries.ChangeAccountStatus(ctx, ledgerpg.ChangeAccountStatusParams{
+		AccountID: req.GetAccountId(),
+		Status:    int32(req.GetStatus()),
+		UpdatedAt: updatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ledgerv1.UpdateAccountStatusResponse{
+		AccountId:      req.GetAccountId(),
+		PreviousStatus: ledgerv1.AccountStatus(previousStatus),
+		Status:         req.GetStatus(),
+		UpdatedAt:      timestamppb.New(updatedAt),
+	}, nil
+}
+
+// GetAccountTree returns the account, its sub-accounts and the aggregated balance of the accounts per currency. As we only allow
+// one level of nesting, the sub-accounts are all accounts with the account as its parent. The balance shards are not returned as
+// the sub-accounts, their balances are added to the balance of their sharded account instead.
+func (a *API) GetAccountTree(ctx context.Context, req *ledgerv1.GetAccountTreeRequest) (*ledgerv1.GetAccountTreeResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	tree, err := a.queries.GetAccountTreeBalances(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	treeAccountIDs := make([]string, len(tree))
+	for idx, balance := range tree {
+		treeAccountIDs[idx] = balance.AccountID
+	}
+	accounts, err := a.queries.GetAccounts(ctx, treeAccountIDs)
+	if err != nil {
+		return nil, err
+	}
+	balanceShards := make(map[string]struct{})
+	for _, account := range accounts {
+		if account.IsBalanceShard {
+			balanceShards[account.AccountID] = struct{}{}
 		}
-		_, err := await.Do(ctx, time.Second*3, accountInfo, func(ctx context.Context, info []ledger.AccountInfo) (any, error) {
-			return nil, fn(ctx, p, info)
-		}, await.WithTrace("ledger.createAccounts"))
-		return err
+	}
+	// Keep the order of the tree, as the sub-accounts are ordered by their creation time.
+	accountIDs := slices.DeleteFunc(treeAccountIDs, func(accountID string) bool {
+		_, ok := balanceShards[accountID]
+		return ok
 	})
+	balances, err := a.queries.GetAccountsBalance(ctx, accountIDs)
 	if err != nil {
 		return nil, err
 	}
+	// The balance of the sharded account is the total balance of the account and its balance shards.
+	addBalanceShards(balances)
+	balancesByAccID := make(map[string]ledgerpg.GetAccountsBalanceRow, len(balances))
+	for _, balance := range balances {
+		balancesByAccID[balance.AccountID] = balance
+	}
+
+	resp := &ledgerv1.GetAccountTreeResponse{}
+	totalBalances := make(map[int32]decimal.Decimal)
+	for _, accountID := range accountIDs {
+		balance, ok := balancesByAccID[accountID]
+		if !ok {
+			continue
+		}
+		if accountID == req.GetAccountId() {
+			resp.Account = newAccountBalance(balance)
+		} else {
+			resp.SubAccounts = append(resp.SubAccounts, newAccountBalance(balance))
+		}
+		totalBalances[balance.CurrencyID] = totalBalances[balance.CurrencyID].Add(balance.Balance)
+	}
+	if resp.Account == nil {
+		return nil, fmt.Errorf("%w: %s", ledger.ErrAccountNotFound, req.GetAccountId())
+	}
+
+	currencies := slices.Sorted(maps.Keys(totalBalances))
+	resp.TotalBalances = make([]*ledgerv1.GetAccountTreeResponse_CurrencyBalance, len(currencies))
+	for idx, currencyID := range currencies {
+		resp.TotalBalances[idx] = &ledgerv1.GetAccountTreeResponse_CurrencyBalance{
+			CurrencyId: currencyID,
+			Balance:    totalBalances[currencyID].String(),
+		}
+	}
 	return resp, nil
 }
 
-func (a *API) GetAccountsBalance(ctx context.Context, req *ledgerv1.GetAccountsBalanceRequest) (*ledgerv1.GetAccountsBalanceResponse, error) {
+// GetAccountsBalanceAt returns the balance of the accounts at a given point in time. The balance is resolved from the accounts_balance_history
+// so we don't need to replay the ledger entries to get the balance. The balances are ordered based on the account_ids in the request.
+//
+// The balance of the sharded account is the total balance of the account and its balance shards at the given time, and the movement of
+// the balance is the latest movement among them.
+func (a *API) GetAccountsBalanceAt(ctx context.Context, req *ledgerv1.GetAccountsBalanceAtRequest) (*ledgerv1.GetAccountsBalanceAtResponse, error) {
 	if err := validator.Validate(req); err != nil {
 		return nil, err
 	}
 
-	resp := &ledgerv1.GetAccountsBalanceResponse{
-		Balances: make([]*ledgerv1.AccountBalance, len(req.GetAccountIds())),
+	accounts, err := a.queries.GetAccounts(ctx, req.GetAccountIds())
+	if err != nil {
+		return nil, err
 	}
-	balances, err := a.queries.GetAccountsBalance(ctx, req.AccountIds)
+	if len(accounts) != len(req.GetAccountIds()) {
+		existingAccounts := make(map[string]struct{}, len(accounts))
+		for _, acc := range accounts {
+			existingAccounts[acc.AccountID] = struct{}{}
+		}
+		for _, accountID := range req.GetAccountIds() {
+			if _, ok := existingAccounts[accountID]; !ok {
+				return nil, fmt.Errorf("%w: %s", ledger.ErrAccountNotFound, accountID)
+			}
+		}
+	}
+
+	historyAccountIDs := slices.Clone(req.GetAccountIds())
+	shardAccountIDs := make(map[string][]string)
+	for _, account := range accounts {
+		for index := range account.BalanceShards {
+			shardAccountID := ledger.BalanceShardAccountID(account.AccountID, index)
+			shardAccountIDs[account.AccountID] = append(shardAccountIDs[account.AccountID], shardAccountID)
+			historyAccountIDs = append(historyAccountIDs, shardAccountID)
+		}
+	}
+	histories, err := a.queries.GetAccountsBalanceAt(ctx, ledgerpg.GetAccountsBalanceAtParams{
+		AccountIds: historyAccountIDs,
+		At:         req.GetAt().AsTime(),
+	})
 	if err != nil {
 		return nil, err
 	}
-	for idx, balance := range balances {
-		resp.Balances[idx] = &ledgerv1.AccountBalance{
-			AccountId:      balance.AccountID,
-			Balance:        balance.Balance.String(),
-			AllowNegative:  balance.AllowNegative,
-			LastMovementId: balance.LastMovementID,
-			LastLedgerId:   balance.LastLedgerID,
-			UpdatedAt:      timestamppb.New(balance.UpdatedAt.Time),
+	historiesByAccID := make(map[string]ledgerpg.GetAccountsBalanceAtRow, len(histories))
+	for _, history := range histories {
+		historiesByAccID[history.AccountID] = history
+	}
+
+	resp := &ledgerv1.GetAccountsBalanceAtResponse{
+		Balances: make([]*ledgerv1.GetAccountsBalanceAtResponse_Balance, len(req.GetAccountIds())),
+		At:       req.GetAt(),
+	}
+	for idx, accountID := range req.GetAccountIds() {
+		var (
+			balance decimal.Decimal
+			latest  ledgerpg.GetAccountsBalanceAtRow
+			found   bool
+		)
+		for _, id := range append([]string{accountID}, shardAccountIDs[accountID]...) {
+			history, ok := historiesByAccID[id]
+			if !ok {
+				continue
+			}
+			balance = balance.Add(history.Balance)
+			if !found || history.CreatedAt.After(latest.CreatedAt) {
+				latest = history
+			}
+			found = true
+		}
+		// The account doesn't have any movements at the given time, so the balance is zero.
+		if !found {
+			resp.Balances[idx] = &ledgerv1.GetAccountsBalanceAtResponse_Balance{
+				AccountId: accountID,
+				Balance:   decimal.Zero.String(),
+			}
+			continue
+		}
+		resp.Balances[idx] = &ledgerv1.GetAccountsBalanceAtResponse_Balance{
+			AccountId:   accountID,
+			Balance:     balance.String(),
+			MovementId:  latest.MovementID,
+			LedgerId:    latest.LedgerID,
+			BalanceTime: timestamppb.New(latest.CreatedAt),
+		}
+	}
+	return resp, nil
+}
+
+// defaultListAccountLedgerPageSize is the page size of ListAccountLedger when the page size is not set by the client.
+const defaultListAccountLedgerPageSize = 100
+
+// ListAccountLedger returns the ledger entries of an account between two timestamps along with the opening, closing and running balance.
+// The API uses keyset pagination on the internal_id of the ledger entries, the client need to pass the next_page_token to retrieve the
+// next page.
+func (a *API) ListAccountLedger(ctx context.Context, req *ledgerv1.ListAccountLedgerRequest) (*ledgerv1.ListAccountLedgerResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	fromTime := req.GetFromTime().AsTime()
+	toTime := req.GetToTime().AsTime()
+	if !toTime.After(fromTime) {
+		return nil, fmt.Errorf("%w: to_time must be after from_time", ledger.ErrInvalidTimeRange)
+	}
+	var afterInternalID int64
+	if req.GetPageToken() != "" {
+		var err error
+		afterInternalID, err = strconv.ParseInt(req.GetPageToken(), 10, 64)
+		if err != nil || afterInternalID < 0 {
+			return nil, fmt.Errorf("%w: %s", ledger.ErrInvalidPageToken, req.GetPageToken())
+		}
+	}
+	pageSize := req.GetPageSize()
+	if pageSize == 0 {
+		pageSize = defaultListAccountLedgerPageSize
+	}
+
+	accounts, err := a.queries.GetAccounts(ctx, []string{req.GetAccountId()})
+	if err != nil {
+		return nil, err
+	}
+	if len(accounts) == 0 {
+		return nil, fmt.Errorf("%w: %s", ledger.ErrAccountNotFound, req.GetAccountId())
+	}
+	// Retrieve one more entry than the page size to know whether we have the next page or not.
+	statement, err := a.queries.GetAccountLedgerStatement(ctx, ledgerpg.GetAccountLedgerStatementParams{
+		AccountID:       req.GetAccountId(),
+		FromTime:        fromTime,
+		ToTime:          toTime,
+		AfterInternalID: afterInternalID,
+		Limit:           pageSize + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ledgerv1.ListAccountLedgerResponse{
+		AccountId:      req.GetAccountId(),
+		OpeningBalance: statement.OpeningBalance.String(),
+		ClosingBalance: statement.ClosingBalance.String(),
+	}
+	ledgers := statement.Ledgers
+	if len(ledgers) > int(pageSize) {
+		ledgers = ledgers[:pageSize]
+		resp.NextPageToken = strconv.FormatInt(ledgers[len(ledgers)-1].InternalID, 10)
+	}
+	resp.Entries = make([]*ledgerv1.ListAccountLedgerResponse_Entry, len(ledgers))
+	runningBalance := statement.PageOpeningBalance
+	for idx, l := range ledgers {
+		runningBalance = runningBalance.Add(l.Amount)
+		resp.Entries[idx] = &ledgerv1.ListAccountLedgerResponse_Entry{
+			LedgerId:         l.LedgerID,
+			MovementId:       l.MovementID,
+			MovementSequence: l.MovementSequence,
+			Amount:           l.Amount.String(),
+			RunningBalance:   runningBalance.String(),
+			ClientId:         l.ClientID.String,
+			ReversalOf:       l.ReversalOf.String,
+			CreatedAt:        timestamppb.New(l.CreatedAt),
 		}
 	}
 	return resp, nil
@@ -6,6 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/studio-asd/go-example/internal/currency"
 	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
 	"github.com/studio-asd/go-example/services/ledger"
@@ -19,7 +23,7 @@ func TestCreateAccounts(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	api := New(th.Postgres())
+	api := New(th.Postgres(), Options{})
 
 	// Setup the test, we will create multiple accounts with and without parent account as the basis.
 	// We will use queries directly as it doesn't have any checks, so its easy to create the data.
@@ -43,6 +47,7 @@ func TestCreateAccounts(t *testing.T) {
 				AccountID:     "no_parent_inactive",
 				AllowNegative: false,
 				Currency:      currency.IDR,
+				Status:        ledger.AccountStatusClosed,
 				CreatedAt:     time.Now(),
 			},
 		}...,
@@ -147,3 +152,492 @@ func TestCreateAccounts(t *testing.T) {
 		})
 	}
 }
+
+func TestListAccountLedger(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+
+	resp := createSimpleTestAccounts(t, api)
+	testAccount := resp.GetAccounts()[0].GetAccountId()
+	depositAccount := resp.GetAccounts()[2].GetAccountId()
+
+	fromTime := time.Now()
+	// Create three movements of 100 to the test account so we have three entries for the account.
+	for _, key := range []string{"one", "two", "three"} {
+		if _, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+			IdempotencyKey: "list_account_ledger_" + key,
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{
+					FromAccountId: depositAccount,
+					ToAccountId:   testAccount,
+					Amount:        "100",
+				},
+			},
+		}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	toTime := time.Now().Add(time.Second)
+
+	firstPage, err := api.ListAccountLedger(context.Background(), &ledgerv1.ListAccountLedgerRequest{
+		AccountId: testAccount,
+		FromTime:  timestamppb.New(fromTime),
+		ToTime:    timestamppb.New(toTime),
+		PageSize:  2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if firstPage.GetOpeningBalance() != "0" || firstPage.GetClosingBalance() != "300" {
+		t.Fatalf("unexpected opening/closing balance %s/%s", firstPage.GetOpeningBalance(), firstPage.GetClosingBalance())
+	}
+	if len(firstPage.GetEntries()) != 2 {
+		t.Fatalf("expecting 2 entries but got %d", len(firstPage.GetEntries()))
+	}
+	if firstPage.GetNextPageToken() == "" {
+		t.Fatal("next page token is empty")
+	}
+
+	secondPage, err := api.ListAccountLedger(context.Background(), &ledgerv1.ListAccountLedgerRequest{
+		AccountId: testAccount,
+		FromTime:  timestamppb.New(fromTime),
+		ToTime:    timestamppb.New(toTime),
+		PageSize:  2,
+		PageToken: firstPage.GetNextPageToken(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(secondPage.GetEntries()) != 1 {
+		t.Fatalf("expecting 1 entry but got %d", len(secondPage.GetEntries()))
+	}
+	if secondPage.GetNextPageToken() != "" {
+		t.Fatalf("expecting empty next page token but got %s", secondPage.GetNextPageToken())
+	}
+
+	var runningBalances []string
+	for _, entry := range append(firstPage.GetEntries(), secondPage.GetEntries()...) {
+		runningBalances = append(runningBalances, entry.GetRunningBalance())
+	}
+	if diff := cmp.Diff([]string{"100", "200", "300"}, runningBalances); diff != "" {
+		t.Fatalf("(-want/+got)\n%s", diff)
+	}
+
+	t.Run("invalid_time_range", func(t *testing.T) {
+		_, err := api.ListAccountLedger(context.Background(), &ledgerv1.ListAccountLedgerRequest{
+			AccountId: testAccount,
+			FromTime:  timestamppb.New(toTime),
+			ToTime:    timestamppb.New(fromTime),
+		})
+		if !errors.Is(err, ledger.ErrInvalidTimeRange) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrInvalidTimeRange, err)
+		}
+	})
+}
+
+func TestGetAccountsBalanceAt(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+
+	resp := createSimpleTestAccounts(t, api)
+	testAccount := resp.GetAccounts()[0].GetAccountId()
+	emptyAccount := resp.GetAccounts()[1].GetAccountId()
+	depositAccount := resp.GetAccounts()[2].GetAccountId()
+
+	transact := func(key string) *ledgerv1.TransactResponse {
+		t.Helper()
+		txResp, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+			IdempotencyKey: key,
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{
+					FromAccountId: depositAccount,
+					ToAccountId:   testAccount,
+					Amount:        "100",
+				},
+			},
+		}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return txResp
+	}
+	firstTx := transact("balance_at_one")
+	// The point in time is between the first and the second movement.
+	at := time.Now()
+	transact("balance_at_two")
+
+	balanceResp, err := api.GetAccountsBalanceAt(context.Background(), &ledgerv1.GetAccountsBalanceAtRequest{
+		AccountIds: []string{testAccount, emptyAccount},
+		At:         timestamppb.New(at),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := &ledgerv1.GetAccountsBalanceAtResponse{
+		Balances: []*ledgerv1.GetAccountsBalanceAtResponse_Balance{
+			{
+				AccountId:  testAccount,
+				Balance:    "100",
+				MovementId: firstTx.GetMovementId(),
+				LedgerId:   firstTx.GetLedgerEntries()[1].GetLedgerId(),
+			},
+			{
+				AccountId: emptyAccount,
+				Balance:   "0",
+			},
+		},
+		At: timestamppb.New(at),
+	}
+	ignoreTime := protocmp.IgnoreFields(&ledgerv1.GetAccountsBalanceAtResponse_Balance{}, "balance_time")
+	if diff := cmp.Diff(expect, balanceResp, ignoreTime, protocmp.Transform()); diff != "" {
+		t.Fatalf("(-want/+got)\n%s", diff)
+	}
+
+	t.Run("sharded_account", func(t *testing.T) {
+		if _, err := api.CreateBalanceShards(context.Background(), &ledgerv1.CreateBalanceShardsRequest{
+			AccountId: depositAccount,
+			Shards:    2,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		// The movements are recorded to the balance shards of the deposit account.
+		transact("balance_at_three")
+		lastTx := transact("balance_at_four")
+
+		balanceResp, err := api.GetAccountsBalanceAt(context.Background(), &ledgerv1.GetAccountsBalanceAtRequest{
+			AccountIds: []string{depositAccount},
+			At:         timestamppb.New(time.Now()),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var lastLedgerID string
+		for _, balance := range lastTx.GetEndingBalances() {
+			if balance.GetAccountId() != testAccount {
+				lastLedgerID = balance.GetLedgerId()
+			}
+		}
+		expect := &ledgerv1.GetAccountsBalanceAtResponse_Balance{
+			AccountId:  depositAccount,
+			Balance:    "-400",
+			MovementId: lastTx.GetMovementId(),
+			LedgerId:   lastLedgerID,
+		}
+		if diff := cmp.Diff(expect, balanceResp.GetBalances()[0], ignoreTime, protocmp.Transform()); diff != "" {
+			t.Fatalf("(-want/+got)\n%s", diff)
+		}
+	})
+
+	t.Run("account_not_found", func(t *testing.T) {
+		_, err := api.GetAccountsBalanceAt(context.Background(), &ledgerv1.GetAccountsBalanceAtRequest{
+			AccountIds: []string{"not_found"},
+			At:         timestamppb.New(at),
+		})
+		if !errors.Is(err, ledger.ErrAccountNotFound) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrAccountNotFound, err)
+		}
+	})
+}
+
+func TestGetAccountTree(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+
+	if err := api.queries.CreateLedgerAccounts(
+		context.Background(),
+		[]ledgerpg.CreateLedgerAccount{
+			{
+				AccountID: "main",
+				Currency:  currency.IDR,
+				CreatedAt: time.Now(),
+			},
+			{
+				AccountID:       "savings",
+				ParentAccountID: "main",
+				Currency:        currency.IDR,
+				CreatedAt:       time.Now(),
+			},
+			{
+				AccountID:       "savings_usd",
+				ParentAccountID: "main",
+				Currency:        currency.USD,
+				CreatedAt:       time.Now(),
+			},
+			{
+				AccountID:       "settlement",
+				ParentAccountID: "main",
+				AllowNegative:   true,
+				Currency:        currency.IDR,
+				CreatedAt:       time.Now(),
+			},
+			{
+				AccountID:     "deposit",
+				AllowNegative: true,
+				Currency:      currency.IDR,
+				CreatedAt:     time.Now(),
+			},
+		}...,
+	); err != nil {
+		t.Fatal(err)
+	}
+	// The balance shards of the settlement account are the sub-accounts of the settlement account, not the main account.
+	if _, err := api.CreateBalanceShards(context.Background(), &ledgerv1.CreateBalanceShardsRequest{
+		AccountId: "settlement",
+		Shards:    2,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+		IdempotencyKey: "account_tree",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{
+				FromAccountId: "deposit",
+				ToAccountId:   "main",
+				Amount:        "100",
+			},
+			{
+				FromAccountId: "deposit",
+				ToAccountId:   "savings",
+				Amount:        "50",
+			},
+			{
+				FromAccountId: "settlement",
+				ToAccountId:   "main",
+				Amount:        "30",
+			},
+		},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := api.GetAccountTree(context.Background(), &ledgerv1.GetAccountTreeRequest{AccountId: "main"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetAccount().GetAccountId() != "main" {
+		t.Fatalf("expecting account main but got %s", resp.GetAccount().GetAccountId())
+	}
+	if len(resp.GetSubAccounts()) != 3 {
+		t.Fatalf("expecting 3 sub-accounts but got %d", len(resp.GetSubAccounts()))
+	}
+	// The sharded sub-account reports the total balance of its balance shards.
+	settlement := resp.GetSubAccounts()[2]
+	if settlement.GetAccountId() != "settlement" || settlement.GetBalance() != "-30" || settlement.GetAvailableBalance() != "-30" ||
+		settlement.GetStatus() != ledgerv1.AccountStatus_ACCOUNT_STATUS_ACTIVE || settlement.GetBalanceShards() != 2 {
+		t.Fatalf("unexpected balance of the sharded sub-account: %v", settlement)
+	}
+	expectTotal := []*ledgerv1.GetAccountTreeResponse_CurrencyBalance{
+		{
+			CurrencyId: currency.IDR.ID,
+			Balance:    "150",
+		},
+		{
+			CurrencyId: currency.USD.ID,
+			Balance:    "0",
+		},
+	}
+	if diff := cmp.Diff(expectTotal, resp.GetTotalBalances(), protocmp.Transform()); diff != "" {
+		t.Fatalf("(-want/+got)\n%s", diff)
+	}
+
+	t.Run("sharded_account", func(t *testing.T) {
+		resp, err := api.GetAccountTree(context.Background(), &ledgerv1.GetAccountTreeRequest{AccountId: "settlement"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetAccount().GetBalance() != "-30" {
+			t.Fatalf("expecting balance -30 but got %s", resp.GetAccount().GetBalance())
+		}
+		// The balance shards are not returned as the sub-accounts.
+		if len(resp.GetSubAccounts()) != 0 {
+			t.Fatalf("expecting no sub-accounts but got %d", len(resp.GetSubAccounts()))
+		}
+		expectTotal := []*ledgerv1.GetAccountTreeResponse_CurrencyBalance{
+			{
+				CurrencyId: currency.IDR.ID,
+				Balance:    "-30",
+			},
+		}
+		if diff := cmp.Diff(expectTotal, resp.GetTotalBalances(), protocmp.Transform()); diff != "" {
+			t.Fatalf("(-want/+got)\n%s", diff)
+		}
+	})
+
+	t.Run("account_not_found", func(t *testing.T) {
+		_, err := api.GetAccountTree(context.Background(), &ledgerv1.GetAccountTreeRequest{AccountId: "not_found"})
+		if !errors.Is(err, ledger.ErrAccountNotFound) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrAccountNotFound, err)
+		}
+	})
+}
+
+func TestUpdateAccountStatus(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	accounts := createSimpleTestAccounts(t, api)
+	user, otherUser, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[1].AccountId, accounts.Accounts[2].AccountId
+
+	// The steps are executed sequentially as the status of the accounts is changed in each step.
+	steps := []struct {
+		name         string
+		status       *ledgerv1.UpdateAccountStatusRequest
+		transact     *ledgerv1.TransactRequest
+		expectStatus *ledgerv1.UpdateAccountStatusResponse
+		err          error
+	}{
+		{
+			name: "freeze account",
+			status: &ledgerv1.UpdateAccountStatusRequest{
+				AccountId: user,
+				Status:    ledgerv1.AccountStatus_ACCOUNT_STATUS_FROZEN,
+			},
+			expectStatus: &ledgerv1.UpdateAccountStatusResponse{
+				AccountId:      user,
+				PreviousStatus: ledgerv1.AccountStatus_ACCOUNT_STATUS_ACTIVE,
+				Status:         ledgerv1.AccountStatus_ACCOUNT_STATUS_FROZEN,
+			},
+		},
+		{
+			name: "credit frozen account",
+			transact: &ledgerv1.TransactRequest{
+				IdempotencyKey: "credit_frozen",
+				MovementEntries: []*ledgerv1.MovementEntry{
+					{FromAccountId: deposit, ToAccountId: user, Amount: "100"},
+				},
+			},
+		},
+		{
+			name: "debit frozen account",
+			transact: &ledgerv1.TransactRequest{
+				IdempotencyKey: "debit_frozen",
+				MovementEntries: []*ledgerv1.MovementEntry{
+					{FromAccountId: user, ToAccountId: otherUser, Amount: "50"},
+				},
+			},
+			err: ledger.ErrAccountFrozen,
+		},
+		{
+			name: "close account",
+			status: &ledgerv1.UpdateAccountStatusRequest{
+				AccountId: otherUser,
+				Status:    ledgerv1.AccountStatus_ACCOUNT_STATUS_CLOSED,
+			},
+			expectStatus: &ledgerv1.UpdateAccountStatusResponse{
+				AccountId:      otherUser,
+				PreviousStatus: ledgerv1.AccountStatus_ACCOUNT_STATUS_ACTIVE,
+				Status:         ledgerv1.AccountStatus_ACCOUNT_STATUS_CLOSED,
+			},
+		},
+		{
+			name: "credit closed account",
+			transact: &ledgerv1.TransactRequest{
+				IdempotencyKey: "credit_closed",
+				MovementEntries: []*ledgerv1.MovementEntry{
+					{FromAccountId: deposit, ToAccountId: otherUser, Amount: "100"},
+				},
+			},
+			err: ledger.ErrAccountClosed,
+		},
+		{
+			name: "closed to frozen",
+			status: &ledgerv1.UpdateAccountStatusRequest{
+				AccountId: otherUser,
+				Status:    ledgerv1.AccountStatus_ACCOUNT_STATUS_FROZEN,
+			},
+			err: ledger.ErrInvalidAccountStatusTransition,
+		},
+		{
+			name: "reopen account",
+			status: &ledgerv1.UpdateAccountStatusRequest{
+				AccountId: otherUser,
+				Status:    ledgerv1.AccountStatus_ACCOUNT_STATUS_ACTIVE,
+			},
+			expectStatus: &ledgerv1.UpdateAccountStatusResponse{
+				AccountId:      otherUser,
+				PreviousStatus: ledgerv1.AccountStatus_ACCOUNT_STATUS_CLOSED,
+				Status:         ledgerv1.AccountStatus_ACCOUNT_STATUS_ACTIVE,
+			},
+		},
+		{
+			name: "unfreeze account",
+			status: &ledgerv1.UpdateAccountStatusRequest{
+				AccountId: user,
+				Status:    ledgerv1.AccountStatus_ACCOUNT_STATUS_ACTIVE,
+			},
+			expectStatus: &ledgerv1.UpdateAccountStatusResponse{
+				AccountId:      user,
+				PreviousStatus: ledgerv1.AccountStatus_ACCOUNT_STATUS_FROZEN,
+				Status:         ledgerv1.AccountStatus_ACCOUNT_STATUS_ACTIVE,
+			},
+		},
+		{
+			name: "debit unfrozen account to reopened account",
+			transact: &ledgerv1.TransactRequest{
+				IdempotencyKey: "debit_unfrozen",
+				MovementEntries: []*ledgerv1.MovementEntry{
+					{FromAccountId: user, ToAccountId: otherUser, Amount: "50"},
+				},
+			},
+		},
+		{
+			name: "account not found",
+			status: &ledgerv1.UpdateAccountStatusRequest{
+				AccountId: "not_found",
+				Status:    ledgerv1.AccountStatus_ACCOUNT_STATUS_FROZEN,
+			},
+			err: ledger.ErrAccountNotFound,
+		},
+	}
+
+	for _, step := range steps {
+		if step.status != nil {
+			resp, err := api.UpdateAccountStatus(context.Background(), step.status)
+			if !errors.Is(err, step.err) {
+				t.Fatalf("%s: expecting error %v but got %v", step.name, step.err, err)
+			}
+			if err != nil {
+				continue
+			}
+			if diff := cmp.Diff(step.expectStatus, resp, protocmp.Transform(), protocmp.IgnoreFields(&ledgerv1.UpdateAccountStatusResponse{}, "updated_at")); diff != "" {
+				t.Fatalf("%s: (-want/+got)\n%s", step.name, diff)
+			}
+			continue
+		}
+		if _, err := api.Transact(context.Background(), step.transact, nil); !errors.Is(err, step.err) {
+			t.Fatalf("%s: expecting error %v but got %v", step.name, step.err, err)
+		}
+	}
+
+	resp, err := api.GetAccountsBalance(context.Background(), &ledgerv1.GetAccountsBalanceRequest{AccountIds: []string{user, otherUser}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, balance := range resp.GetBalances() {
+		if balance.GetStatus() != ledgerv1.AccountStatus_ACCOUNT_STATUS_ACTIVE {
+			t.Fatalf("expecting account %s to be active but got %s", balance.GetAccountId(), balance.GetStatus())
+		}
+		if balance.GetBalance() != "50" {
+			t.Fatalf("expecting account %s balance to be 50 but got %s", balance.GetAccountId(), balance.GetBalance())
+		}
+	}
+}
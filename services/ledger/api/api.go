@@ -2,8 +2,10 @@ package api
 
 import (
 	"context"
-	"database/sql"
+	"errors"
+	"fmt"
 	"log/slog"
+	"strconv"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,7 +13,6 @@ import (
 	"github.com/studio-asd/pkg/srun"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
-	"github.com/studio-asd/go-example/internal/await"
 	"github.com/studio-asd/go-example/internal/protovalidate"
 	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
 	"github.com/studio-asd/go-example/services/ledger"
@@ -32,6 +33,23 @@ func init() {
 			&ledgerv1.TransactRequest{},
 			&ledgerv1.CreateLedgerAccountsRequest_Account{},
 			&ledgerv1.GetAccountsBalanceRequest{},
+			&ledgerv1.ReverseMovementRequest{},
+			&ledgerv1.ListAccountLedgerRequest{},
+			&ledgerv1.GetAccountsBalanceAtRequest{},
+			&ledgerv1.GetAccountTreeRequest{},
+			&ledgerv1.UpdateAccountStatusRequest{},
+			&ledgerv1.AuthorizeMovementRequest{},
+			&ledgerv1.CaptureMovementRequest{},
+			&ledgerv1.VoidMovementRequest{},
+			&ledgerv1.PlaceHoldRequest{},
+			&ledgerv1.ReleaseHoldRequest{},
+			&ledgerv1.TransactFXRequest{},
+			&ledgerv1.CreateCurrencyRequest{},
+			&ledgerv1.CreateBalanceShardsRequest{},
+			&ledgerv1.WatchAccountsRequest{},
+			&ledgerv1.ScheduleMovementRequest{},
+			&ledgerv1.ListScheduledMovementsRequest{},
+			&ledgerv1.CancelScheduledMovementRequest{},
 		),
 	)
 	if err != nil {
@@ -40,13 +58,21 @@ func init() {
 }
 
 type API struct {
-	queries *ledgerpg.Queries
-	logger  *slog.Logger
+	queries  *ledgerpg.Queries
+	logger   *slog.Logger
+	options  Options
+	watchers *balanceWatchers
+	hooks    *hooks
 }
 
-func New(pg *postgres.Postgres) *API {
+func New(pg *postgres.Postgres, options Options) *API {
+	options = options.withDefaults()
 	return &API{
-		queries: ledgerpg.New(pg),
+		queries:  ledgerpg.New(pg),
+		logger:   slog.Default(),
+		options:  options,
+		watchers: newBalanceWatchers(),
+		hooks:    newHooks(options.HookTimeout),
 	}
 }
 
@@ -56,7 +82,10 @@ func (a *API) Name() string {
 
 func (a *API) Init(ctx srun.Context) error {
 	a.logger = ctx.Logger
-	return nil
+	// Load the currencies stored in the database, so the currencies added via CreateCurrency are available after restart.
+	loadCtx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+	return a.LoadCurrencies(loadCtx)
 }
 
 // GRPC returns the grpc api implementation of the ledger api.
@@ -69,18 +98,34 @@ func (a *API) Transact(ctx context.Context, req *ledgerv1.TransactRequest, fn fu
 	if err := validator.Validate(req); err != nil {
 		return nil, err
 	}
+	ctx, cancel := withDeadline(ctx, a.options.Deadlines.Transact)
+	defer cancel()
+	// Replay the original response if the movement with the same idempotency key is already recorded. This allows the client to safely
+	// retry the request when the previous request is timed out.
+	response, err := a.replayTransact(ctx, req)
+	if err == nil {
+		return response, nil
+	}
+	if !errors.Is(err, postgres.ErrNoRows) {
+		return nil, err
+	}
 
 	accounts := make([]string, len(req.GetMovementEntries())*2)
 	entries := req.GetMovementEntries()
 	for idx, entry := range entries {
-		accounts[idx] = entry.FromAccountId
-		accounts[idx+1] = entry.ToAccountId
+		accounts[idx*2] = entry.FromAccountId
+		accounts[idx*2+1] = entry.ToAccountId
 	}
 
 	accountsBalance, err := a.queries.GetAccountsBalanceMappedByAccID(ctx, accounts...)
 	if err != nil {
 		return nil, err
 	}
+	// Record the movements of the sharded accounts to their balance shards.
+	entries, err = a.routeBalanceShards(ctx, req.GetIdempotencyKey(), accountsBalance, entries)
+	if err != nil {
+		return nil, err
+	}
 	// Create a new UUID_V7 for movement_id.
 	uuidv7, err := uuid.NewV7()
 	if err != nil {
@@ -91,39 +136,41 @@ func (a *API) Transact(ctx context.Context, req *ledgerv1.TransactRequest, fn fu
 		return nil, err
 	}
 
+	// The function scope of the request is invoked as the last pre-commit hook, after the hooks registered to the API.
+	preCommitHooks, postCommitHooks := a.hooks.movementHooks()
+	if fn != nil {
+		preCommitHooks = append(preCommitHooks, PreCommitHook[ledger.MovementInfo]{
+			Name: "transact",
+			Fn:   fn,
+		})
+	}
+
 	var result internal.MovementResult
-	// If the additional function scope is not nil, then we should invoke the function inside a time-bounded
-	// goroutine as we don't know how much time the function will spent. So we need to ensure the function runs
-	// inside the Transact SLA.
-	if fn == nil {
-		result, err = a.queries.Move(ctx, ledgerEntries)
+	err = a.transact(ctx, func(ctx context.Context, q *ledgerpg.Queries) error {
+		result, err = q.Move(ctx, ledgerEntries)
 		if err != nil {
-			return nil, err
-		}
-	} else {
-		err = a.queries.WithTransact(ctx, sql.LevelReadCommitted, func(ctx context.Context, q *ledgerpg.Queries) error {
-			result, err = q.Move(ctx, ledgerEntries)
-			if err != nil {
-				return err
-			}
-			info := ledger.MovementInfo{
-				MovementID: result.MovementID,
-			}
-			err := q.Do(ctx, func(ctx context.Context, pg *postgres.Postgres) error {
-				_, err := await.Do(ctx, time.Second*3, info, func(ctx context.Context, info ledger.MovementInfo) (any, error) {
-					return nil, fn(ctx, pg, info)
-				})
-				return err
-			})
 			return err
-		})
-		if err != nil {
-			return nil, err
 		}
+		return runPreCommitHooks(ctx, a.hooks, hookEventMovement, q.Postgres(), preCommitHooks, ledger.MovementInfo{MovementID: result.MovementID})
+	})
+	if err != nil {
+		// The unique violation happens when another request with the same idempotency key is recorded concurrently. In this case
+		// we should return the response of the recorded movement.
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return a.replayTransact(ctx, req)
+		}
+		return nil, err
 	}
 
+	runPostCommitHooks(ctx, a.hooks, a.logger, hookEventMovement, postCommitHooks, ledger.MovementInfo{MovementID: result.MovementID})
+
 	// Construct the response. As the movement id and ledger ids are constructed beforehand, we only consruct the response
 	// after we know all operations is a success to not wasting compute resource.
+	return newTransactResponse(ledgerEntries, result), nil
+}
+
+// newTransactResponse constructs the transact response from the recorded ledger entries and the result of the movement.
+func newTransactResponse(ledgerEntries ledger.MovementLedgerEntries, result internal.MovementResult) *ledgerv1.TransactResponse {
 	response := &ledgerv1.TransactResponse{
 		MovementId:     ledgerEntries.MovementID,
 		TransactTime:   timestamppb.New(result.Time),
@@ -150,5 +197,206 @@ func (a *API) Transact(ctx context.Context, req *ledgerv1.TransactRequest, fn fu
 		}
 		counter++
 	}
-	return response, nil
+	return response
+}
+
+// replayTransact returns the original response of the movement recorded with the idempotency key of the request. The function
+// returns postgres.ErrNoRows if there is no movement recorded with the key, and ledger.ErrIdempotencyKeyConflict if the movement
+// entries are different from the recorded one.
+func (a *API) replayTransact(ctx context.Context, req *ledgerv1.TransactRequest) (*ledgerv1.TransactResponse, error) {
+	movement, err := a.queries.GetMovementByIdempotencyKey(ctx, req.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
+	ledgers, err := a.queries.GetMovementAccountsLedger(ctx, movement.MovementID)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := movementEntriesFromLedgers(ledgers)
+	if err != nil {
+		return nil, err
+	}
+	if !sameMovementEntries(entries, req.GetMovementEntries()) {
+		// The movement of the sharded account is recorded to its balance shard, so compare the entries again using the sharded account.
+		if err := a.unshardMovementEntries(ctx, entries); err != nil {
+			return nil, err
+		}
+		if !sameMovementEntries(entries, req.GetMovementEntries()) {
+			return nil, fmt.Errorf("%w: idempotency key %s", ledger.ErrIdempotencyKeyConflict, req.GetIdempotencyKey())
+		}
+	}
+	histories, err := a.queries.GetAccountsBalanceHistoryByMovementID(ctx, movement.MovementID)
+	if err != nil {
+		return nil, err
+	}
+	return newRecordedTransactResponse(movement, ledgers, histories), nil
+}
+
+// newRecordedTransactResponse constructs the transact response from the recorded movement, its ledger entries and its balance histories.
+func newRecordedTransactResponse(movement ledgerpg.GetMovementByIdempotencyKeyRow, ledgers []ledgerpg.AccountsLedger, histories []ledgerpg.AccountsBalanceHistory) *ledgerv1.TransactResponse {
+	response := &ledgerv1.TransactResponse{
+		MovementId:     movement.MovementID,
+		TransactTime:   timestamppb.New(movement.CreatedAt),
+		LedgerEntries:  make([]*ledgerv1.TransactResponse_LedgerEntry, len(ledgers)),
+		EndingBalances: make([]*ledgerv1.TransactResponse_Balance, len(histories)),
+	}
+	for idx, l := range ledgers {
+		response.LedgerEntries[idx] = &ledgerv1.TransactResponse_LedgerEntry{
+			LedgerId:         l.LedgerID,
+			ClientId:         l.ClientID.String,
+			MovementSequence: l.MovementSequence,
+		}
+	}
+	for idx, history := range histories {
+		response.EndingBalances[idx] = &ledgerv1.TransactResponse_Balance{
+			AccountId:          history.AccountID,
+			LedgerId:           history.LedgerID,
+			NewBalance:         history.Balance.String(),
+			PreviousBalance:    history.PreviousBalance.String(),
+			PreviousLedgerId:   history.PreviousLedgerID,
+			PreviousMovementId: history.PreviousMovementID,
+		}
+	}
+	return response
+}
+
+// ReverseMovement reverses a movement by creating a new movement with the opposite entries of the original movement. Each
+// of the new ledger entries refers to the ledger entry it reverses, and a movement can only be reversed once. A retried request
+// with the same idempotency key returns the recorded reversal.
+func (a *API) ReverseMovement(ctx context.Context, req *ledgerv1.ReverseMovementRequest) (*ledgerv1.ReverseMovementResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	ctx, cancel := withDeadline(ctx, a.options.Deadlines.ReverseMovement)
+	defer cancel()
+	// Replay the recorded reversal before checking whether the movement is reversed, as the movement is already reversed by the
+	// request with the same idempotency key.
+	response, err := a.replayReverseMovement(ctx, req)
+	if err == nil {
+		return response, nil
+	}
+	if !errors.Is(err, postgres.ErrNoRows) {
+		return nil, err
+	}
+
+	movement, err := a.queries.GetMovement(ctx, req.GetMovementId())
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, ledger.ErrMovementNotFound
+		}
+		return nil, err
+	}
+	// Check the reversal early so we don't have to construct the entries. The reversal flag will be checked again
+	// inside the data layer under lock.
+	if movement.ReversedAt.Valid {
+		return nil, ledger.ErrMovementAlreadyReversed
+	}
+	ledgers, err := a.queries.GetMovementAccountsLedger(ctx, req.GetMovementId())
+	if err != nil {
+		return nil, err
+	}
+	entries, err := createReversalMovementEntries(ledgers)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]string, len(entries)*2)
+	for idx, entry := range entries {
+		accounts[idx*2] = entry.FromAccountId
+		accounts[idx*2+1] = entry.ToAccountId
+	}
+	accountsBalance, err := a.queries.GetAccountsBalanceMappedByAccID(ctx, accounts...)
+	if err != nil {
+		return nil, err
+	}
+	shardParents, err := a.routeCreditOnlyShardDebits(ctx, accountsBalance, entries)
+	if err != nil {
+		return nil, err
+	}
+	// Create a new UUID_V7 for the reversal movement_id.
+	uuidv7, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	ledgerEntries, err := createLedgerEntries(uuidv7.String(), req.GetIdempotencyKey(), accountsBalance, entries...)
+	if err != nil {
+		return nil, err
+	}
+	// Link each of the reversal entries to the original ledger entry. The key is account_id:movement_sequence because the reversal
+	// keeps the same sequence as the original movement. The entry of the credit-only balance shard is reversed by its sharded account.
+	reversalOf := make(map[string]string, len(ledgers))
+	for _, l := range ledgers {
+		accountID := l.AccountID
+		if parent, ok := shardParents[accountID]; ok {
+			accountID = parent
+		}
+		reversalOf[accountID+":"+strconv.Itoa(int(l.MovementSequence))] = l.LedgerID
+	}
+	for idx, entry := range ledgerEntries.LedgerEntries {
+		entry.ReversalOf = reversalOf[entry.AccountID+":"+strconv.Itoa(entry.MovementSequence)]
+		ledgerEntries.LedgerEntries[idx] = entry
+	}
+
+	preCommitHooks, postCommitHooks := a.hooks.movementHooks()
+	var result internal.MovementResult
+	err = a.transact(ctx, func(ctx context.Context, q *ledgerpg.Queries) error {
+		result, err = q.ReverseMovement(ctx, ledgerEntries, ledgerpg.ReverseMovementParams{
+			MovementID:     req.GetMovementId(),
+			ReversalReason: req.GetReversalReason(),
+		})
+		if err != nil {
+			return err
+		}
+		return runPreCommitHooks(ctx, a.hooks, hookEventMovement, q.Postgres(), preCommitHooks, ledger.MovementInfo{MovementID: result.MovementID})
+	})
+	if err != nil {
+		// The unique violation happens when another reversal with the same idempotency key is recorded concurrently. In this case
+		// we should return the response of the recorded reversal.
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return a.replayReverseMovement(ctx, req)
+		}
+		return nil, err
+	}
+	runPostCommitHooks(ctx, a.hooks, a.logger, hookEventMovement, postCommitHooks, ledger.MovementInfo{MovementID: result.MovementID})
+	return newReverseMovementResponse(req.GetMovementId(), newTransactResponse(ledgerEntries, result)), nil
+}
+
+// replayReverseMovement returns the original response of the reversal recorded with the idempotency key of the request. The function
+// returns postgres.ErrNoRows if there is no movement recorded with the key, and ledger.ErrIdempotencyKeyConflict if the recorded
+// movement is not the reversal of the movement in the request.
+func (a *API) replayReverseMovement(ctx context.Context, req *ledgerv1.ReverseMovementRequest) (*ledgerv1.ReverseMovementResponse, error) {
+	reversal, err := a.queries.GetMovementByIdempotencyKey(ctx, req.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
+	movement, err := a.queries.GetMovement(ctx, req.GetMovementId())
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, fmt.Errorf("%w: idempotency key %s", ledger.ErrIdempotencyKeyConflict, req.GetIdempotencyKey())
+		}
+		return nil, err
+	}
+	if movement.ReversalMovementID.String != reversal.MovementID {
+		return nil, fmt.Errorf("%w: idempotency key %s", ledger.ErrIdempotencyKeyConflict, req.GetIdempotencyKey())
+	}
+	ledgers, err := a.queries.GetMovementAccountsLedger(ctx, reversal.MovementID)
+	if err != nil {
+		return nil, err
+	}
+	histories, err := a.queries.GetAccountsBalanceHistoryByMovementID(ctx, reversal.MovementID)
+	if err != nil {
+		return nil, err
+	}
+	return newReverseMovementResponse(movement.MovementID, newRecordedTransactResponse(reversal, ledgers, histories)), nil
+}
+
+// newReverseMovementResponse constructs the reverse movement response from the transact response of the reversal movement.
+func newReverseMovementResponse(movementID string, reversal *ledgerv1.TransactResponse) *ledgerv1.ReverseMovementResponse {
+	return &ledgerv1.ReverseMovementResponse{
+		MovementId:         movementID,
+		ReversalMovementId: reversal.GetMovementId(),
+		ReversedAt:         reversal.GetTransactTime(),
+		LedgerEntries:      reversal.GetLedgerEntries(),
+		EndingBalances:     reversal.GetEndingBalances(),
+	}
 }
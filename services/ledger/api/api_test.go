@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"testing"
 
@@ -24,7 +25,7 @@ func TestTransact(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	tl := New(th.Postgres())
+	tl := New(th.Postgres(), Options{})
 	tq := ledgerpg.New(th.Postgres())
 
 	newTableQuery := "CREATE TABLE IF NOT EXISTS trasact_test(id int PRIMARY KEY);"
@@ -149,7 +150,7 @@ func TestTransactCorrectness(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		a := New(th.Postgres())
+		a := New(th.Postgres(), Options{})
 
 		accountsResp, err := a.CreateAccounts(context.Background(), &ledgerv1.CreateLedgerAccountsRequest{
 			Accounts: []*ledgerv1.CreateLedgerAccountsRequest_Account{
@@ -245,6 +246,164 @@ func TestTransactCorrectness(t *testing.T) {
 	})
 }
 
+func TestTransactIdempotency(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := New(th.Postgres(), Options{})
+
+	resp := createSimpleTestAccounts(t, a)
+	testAccount := resp.GetAccounts()[0].GetAccountId()
+	depositAccount := resp.GetAccounts()[2].GetAccountId()
+
+	req := &ledgerv1.TransactRequest{
+		IdempotencyKey: "idempotency_test",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{
+				FromAccountId: depositAccount,
+				ToAccountId:   testAccount,
+				Amount:        "100",
+				ClientId:      "test_client_id",
+			},
+		},
+	}
+	txResp, err := a.Transact(context.Background(), req, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		replayResp, err := a.Transact(context.Background(), req, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sortEndingBalances := protocmp.SortRepeatedFields(&ledgerv1.TransactResponse{}, "ending_balances")
+		// Ignore the transact time as the precision of the time stored in the database is different.
+		ignoreTime := protocmp.IgnoreFields(&ledgerv1.TransactResponse{}, "transact_time")
+		if diff := cmp.Diff(txResp, replayResp, sortEndingBalances, ignoreTime, protocmp.Transform()); diff != "" {
+			t.Fatalf("(-want/+got)\n%s", diff)
+		}
+
+		// The balance should only be moved once.
+		balances, err := a.GetAccountsBalance(context.Background(), &ledgerv1.GetAccountsBalanceRequest{
+			AccountIds: []string{testAccount},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balances.GetBalances()[0].GetBalance() != "100" {
+			t.Fatalf("expecting balance of 100 but got %s", balances.GetBalances()[0].GetBalance())
+		}
+	})
+
+	t.Run("different_entries", func(t *testing.T) {
+		_, err := a.Transact(context.Background(), &ledgerv1.TransactRequest{
+			IdempotencyKey: "idempotency_test",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{
+					FromAccountId: depositAccount,
+					ToAccountId:   testAccount,
+					Amount:        "200",
+					ClientId:      "test_client_id",
+				},
+			},
+		}, nil)
+		if !errors.Is(err, ledger.ErrIdempotencyKeyConflict) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrIdempotencyKeyConflict, err)
+		}
+	})
+}
+
+func TestReverseMovement(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := New(th.Postgres(), Options{})
+
+	resp := createSimpleTestAccounts(t, a)
+	testAccount := resp.GetAccounts()[0].GetAccountId()
+	depositAccount := resp.GetAccounts()[2].GetAccountId()
+
+	txResp, err := a.Transact(context.Background(), &ledgerv1.TransactRequest{
+		IdempotencyKey: "reverse_test",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{
+				FromAccountId: depositAccount,
+				ToAccountId:   testAccount,
+				Amount:        "100",
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reverseResp, err := a.ReverseMovement(context.Background(), &ledgerv1.ReverseMovementRequest{
+		MovementId:     txResp.GetMovementId(),
+		ReversalReason: "test",
+		IdempotencyKey: "reverse_test_reversal",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reverseResp.GetReversalMovementId() == "" {
+		t.Fatal("reversal movement id is empty")
+	}
+
+	balances, err := a.GetAccountsBalance(context.Background(), &ledgerv1.GetAccountsBalanceRequest{
+		AccountIds: []string{testAccount, depositAccount},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, balance := range balances.GetBalances() {
+		if balance.GetBalance() != decimal.Zero.String() {
+			t.Fatalf("expecting zero balance for account %s but got %s", balance.GetAccountId(), balance.GetBalance())
+		}
+	}
+
+	// Retrying the reversal with the same idempotency key returns the recorded reversal.
+	replayed, err := a.ReverseMovement(context.Background(), &ledgerv1.ReverseMovementRequest{
+		MovementId:     txResp.GetMovementId(),
+		ReversalReason: "test",
+		IdempotencyKey: "reverse_test_reversal",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sortEndingBalances := protocmp.SortRepeatedFields(&ledgerv1.ReverseMovementResponse{}, "ending_balances")
+	// Ignore the reversal time as the precision of the time stored in the database is different.
+	ignoreTime := protocmp.IgnoreFields(&ledgerv1.ReverseMovementResponse{}, "reversed_at")
+	if diff := cmp.Diff(reverseResp, replayed, sortEndingBalances, ignoreTime, protocmp.Transform()); diff != "" {
+		t.Fatalf("(-want/+got)\n%s", diff)
+	}
+
+	// Reversing the same movement twice is not allowed.
+	_, err = a.ReverseMovement(context.Background(), &ledgerv1.ReverseMovementRequest{
+		MovementId:     txResp.GetMovementId(),
+		ReversalReason: "test",
+		IdempotencyKey: "reverse_test_reversal_2",
+	})
+	if !errors.Is(err, ledger.ErrMovementAlreadyReversed) {
+		t.Fatalf("expecting error %v but got %v", ledger.ErrMovementAlreadyReversed, err)
+	}
+	// The idempotency key of the reversal can't be re-used to reverse the other movement.
+	_, err = a.ReverseMovement(context.Background(), &ledgerv1.ReverseMovementRequest{
+		MovementId:     "other_movement",
+		ReversalReason: "test",
+		IdempotencyKey: "reverse_test_reversal",
+	})
+	if !errors.Is(err, ledger.ErrIdempotencyKeyConflict) {
+		t.Fatalf("expecting error %v but got %v", ledger.ErrIdempotencyKeyConflict, err)
+	}
+}
+
 func createSimpleTestAccounts(t *testing.T, api *API) *ledgerv1.CreateLedgerAccountsResponse {
 	t.Helper()
 	accountsResp, err := api.CreateAccounts(context.Background(), &ledgerv1.CreateLedgerAccountsRequest{
@@ -0,0 +1,216 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/studio-asd/pkg/postgres"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	internal "github.com/studio-asd/go-example/services/ledger/internal"
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+const (
+	// transactBatchMaxSize is the maximum number of movements accepted in a single batch.
+	transactBatchMaxSize = 50000
+	// transactBatchGroupSize is the number of movements recorded inside a single database transaction. The accounts of the
+	// whole group are locked at once, so a bigger group means fewer lock rounds but longer locks for the accounts.
+	transactBatchGroupSize = 500
+)
+
+// TransactBatch records many independent movements. Unlike Transact, a failed movement doesn't fail the whole batch and
+// the result of each movement is reported separately in the same order of the requests. A group which cannot be recorded, for
+// example because the accounts cannot be locked, only fails the movements of the group.
+//
+// The movements are recorded in groups of transactBatchGroupSize, where the accounts balance of each group are only locked
+// once. The movements inside a group are applied in order, so a movement can use the balance moved by the previous movements
+// in the same batch. The balances are checked for each movement under lock after the previous movements are recorded, so a
+// failed movement never affects the balance check of the next movements.
+//
+// The movement hooks are invoked for each recorded movement, the pre-commit hooks inside the savepoint of the movement so a
+// rejected movement is reported in its result without failing the rest of the batch. The post-commit hooks are invoked after
+// the group of the movement is committed.
+func (a *API) TransactBatch(ctx context.Context, reqs []*ledgerv1.TransactRequest) (*ledgerv1.TransactBatchResponse, error) {
+	if len(reqs) == 0 {
+		return nil, ledger.ErrEmptyBatch
+	}
+	if len(reqs) > transactBatchMaxSize {
+		return nil, fmt.Errorf("%w: maximum of %d movements but got %d", ledger.ErrBatchTooLarge, transactBatchMaxSize, len(reqs))
+	}
+	ctx, cancel := withDeadline(ctx, a.options.Deadlines.TransactBatch)
+	defer cancel()
+
+	response := &ledgerv1.TransactBatchResponse{
+		Results: make([]*ledgerv1.TransactBatchResponse_Result, len(reqs)),
+	}
+	var (
+		// first maps the idempotency keys in the batch to the index of their first occurrence, so the movement with the same
+		// key is only recorded once.
+		first      = make(map[string]int, len(reqs))
+		pending    []int
+		duplicates []int
+	)
+	for idx, req := range reqs {
+		response.Results[idx] = &ledgerv1.TransactBatchResponse_Result{
+			// Its okay to cast the index to int32 as the batch size is limited.
+			Index:          int32(idx),
+			IdempotencyKey: req.GetIdempotencyKey(),
+		}
+		if err := validator.Validate(req); err != nil {
+			response.Results[idx].Error = err.Error()
+			continue
+		}
+		if _, ok := first[req.GetIdempotencyKey()]; ok {
+			duplicates = append(duplicates, idx)
+			continue
+		}
+		first[req.GetIdempotencyKey()] = idx
+		pending = append(pending, idx)
+	}
+	preCommitHooks, postCommitHooks := a.hooks.movementHooks()
+	var replays []int
+	for begin := 0; begin < len(pending); begin += transactBatchGroupSize {
+		group := pending[begin:min(begin+transactBatchGroupSize, len(pending))]
+		groupReplays, err := a.transactBatchGroup(ctx, reqs, response.Results, group, preCommitHooks, postCommitHooks)
+		if err != nil {
+			// The previous groups are already committed, so the error is reported as the result of the movements in the group
+			// instead of failing the whole batch.
+			for _, idx := range group {
+				if result := response.Results[idx]; result.GetError() == "" && result.GetResponse() == nil {
+					result.Error = err.Error()
+				}
+			}
+			continue
+		}
+		replays = append(replays, groupReplays...)
+	}
+	// Replay the movements with the idempotency key which already recorded before the batch. The replay is done after all groups
+	// are recorded, so the movements recorded concurrently by other requests are already committed.
+	for _, idx := range replays {
+		a.replayTransactBatch(ctx, reqs[idx], response.Results[idx])
+	}
+	// The duplicates in the batch share the result of their first occurrence, and only replayed when the first occurrence is
+	// recorded.
+	for _, idx := range duplicates {
+		if firstResult := response.Results[first[reqs[idx].GetIdempotencyKey()]]; firstResult.GetError() != "" {
+			response.Results[idx].Error = firstResult.GetError()
+			continue
+		}
+		a.replayTransactBatch(ctx, reqs[idx], response.Results[idx])
+	}
+	for _, result := range response.Results {
+		if result.GetError() != "" {
+			response.Failed++
+			continue
+		}
+		response.Succeeded++
+	}
+	return response, nil
+}
+
+// replayTransactBatch replays the recorded movement of the request and writes the replayed response or error to the result.
+func (a *API) replayTransactBatch(ctx context.Context, req *ledgerv1.TransactRequest, result *ledgerv1.TransactBatchResponse_Result) {
+	replayed, err := a.replayTransact(ctx, req)
+	if err != nil {
+		result.Error = err.Error()
+		return
+	}
+	result.Response = replayed
+}
+
+// transactBatchGroup records a group of movements inside a single database transaction and writes the result of each movement
+// to the results. The group contains the index of the requests to be recorded. The movements which idempotency key is already
+// recorded are not recorded again, and the index of them are returned so they can be replayed.
+func (a *API) transactBatchGroup(ctx context.Context, reqs []*ledgerv1.TransactRequest, results []*ledgerv1.TransactBatchResponse_Result, group []int, preCommitHooks []PreCommitHook[ledger.MovementInfo], postCommitHooks []PostCommitHook[ledger.MovementInfo]) ([]int, error) {
+	var (
+		accounts []string
+		keys     = make([]string, len(group))
+		replays  []int
+	)
+	for i, idx := range group {
+		keys[i] = reqs[idx].GetIdempotencyKey()
+		for _, entry := range reqs[idx].GetMovementEntries() {
+			accounts = append(accounts, entry.GetFromAccountId(), entry.GetToAccountId())
+		}
+	}
+	recordedKeys, err := a.queries.GetRecordedIdempotencyKeys(ctx, keys)
+	if err != nil {
+		return nil, err
+	}
+	recorded := make(map[string]struct{}, len(recordedKeys))
+	for _, key := range recordedKeys {
+		recorded[key] = struct{}{}
+	}
+	accountsBalance, err := a.queries.GetAccountsBalanceMappedByAccID(ctx, accounts...)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		les []ledger.MovementLedgerEntries
+		// indexes maps the movements to the index of the requests.
+		indexes []int
+	)
+	for _, idx := range group {
+		req := reqs[idx]
+		if _, ok := recorded[req.GetIdempotencyKey()]; ok {
+			replays = append(replays, idx)
+			continue
+		}
+		// Create a new UUID_V7 for movement_id.
+		uuidv7, err := uuid.NewV7()
+		if err != nil {
+			return nil, err
+		}
+		// Record the movements of the sharded accounts to their balance shards.
+		entries, err := a.routeBalanceShards(ctx, req.GetIdempotencyKey(), accountsBalance, req.GetMovementEntries())
+		if err != nil {
+			return nil, err
+		}
+		// The balances are only checked by the data layer under lock, as the balances depend on whether the previous movements
+		// in the group are recorded. The data layer re-calculates the balances when the previous movements changed them.
+		le, err := createUncheckedLedgerEntries(uuidv7.String(), req.GetIdempotencyKey(), accountsBalance, entries...)
+		if err != nil {
+			results[idx].Error = err.Error()
+			continue
+		}
+		les = append(les, le)
+		indexes = append(indexes, idx)
+	}
+	if len(les) == 0 {
+		return replays, nil
+	}
+
+	var (
+		moveResults []internal.MovementResult
+		moveErrs    []error
+	)
+	err = a.transact(ctx, func(ctx context.Context, q *ledgerpg.Queries) error {
+		moveResults, moveErrs, err = q.MoveBatch(ctx, les, func(ctx context.Context, q *ledgerpg.Queries, result internal.MovementResult) error {
+			return runPreCommitHooks(ctx, a.hooks, hookEventMovement, q.Postgres(), preCommitHooks, ledger.MovementInfo{MovementID: result.MovementID})
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, idx := range indexes {
+		if moveErrs[i] == nil {
+			runPostCommitHooks(ctx, a.hooks, a.logger, hookEventMovement, postCommitHooks, ledger.MovementInfo{MovementID: moveResults[i].MovementID})
+			results[idx].Response = newTransactResponse(les[i], moveResults[i])
+			continue
+		}
+		// The unique violation happens when another request with the same idempotency key is recorded concurrently. In this
+		// case we should return the response of the recorded movement, the same as Transact.
+		if errors.Is(moveErrs[i], postgres.ErrUniqueViolation) {
+			replays = append(replays, idx)
+			continue
+		}
+		results[idx].Error = moveErrs[i].Error()
+	}
+	return replays, nil
+}
@@ -0,0 +1,241 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/studio-asd/pkg/postgres"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+func TestTransactBatch(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	accounts := createSimpleTestAccounts(t, api)
+	user, merchant, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[1].AccountId, accounts.Accounts[2].AccountId
+
+	reqs := []*ledgerv1.TransactRequest{
+		{
+			IdempotencyKey: "deposit",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: deposit, ToAccountId: user, Amount: "100"},
+			},
+		},
+		// The movement uses the balance deposited by the previous movement in the same batch.
+		{
+			IdempotencyKey: "pay",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "60"},
+			},
+		},
+		{
+			IdempotencyKey: "insufficient",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "50"},
+			},
+		},
+		// Invalid request as the idempotency key is empty.
+		{
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "10"},
+			},
+		},
+		// The same movement is replayed and not recorded twice.
+		{
+			IdempotencyKey: "pay",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "60"},
+			},
+		},
+		// The same movement shares the error of the failed movement.
+		{
+			IdempotencyKey: "insufficient",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "50"},
+			},
+		},
+	}
+	resp, err := api.TransactBatch(context.Background(), reqs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetSucceeded() != 3 || resp.GetFailed() != 3 {
+		t.Fatalf("expecting 3 succeeded and 3 failed movements but got %d and %d", resp.GetSucceeded(), resp.GetFailed())
+	}
+	for idx, expectError := range []bool{false, false, true, true, false, true} {
+		result := resp.GetResults()[idx]
+		if result.GetIndex() != int32(idx) {
+			t.Fatalf("expecting index %d but got %d", idx, result.GetIndex())
+		}
+		if (result.GetError() != "") != expectError {
+			t.Fatalf("result at index %d: expecting error %v but got %q", idx, expectError, result.GetError())
+		}
+	}
+	if resp.GetResults()[1].GetResponse().GetMovementId() != resp.GetResults()[4].GetResponse().GetMovementId() {
+		t.Fatal("expecting the same movement for the same idempotency key")
+	}
+	if resp.GetResults()[2].GetError() != resp.GetResults()[5].GetError() {
+		t.Fatalf("expecting the same error for the same idempotency key but got %q", resp.GetResults()[5].GetError())
+	}
+
+	balances, err := api.GetAccountsBalance(context.Background(), &ledgerv1.GetAccountsBalanceRequest{AccountIds: []string{user, merchant}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectBalances := map[string]string{
+		user:     "40",
+		merchant: "60",
+	}
+	for _, balance := range balances.GetBalances() {
+		if balance.GetBalance() != expectBalances[balance.GetAccountId()] {
+			t.Fatalf("expecting account %s balance to be %s but got %s", balance.GetAccountId(), expectBalances[balance.GetAccountId()], balance.GetBalance())
+		}
+	}
+}
+
+// TestTransactBatchRejectedMovement tests the movement which is rejected inside the database doesn't affect the balance check of
+// the next movements in the batch.
+func TestTransactBatchRejectedMovement(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	accounts := createSimpleTestAccounts(t, api)
+	user, merchant, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[1].AccountId, accounts.Accounts[2].AccountId
+	if _, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+		IdempotencyKey: "deposit",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{FromAccountId: deposit, ToAccountId: user, Amount: "100"},
+		},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reject the first movement of the batch inside the database.
+	errRejected := errors.New("rejected")
+	var rejected bool
+	api.AddMovementPreCommitHook(PreCommitHook[ledger.MovementInfo]{
+		Name: "reject_first",
+		Fn: func(ctx context.Context, tx *postgres.Postgres, info ledger.MovementInfo) error {
+			if !rejected {
+				rejected = true
+				return errRejected
+			}
+			return nil
+		},
+	})
+
+	resp, err := api.TransactBatch(context.Background(), []*ledgerv1.TransactRequest{
+		{
+			IdempotencyKey: "pay_rejected",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "100"},
+			},
+		},
+		// The movement can only be recorded because the previous movement is rejected.
+		{
+			IdempotencyKey: "pay",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "80"},
+			},
+		},
+		{
+			IdempotencyKey: "insufficient",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "30"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for idx, expectError := range []error{errRejected, nil, ledger.ErrInsufficientBalance} {
+		result := resp.GetResults()[idx]
+		if expectError == nil {
+			if result.GetError() != "" {
+				t.Fatalf("result at index %d: expecting no error but got %q", idx, result.GetError())
+			}
+			continue
+		}
+		if !strings.Contains(result.GetError(), expectError.Error()) {
+			t.Fatalf("result at index %d: expecting error %v but got %q", idx, expectError, result.GetError())
+		}
+	}
+
+	balances, err := api.GetAccountsBalance(context.Background(), &ledgerv1.GetAccountsBalanceRequest{AccountIds: []string{user, merchant}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectBalances := map[string]string{
+		user:     "20",
+		merchant: "80",
+	}
+	for _, balance := range balances.GetBalances() {
+		if balance.GetBalance() != expectBalances[balance.GetAccountId()] {
+			t.Fatalf("expecting account %s balance to be %s but got %s", balance.GetAccountId(), expectBalances[balance.GetAccountId()], balance.GetBalance())
+		}
+	}
+}
+
+// TestTransactBatchGroupError tests the error of recording a group of movements is reported as the result of the movements
+// instead of failing the batch.
+func TestTransactBatchGroupError(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	accounts := createSimpleTestAccounts(t, api)
+	user, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[2].AccountId
+
+	// Cancel the context while the group is recorded, so the transaction of the group fails.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	api.AddMovementPreCommitHook(PreCommitHook[ledger.MovementInfo]{
+		Name: "cancel",
+		Fn: func(ctx context.Context, tx *postgres.Postgres, info ledger.MovementInfo) error {
+			cancel()
+			return nil
+		},
+	})
+
+	resp, err := api.TransactBatch(ctx, []*ledgerv1.TransactRequest{
+		{
+			IdempotencyKey: "deposit_1",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: deposit, ToAccountId: user, Amount: "100"},
+			},
+		},
+		{
+			IdempotencyKey: "deposit_2",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: deposit, ToAccountId: user, Amount: "100"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetFailed() != 2 {
+		t.Fatalf("expecting 2 failed movements but got %d", resp.GetFailed())
+	}
+	for idx, result := range resp.GetResults() {
+		if result.GetError() == "" || result.GetResponse() != nil {
+			t.Fatalf("result at index %d: expecting error but got response %v", idx, result.GetResponse())
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/studio-asd/pkg/postgres"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+// LoadCurrencies loads the currencies stored in the database into the currency registry.
+func (a *API) LoadCurrencies(ctx context.Context) error {
+	stored, err := a.queries.ListCurrencies(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list currencies: %w", err)
+	}
+	list := make([]*currency.Currency, len(stored))
+	for idx, curr := range stored {
+		list[idx] = &currency.Currency{
+			ID:           curr.CurrencyID,
+			Name:         curr.CurrencyCode,
+			Exp:          curr.Exponent,
+			RoundingMode: currency.RoundingMode(curr.RoundingMode),
+			Active:       curr.IsActive,
+		}
+	}
+	return currency.Currencies.Load(list)
+}
+
+// CreateCurrency stores a new currency and registers the currency to the currency registry, so accounts can be created with
+// the currency right away. Other instances of the service load the currency on their next startup.
+func (a *API) CreateCurrency(ctx context.Context, req *ledgerv1.CreateCurrencyRequest) (*ledgerv1.CreateCurrencyResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	if _, err := currency.Currencies.GetByID(req.GetId()); err == nil {
+		return nil, fmt.Errorf("%w: currency with id %d", ledger.ErrCurrencyAlreadyExists, req.GetId())
+	}
+	if _, err := currency.Currencies.GetByName(req.GetName()); err == nil {
+		return nil, fmt.Errorf("%w: currency with name %s", ledger.ErrCurrencyAlreadyExists, req.GetName())
+	}
+
+	curr := &currency.Currency{
+		ID:           req.GetId(),
+		Name:         req.GetName(),
+		Exp:          req.GetExponent(),
+		RoundingMode: currency.RoundingMode(req.GetRoundingMode()),
+		Active:       req.GetActive(),
+	}
+	if err := a.queries.CreateCurrency(ctx, ledgerpg.CreateCurrencyParams{
+		CurrencyID:   curr.ID,
+		CurrencyCode: curr.Name,
+		Exponent:     curr.Exp,
+		RoundingMode: int32(curr.RoundingMode),
+		IsActive:     curr.Active,
+		CreatedAt:    time.Now(),
+	}); err != nil {
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return nil, fmt.Errorf("%w: currency with id %d or name %s", ledger.ErrCurrencyAlreadyExists, curr.ID, curr.Name)
+		}
+		return nil, err
+	}
+	if err := currency.Currencies.Register(curr); err != nil {
+		return nil, err
+	}
+	return &ledgerv1.CreateCurrencyResponse{
+		Currency: currencyToProto(curr),
+	}, nil
+}
+
+// ListCurrencies returns the currencies registered in the currency registry.
+func (a *API) ListCurrencies(ctx context.Context, req *ledgerv1.CurrencyListRequest) (*ledgerv1.CurrencyListResponse, error) {
+	list := currency.Currencies.List()
+	slices.SortFunc(list, func(a, b *currency.Currency) int {
+		return int(a.ID - b.ID)
+	})
+	resp := &ledgerv1.CurrencyListResponse{
+		Currencies: make([]*ledgerv1.Currency, len(list)),
+	}
+	for idx, curr := range list {
+		resp.Currencies[idx] = currencyToProto(curr)
+	}
+	return resp, nil
+}
+
+func currencyToProto(curr *currency.Currency) *ledgerv1.Currency {
+	return &ledgerv1.Currency{
+		Id:           curr.ID,
+		Name:         curr.Name,
+		Exponent:     curr.Exp,
+		RoundingMode: ledgerv1.RoundingMode(curr.RoundingMode),
+		Active:       curr.Active,
+	}
+}
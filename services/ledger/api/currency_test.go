@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+func TestCreateCurrency(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+
+	resp, err := api.CreateCurrency(context.Background(), &ledgerv1.CreateCurrencyRequest{
+		Id:           702,
+		Name:         "SGD",
+		Exponent:     2,
+		RoundingMode: ledgerv1.RoundingMode_ROUNDING_MODE_HALF_EVEN,
+		Active:       true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := &ledgerv1.Currency{
+		Id:           702,
+		Name:         "SGD",
+		Exponent:     2,
+		RoundingMode: ledgerv1.RoundingMode_ROUNDING_MODE_HALF_EVEN,
+		Active:       true,
+	}
+	if diff := cmp.Diff(expect, resp.GetCurrency(), protocmp.Transform()); diff != "" {
+		t.Fatalf("(-want/+got)\n%s", diff)
+	}
+	// The currency is registered and can be used to create accounts.
+	if _, err := currency.Currencies.GetByName("SGD"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.CreateAccounts(context.Background(), &ledgerv1.CreateLedgerAccountsRequest{
+		Accounts: []*ledgerv1.CreateLedgerAccountsRequest_Account{
+			{CurrencyId: 702},
+		},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = api.CreateCurrency(context.Background(), &ledgerv1.CreateCurrencyRequest{
+		Id:           702,
+		Name:         "SGD",
+		Exponent:     2,
+		RoundingMode: ledgerv1.RoundingMode_ROUNDING_MODE_HALF_EVEN,
+	})
+	if !errors.Is(err, ledger.ErrCurrencyAlreadyExists) {
+		t.Fatalf("expecting error %v but got %v", ledger.ErrCurrencyAlreadyExists, err)
+	}
+
+	// Accounts cannot be created with inactive currency.
+	if _, err := api.CreateCurrency(context.Background(), &ledgerv1.CreateCurrencyRequest{
+		Id:           764,
+		Name:         "THB",
+		Exponent:     2,
+		RoundingMode: ledgerv1.RoundingMode_ROUNDING_MODE_HALF_UP,
+		Active:       false,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	_, err = api.CreateAccounts(context.Background(), &ledgerv1.CreateLedgerAccountsRequest{
+		Accounts: []*ledgerv1.CreateLedgerAccountsRequest_Account{
+			{CurrencyId: 764},
+		},
+	}, nil)
+	if !errors.Is(err, currency.ErrCurrencyInactive) {
+		t.Fatalf("expecting error %v but got %v", currency.ErrCurrencyInactive, err)
+	}
+}
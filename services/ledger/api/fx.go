@@ -0,0 +1,241 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/studio-asd/pkg/postgres"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	internal "github.com/studio-asd/go-example/services/ledger/internal"
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+// TransactFX moves money between accounts with different currencies. The movement is recorded as two legs, the source account
+// to the FX clearing account of the source currency and the FX clearing account of the destination currency to the destination
+// account. This keeps each currency leg balanced while the conversion rate and both amounts are recorded on the movement.
+func (a *API) TransactFX(ctx context.Context, req *ledgerv1.TransactFXRequest) (*ledgerv1.TransactFXResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	ctx, cancel := withDeadline(ctx, a.options.Deadlines.TransactFX)
+	defer cancel()
+	amount, err := decimal.NewFromString(req.GetAmount())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ledger.ErrInvalidAmount, err)
+	}
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount must be positive", ledger.ErrInvalidAmount)
+	}
+	// Replay the original response if the movement with the same idempotency key is already recorded.
+	response, err := a.replayTransactFX(ctx, req, amount)
+	if err == nil {
+		return response, nil
+	}
+	if !errors.Is(err, postgres.ErrNoRows) {
+		return nil, err
+	}
+
+	accountsBalance, err := a.queries.GetAccountsBalanceMappedByAccID(ctx, req.GetFromAccountId(), req.GetToAccountId())
+	if err != nil {
+		return nil, err
+	}
+	for _, accountID := range []string{req.GetFromAccountId(), req.GetToAccountId()} {
+		if _, ok := accountsBalance[accountID]; !ok {
+			return nil, fmt.Errorf("%w: account %s does not exist", ledger.ErrAccountNotFound, accountID)
+		}
+	}
+	currFrom, err := currency.Currencies.GetByID(accountsBalance[req.GetFromAccountId()].CurrencyID)
+	if err != nil {
+		return nil, err
+	}
+	currTo, err := currency.Currencies.GetByID(accountsBalance[req.GetToAccountId()].CurrencyID)
+	if err != nil {
+		return nil, err
+	}
+	if currFrom.ID == currTo.ID {
+		return nil, ledger.ErrFXSameCurrency
+	}
+	rate, err := a.fxRate(ctx, req.GetRate(), currFrom, currTo)
+	if err != nil {
+		return nil, err
+	}
+	fromAmount := currFrom.NormalizeDecimal(amount)
+	// The converted amount is rounded using the rounding mode of the destination currency.
+	toAmount := currTo.Round(fromAmount.Mul(rate))
+	if !fromAmount.IsPositive() || !toAmount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount is too small to be converted from %s to %s", ledger.ErrInvalidAmount, currFrom.Name, currTo.Name)
+	}
+
+	fromClearingAccount := ledger.FXClearingAccountID(currFrom.ID)
+	toClearingAccount := ledger.FXClearingAccountID(currTo.ID)
+	clearingBalances, err := a.queries.GetAccountsBalanceMappedByAccID(ctx, fromClearingAccount, toClearingAccount)
+	if err != nil {
+		return nil, err
+	}
+	// Create the clearing accounts on the first movement of the currency pair.
+	if len(clearingBalances) != 2 {
+		if err := a.queries.EnsureFXClearingAccounts(ctx, currFrom, currTo); err != nil {
+			return nil, err
+		}
+		clearingBalances, err = a.queries.GetAccountsBalanceMappedByAccID(ctx, fromClearingAccount, toClearingAccount)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for accountID, balance := range clearingBalances {
+		accountsBalance[accountID] = balance
+	}
+
+	entries := []*ledgerv1.MovementEntry{
+		{
+			FromAccountId: req.GetFromAccountId(),
+			ToAccountId:   fromClearingAccount,
+			Amount:        fromAmount.String(),
+			ClientId:      req.GetClientId(),
+		},
+		{
+			FromAccountId: toClearingAccount,
+			ToAccountId:   req.GetToAccountId(),
+			Amount:        toAmount.String(),
+			ClientId:      req.GetClientId(),
+		},
+	}
+	// Record the movements of the sharded accounts, for example the clearing accounts, to their balance shards.
+	entries, err = a.routeBalanceShards(ctx, req.GetIdempotencyKey(), accountsBalance, entries)
+	if err != nil {
+		return nil, err
+	}
+	uuidv7, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	ledgerEntries, err := createLedgerEntries(uuidv7.String(), req.GetIdempotencyKey(), accountsBalance, entries...)
+	if err != nil {
+		return nil, err
+	}
+	preCommitHooks, postCommitHooks := a.hooks.movementHooks()
+	var result internal.MovementResult
+	err = a.transact(ctx, func(ctx context.Context, q *ledgerpg.Queries) error {
+		result, err = q.MoveFX(ctx, ledgerEntries, ledgerpg.MovementFX{
+			FromAccountID: req.GetFromAccountId(),
+			ToAccountID:   req.GetToAccountId(),
+			FromCurrency:  currFrom,
+			ToCurrency:    currTo,
+			FromAmount:    fromAmount,
+			ToAmount:      toAmount,
+			Rate:          rate,
+		})
+		if err != nil {
+			return err
+		}
+		return runPreCommitHooks(ctx, a.hooks, hookEventMovement, q.Postgres(), preCommitHooks, ledger.MovementInfo{MovementID: result.MovementID})
+	})
+	if err != nil {
+		// The unique violation happens when another request with the same idempotency key is recorded concurrently.
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return a.replayTransactFX(ctx, req, amount)
+		}
+		return nil, err
+	}
+	runPostCommitHooks(ctx, a.hooks, a.logger, hookEventMovement, postCommitHooks, ledger.MovementInfo{MovementID: result.MovementID})
+
+	response = &ledgerv1.TransactFXResponse{
+		MovementId:     ledgerEntries.MovementID,
+		FromCurrencyId: currFrom.ID,
+		ToCurrencyId:   currTo.ID,
+		FromAmount:     fromAmount.String(),
+		ToAmount:       toAmount.String(),
+		Rate:           rate.String(),
+		LedgerEntries:  make([]*ledgerv1.TransactResponse_LedgerEntry, len(ledgerEntries.LedgerEntries)),
+		TransactTime:   timestamppb.New(result.Time),
+	}
+	for idx, entry := range ledgerEntries.LedgerEntries {
+		response.LedgerEntries[idx] = &ledgerv1.TransactResponse_LedgerEntry{
+			LedgerId:         entry.LedgerID,
+			ClientId:         entry.ClientID,
+			MovementSequence: int32(entry.MovementSequence),
+		}
+	}
+	return response, nil
+}
+
+// fxRate returns the rate from the request if the rate is not empty, otherwise the rate is retrieved from the rate provider.
+func (a *API) fxRate(ctx context.Context, reqRate string, from, to *currency.Currency) (decimal.Decimal, error) {
+	var (
+		rate decimal.Decimal
+		err  error
+	)
+	if reqRate != "" {
+		rate, err = decimal.NewFromString(reqRate)
+		if err != nil {
+			return decimal.Zero, fmt.Errorf("%w: invalid rate: %v", ledger.ErrInvalidAmount, err)
+		}
+	} else {
+		if a.options.FXRateProvider == nil {
+			return decimal.Zero, fmt.Errorf("%w: no rate provider for %s to %s", ledger.ErrFXRateRequired, from.Name, to.Name)
+		}
+		rate, err = a.options.FXRateProvider.GetRate(ctx, from, to)
+		if err != nil {
+			return decimal.Zero, err
+		}
+	}
+	if !rate.IsPositive() {
+		return decimal.Zero, fmt.Errorf("%w: rate must be positive", ledger.ErrInvalidAmount)
+	}
+	return rate, nil
+}
+
+// replayTransactFX returns the original response of the cross-currency movement recorded with the idempotency key of the
+// request. The function returns postgres.ErrNoRows if there is no movement recorded with the key, and
+// ledger.ErrIdempotencyKeyConflict if the recorded movement is not the same cross-currency movement.
+func (a *API) replayTransactFX(ctx context.Context, req *ledgerv1.TransactFXRequest, amount decimal.Decimal) (*ledgerv1.TransactFXResponse, error) {
+	movement, err := a.queries.GetMovementByIdempotencyKey(ctx, req.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
+	fx, err := a.queries.GetMovementFX(ctx, movement.MovementID)
+	if err != nil {
+		// The idempotency key is used by a movement with a single currency.
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, fmt.Errorf("%w: idempotency key %s", ledger.ErrIdempotencyKeyConflict, req.GetIdempotencyKey())
+		}
+		return nil, err
+	}
+	currFrom, err := currency.Currencies.GetByID(fx.FromCurrencyID)
+	if err != nil {
+		return nil, err
+	}
+	if fx.FromAccountID != req.GetFromAccountId() || fx.ToAccountID != req.GetToAccountId() || !fx.FromAmount.Equal(currFrom.NormalizeDecimal(amount)) {
+		return nil, fmt.Errorf("%w: idempotency key %s", ledger.ErrIdempotencyKeyConflict, req.GetIdempotencyKey())
+	}
+	ledgers, err := a.queries.GetMovementAccountsLedger(ctx, movement.MovementID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ledgerv1.TransactFXResponse{
+		MovementId:     movement.MovementID,
+		FromCurrencyId: fx.FromCurrencyID,
+		ToCurrencyId:   fx.ToCurrencyID,
+		FromAmount:     fx.FromAmount.String(),
+		ToAmount:       fx.ToAmount.String(),
+		Rate:           fx.Rate.String(),
+		LedgerEntries:  make([]*ledgerv1.TransactResponse_LedgerEntry, len(ledgers)),
+		TransactTime:   timestamppb.New(movement.CreatedAt),
+	}
+	for idx, l := range ledgers {
+		response.LedgerEntries[idx] = &ledgerv1.TransactResponse_LedgerEntry{
+			LedgerId:         l.LedgerID,
+			ClientId:         l.ClientID.String,
+			MovementSequence: l.MovementSequence,
+		}
+	}
+	return response, nil
+}
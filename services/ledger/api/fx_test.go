@@ -0,0 +1,139 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+type staticFXRateProvider decimal.Decimal
+
+func (s staticFXRateProvider) GetRate(ctx context.Context, from, to *currency.Currency) (decimal.Decimal, error) {
+	return decimal.Decimal(s), nil
+}
+
+func TestTransactFX(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	// IDR accounts.
+	idrAccounts := createSimpleTestAccounts(t, api)
+	idrUser, idrDeposit := idrAccounts.Accounts[0].AccountId, idrAccounts.Accounts[2].AccountId
+	// USD account.
+	usdAccounts, err := api.CreateAccounts(context.Background(), &ledgerv1.CreateLedgerAccountsRequest{
+		Accounts: []*ledgerv1.CreateLedgerAccountsRequest_Account{
+			{
+				AllowNegative: false,
+				CurrencyId:    currency.USD.ID,
+			},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	usdUser := usdAccounts.Accounts[0].AccountId
+
+	if _, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+		IdempotencyKey: "deposit",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{FromAccountId: idrDeposit, ToAccountId: idrUser, Amount: "100000"},
+		},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	req := &ledgerv1.TransactFXRequest{
+		IdempotencyKey: "idr_to_usd",
+		FromAccountId:  idrUser,
+		ToAccountId:    usdUser,
+		Amount:         "50000",
+		Rate:           "0.0000625",
+	}
+	resp, err := api.TransactFX(context.Background(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetFromAmount() != "50000" || resp.GetToAmount() != "3.12" {
+		t.Fatalf("expecting from amount 50000 and to amount 3.12 but got %s and %s", resp.GetFromAmount(), resp.GetToAmount())
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		replayed, err := api.TransactFX(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if replayed.GetMovementId() != resp.GetMovementId() {
+			t.Fatalf("expecting movement %s but got %s", resp.GetMovementId(), replayed.GetMovementId())
+		}
+	})
+
+	t.Run("rate provider", func(t *testing.T) {
+		_, err := api.TransactFX(context.Background(), &ledgerv1.TransactFXRequest{
+			IdempotencyKey: "usd_to_idr_no_provider",
+			FromAccountId:  usdUser,
+			ToAccountId:    idrUser,
+			Amount:         "1",
+		})
+		if !errors.Is(err, ledger.ErrFXRateRequired) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrFXRateRequired, err)
+		}
+
+		api := New(th.Postgres(), Options{
+			FXRateProvider: staticFXRateProvider(decimal.NewFromInt(16000)),
+		})
+		resp, err := api.TransactFX(context.Background(), &ledgerv1.TransactFXRequest{
+			IdempotencyKey: "usd_to_idr",
+			FromAccountId:  usdUser,
+			ToAccountId:    idrUser,
+			Amount:         "1.5",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetToAmount() != "24000" {
+			t.Fatalf("expecting to amount 24000 but got %s", resp.GetToAmount())
+		}
+	})
+
+	t.Run("same currency", func(t *testing.T) {
+		_, err := api.TransactFX(context.Background(), &ledgerv1.TransactFXRequest{
+			IdempotencyKey: "idr_to_idr",
+			FromAccountId:  idrUser,
+			ToAccountId:    idrDeposit,
+			Amount:         "1000",
+			Rate:           "1",
+		})
+		if !errors.Is(err, ledger.ErrFXSameCurrency) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrFXSameCurrency, err)
+		}
+	})
+
+	balances, err := api.GetAccountsBalance(context.Background(), &ledgerv1.GetAccountsBalanceRequest{
+		AccountIds: []string{idrUser, usdUser, ledger.FXClearingAccountID(currency.IDR.ID), ledger.FXClearingAccountID(currency.USD.ID)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectBalances := map[string]string{
+		idrUser: "74000",
+		usdUser: "1.62",
+		// The clearing accounts hold the conversion position of each currency.
+		ledger.FXClearingAccountID(currency.IDR.ID): "26000",
+		ledger.FXClearingAccountID(currency.USD.ID): "-1.62",
+	}
+	for _, balance := range balances.GetBalances() {
+		if balance.GetBalance() != expectBalances[balance.GetAccountId()] {
+			t.Fatalf("expecting account %s balance to be %s but got %s", balance.GetAccountId(), expectBalances[balance.GetAccountId()], balance.GetBalance())
+		}
+	}
+}
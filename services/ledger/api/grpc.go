@@ -2,6 +2,10 @@ package api
 
 import (
 	"context"
+	"errors"
+	"io"
+
+	"google.golang.org/grpc"
 
 	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
 )
@@ -24,6 +28,107 @@ func newGRPC(api *API) *GRPC {
 func (g *GRPC) RegisterGateway(ctx context.Context) {
 }
 
-func (g *GRPC) Transact(context.Context, *ledgerv1.TransactRequest) (*ledgerv1.TransactResponse, error) {
-	return nil, nil
+func (g *GRPC) Transact(ctx context.Context, req *ledgerv1.TransactRequest) (*ledgerv1.TransactResponse, error) {
+	return g.api.Transact(ctx, req, nil)
+}
+
+// TransactBatch receives all transact requests from the client stream before recording the movements, so the movements
+// can be grouped together.
+func (g *GRPC) TransactBatch(stream grpc.ClientStreamingServer[ledgerv1.TransactRequest, ledgerv1.TransactBatchResponse]) error {
+	var reqs []*ledgerv1.TransactRequest
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		reqs = append(reqs, req)
+	}
+	response, err := g.api.TransactBatch(stream.Context(), reqs)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(response)
+}
+
+func (g *GRPC) WatchAccounts(req *ledgerv1.WatchAccountsRequest, stream grpc.ServerStreamingServer[ledgerv1.AccountBalanceChange]) error {
+	return g.api.WatchAccounts(stream.Context(), req, stream.Send)
+}
+
+func (g *GRPC) CreateAccounts(ctx context.Context, req *ledgerv1.CreateLedgerAccountsRequest) (*ledgerv1.CreateLedgerAccountsResponse, error) {
+	return g.api.CreateAccounts(ctx, req, nil)
+}
+
+func (g *GRPC) GetAccountsBalance(ctx context.Context, req *ledgerv1.GetAccountsBalanceRequest) (*ledgerv1.GetAccountsBalanceResponse, error) {
+	return g.api.GetAccountsBalance(ctx, req)
+}
+
+func (g *GRPC) ReverseMovement(ctx context.Context, req *ledgerv1.ReverseMovementRequest) (*ledgerv1.ReverseMovementResponse, error) {
+	return g.api.ReverseMovement(ctx, req)
+}
+
+func (g *GRPC) ListAccountLedger(ctx context.Context, req *ledgerv1.ListAccountLedgerRequest) (*ledgerv1.ListAccountLedgerResponse, error) {
+	return g.api.ListAccountLedger(ctx, req)
+}
+
+func (g *GRPC) GetAccountsBalanceAt(ctx context.Context, req *ledgerv1.GetAccountsBalanceAtRequest) (*ledgerv1.GetAccountsBalanceAtResponse, error) {
+	return g.api.GetAccountsBalanceAt(ctx, req)
+}
+
+func (g *GRPC) GetAccountTree(ctx context.Context, req *ledgerv1.GetAccountTreeRequest) (*ledgerv1.GetAccountTreeResponse, error) {
+	return g.api.GetAccountTree(ctx, req)
+}
+
+func (g *GRPC) UpdateAccountStatus(ctx context.Context, req *ledgerv1.UpdateAccountStatusRequest) (*ledgerv1.UpdateAccountStatusResponse, error) {
+	return g.api.UpdateAccountStatus(ctx, req)
+}
+
+func (g *GRPC) AuthorizeMovement(ctx context.Context, req *ledgerv1.AuthorizeMovementRequest) (*ledgerv1.AuthorizeMovementResponse, error) {
+	return g.api.AuthorizeMovement(ctx, req)
+}
+
+func (g *GRPC) CaptureMovement(ctx context.Context, req *ledgerv1.CaptureMovementRequest) (*ledgerv1.CaptureMovementResponse, error) {
+	return g.api.CaptureMovement(ctx, req)
+}
+
+func (g *GRPC) VoidMovement(ctx context.Context, req *ledgerv1.VoidMovementRequest) (*ledgerv1.VoidMovementResponse, error) {
+	return g.api.VoidMovement(ctx, req)
+}
+
+func (g *GRPC) PlaceHold(ctx context.Context, req *ledgerv1.PlaceHoldRequest) (*ledgerv1.PlaceHoldResponse, error) {
+	return g.api.PlaceHold(ctx, req)
+}
+
+func (g *GRPC) ReleaseHold(ctx context.Context, req *ledgerv1.ReleaseHoldRequest) (*ledgerv1.ReleaseHoldResponse, error) {
+	return g.api.ReleaseHold(ctx, req)
+}
+
+func (g *GRPC) TransactFX(ctx context.Context, req *ledgerv1.TransactFXRequest) (*ledgerv1.TransactFXResponse, error) {
+	return g.api.TransactFX(ctx, req)
+}
+
+func (g *GRPC) CreateCurrency(ctx context.Context, req *ledgerv1.CreateCurrencyRequest) (*ledgerv1.CreateCurrencyResponse, error) {
+	return g.api.CreateCurrency(ctx, req)
+}
+
+func (g *GRPC) ListCurrencies(ctx context.Context, req *ledgerv1.CurrencyListRequest) (*ledgerv1.CurrencyListResponse, error) {
+	return g.api.ListCurrencies(ctx, req)
+}
+
+func (g *GRPC) CreateBalanceShards(ctx context.Context, req *ledgerv1.CreateBalanceShardsRequest) (*ledgerv1.CreateBalanceShardsResponse, error) {
+	return g.api.CreateBalanceShards(ctx, req)
+}
+
+func (g *GRPC) ScheduleMovement(ctx context.Context, req *ledgerv1.ScheduleMovementRequest) (*ledgerv1.ScheduleMovementResponse, error) {
+	return g.api.ScheduleMovement(ctx, req)
+}
+
+func (g *GRPC) ListScheduledMovements(ctx context.Context, req *ledgerv1.ListScheduledMovementsRequest) (*ledgerv1.ListScheduledMovementsResponse, error) {
+	return g.api.ListScheduledMovements(ctx, req)
+}
+
+func (g *GRPC) CancelScheduledMovement(ctx context.Context, req *ledgerv1.CancelScheduledMovementRequest) (*ledgerv1.CancelScheduledMovementResponse, error) {
+	return g.api.CancelScheduledMovement(ctx, req)
 }
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+// PlaceHold holds the amount from the available balance of the account. The held amount stays in the account balance but cannot
+// be used by the movements until the hold is released with ReleaseHold.
+func (a *API) PlaceHold(ctx context.Context, req *ledgerv1.PlaceHoldRequest) (*ledgerv1.PlaceHoldResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	amount, err := decimal.NewFromString(req.GetAmount())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ledger.ErrInvalidAmount, err)
+	}
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: hold amount must be positive", ledger.ErrInvalidAmount)
+	}
+
+	balances, err := a.queries.GetAccountsBalanceMappedByAccID(ctx, req.GetAccountId())
+	if err != nil {
+		return nil, err
+	}
+	balance, ok := balances[req.GetAccountId()]
+	if !ok {
+		return nil, fmt.Errorf("%w: account %s does not exist", ledger.ErrAccountNotFound, req.GetAccountId())
+	}
+	curr, err := currency.Currencies.GetByID(balance.CurrencyID)
+	if err != nil {
+		return nil, err
+	}
+	uuidv7, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+
+	hold, available, err := a.queries.PlaceHold(ctx, ledgerpg.PlaceHoldParams{
+		HoldID:          uuidv7.String(),
+		AccountID:       req.GetAccountId(),
+		ClientReference: req.GetClientReference(),
+		// Normalize the amount based on the currency, because the exponent might be more than expected.
+		Amount:    curr.NormalizeDecimal(amount),
+		Reason:    req.GetReason(),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ledgerv1.PlaceHoldResponse{
+		HoldId:           hold.HoldID,
+		AccountId:        hold.AccountID,
+		ClientReference:  hold.ClientReference,
+		Amount:           hold.Amount.String(),
+		AvailableBalance: available.String(),
+		CreateTime:       timestamppb.New(hold.CreatedAt),
+	}, nil
+}
+
+// ReleaseHold releases the amount of the hold back to the available balance of the account.
+func (a *API) ReleaseHold(ctx context.Context, req *ledgerv1.ReleaseHoldRequest) (*ledgerv1.ReleaseHoldResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	hold, err := a.queries.ReleaseHold(ctx, ledgerpg.ReleaseHoldParams{
+		AccountID:       req.GetAccountId(),
+		ClientReference: req.GetClientReference(),
+		ReleasedAt:      time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ledgerv1.ReleaseHoldResponse{
+		HoldId:          hold.HoldID,
+		AccountId:       hold.AccountID,
+		ClientReference: hold.ClientReference,
+		Amount:          hold.Amount.String(),
+		ReleaseTime:     timestamppb.New(hold.ReleasedAt.Time),
+	}, nil
+}
@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+func TestHold(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	accounts := createSimpleTestAccounts(t, api)
+	user, merchant, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[1].AccountId, accounts.Accounts[2].AccountId
+
+	if _, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+		IdempotencyKey: "deposit",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{FromAccountId: deposit, ToAccountId: user, Amount: "100"},
+		},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	placeReq := &ledgerv1.PlaceHoldRequest{
+		AccountId:       user,
+		ClientReference: "card_authorization",
+		Amount:          "60",
+		Reason:          "card authorization",
+	}
+	placed, err := api.PlaceHold(context.Background(), placeReq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if placed.GetAvailableBalance() != "40" {
+		t.Fatalf("expecting available balance 40 but got %s", placed.GetAvailableBalance())
+	}
+
+	t.Run("replay place hold", func(t *testing.T) {
+		replayed, err := api.PlaceHold(context.Background(), placeReq)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if replayed.GetHoldId() != placed.GetHoldId() {
+			t.Fatalf("expecting hold %s but got %s", placed.GetHoldId(), replayed.GetHoldId())
+		}
+		_, err = api.PlaceHold(context.Background(), &ledgerv1.PlaceHoldRequest{
+			AccountId:       user,
+			ClientReference: "card_authorization",
+			Amount:          "10",
+		})
+		if !errors.Is(err, ledger.ErrHoldReferenceConflict) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrHoldReferenceConflict, err)
+		}
+	})
+
+	t.Run("held funds cannot be used", func(t *testing.T) {
+		_, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+			IdempotencyKey: "use_held",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "50"},
+			},
+		}, nil)
+		if !errors.Is(err, ledger.ErrInsufficientBalance) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrInsufficientBalance, err)
+		}
+		_, err = api.PlaceHold(context.Background(), &ledgerv1.PlaceHoldRequest{
+			AccountId:       user,
+			ClientReference: "dispute",
+			Amount:          "50",
+		})
+		if !errors.Is(err, ledger.ErrInsufficientBalance) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrInsufficientBalance, err)
+		}
+	})
+
+	t.Run("balance", func(t *testing.T) {
+		balances, err := api.GetAccountsBalance(context.Background(), &ledgerv1.GetAccountsBalanceRequest{AccountIds: []string{user}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		balance := balances.GetBalances()[0]
+		if balance.GetBalance() != "100" || balance.GetHeldAmount() != "60" || balance.GetAvailableBalance() != "40" {
+			t.Fatalf("expecting balance 100, held 60 and available 40 but got %s, %s and %s", balance.GetBalance(), balance.GetHeldAmount(), balance.GetAvailableBalance())
+		}
+	})
+
+	t.Run("release", func(t *testing.T) {
+		releaseReq := &ledgerv1.ReleaseHoldRequest{
+			AccountId:       user,
+			ClientReference: "card_authorization",
+		}
+		if _, err := api.ReleaseHold(context.Background(), releaseReq); err != nil {
+			t.Fatal(err)
+		}
+		_, err := api.ReleaseHold(context.Background(), releaseReq)
+		if !errors.Is(err, ledger.ErrHoldAlreadyReleased) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrHoldAlreadyReleased, err)
+		}
+		_, err = api.ReleaseHold(context.Background(), &ledgerv1.ReleaseHoldRequest{
+			AccountId:       user,
+			ClientReference: "unknown",
+		})
+		if !errors.Is(err, ledger.ErrHoldNotFound) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrHoldNotFound, err)
+		}
+		if _, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+			IdempotencyKey: "use_released",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "50"},
+			},
+		}, nil); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
@@ -0,0 +1,196 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/studio-asd/pkg/postgres"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+// defaultHookTimeout is the default of Options.HookTimeout.
+const defaultHookTimeout = time.Second * 3
+
+const (
+	hookStagePreCommit  = "pre_commit"
+	hookStagePostCommit = "post_commit"
+	hookEventMovement   = "movement"
+	hookEventAccounts   = "accounts"
+)
+
+// PreCommitHook is invoked inside the database transaction after the records are created, the transaction is rolled back if the hook
+// returns an error. The hook is invoked in the same goroutine of the transaction and the transaction is only finished after the hook
+// returns, so the hook can never write to the transaction after it is rolled back. The hook must respect the context deadline, as the
+// row locks of the transaction are held until the hook returns.
+type PreCommitHook[T any] struct {
+	// Name is the name of the hook, the name is used in the error and the metrics of the hook.
+	Name string
+	// Timeout is the deadline of the hook. The Options.HookTimeout is used when the timeout is not set.
+	Timeout time.Duration
+	Fn      func(ctx context.Context, tx *postgres.Postgres, info T) error
+}
+
+// PostCommitHook is invoked after the database transaction is committed. The error of the hook is logged and recorded in the metrics,
+// but the error is not returned to the caller as the records are already committed. The context of the hook is not canceled when the
+// request is canceled, so the hook is always invoked until its deadline.
+type PostCommitHook[T any] struct {
+	// Name is the name of the hook, the name is used in the log and the metrics of the hook.
+	Name string
+	// Timeout is the deadline of the hook. The Options.HookTimeout is used when the timeout is not set.
+	Timeout time.Duration
+	Fn      func(ctx context.Context, info T) error
+}
+
+// hooks stores the hooks registered to the API. The hooks can be registered while the API is serving requests, the requests that
+// already started are not affected by the newly registered hooks.
+type hooks struct {
+	mu                 sync.RWMutex
+	movementPreCommit  []PreCommitHook[ledger.MovementInfo]
+	movementPostCommit []PostCommitHook[ledger.MovementInfo]
+	accountsPreCommit  []PreCommitHook[[]ledger.AccountInfo]
+	accountsPostCommit []PostCommitHook[[]ledger.AccountInfo]
+	// defaultTimeout is the deadline of the hooks registered without timeout.
+	defaultTimeout time.Duration
+	// latency records the duration of the hooks in seconds.
+	latency metric.Float64Histogram
+}
+
+func newHooks(defaultTimeout time.Duration) *hooks {
+	latency, err := otel.Meter("github.com/studio-asd/go-example/services/ledger/api").Float64Histogram(
+		"ledger.hook.duration",
+		metric.WithDescription("The duration of the ledger pre-commit and post-commit hooks."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		latency = noop.Float64Histogram{}
+	}
+	return &hooks{
+		defaultTimeout: defaultTimeout,
+		latency:        latency,
+	}
+}
+
+// timeout returns the deadline of the hook.
+func (h *hooks) timeout(timeout time.Duration) time.Duration {
+	if timeout <= 0 {
+		return h.defaultTimeout
+	}
+	return timeout
+}
+
+func (h *hooks) movementHooks() ([]PreCommitHook[ledger.MovementInfo], []PostCommitHook[ledger.MovementInfo]) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return slices.Clip(h.movementPreCommit), slices.Clip(h.movementPostCommit)
+}
+
+func (h *hooks) accountsHooks() ([]PreCommitHook[[]ledger.AccountInfo], []PostCommitHook[[]ledger.AccountInfo]) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return slices.Clip(h.accountsPreCommit), slices.Clip(h.accountsPostCommit)
+}
+
+// record records the duration of the hook with the status of the hook.
+func (h *hooks) record(ctx context.Context, event, stage, name string, start time.Time, err error) {
+	status := "ok"
+	switch {
+	case errors.Is(err, ledger.ErrHookDeadlineExceeded):
+		status = "timeout"
+	case err != nil:
+		status = "error"
+	}
+	h.latency.Record(ctx, time.Since(start).Seconds(), metric.WithAttributes(
+		attribute.String("event", event),
+		attribute.String("stage", stage),
+		attribute.String("hook", name),
+		attribute.String("status", status),
+	))
+}
+
+// AddMovementPreCommitHook registers the hook to be invoked inside the transaction of every movement recorded via Transact,
+// TransactBatch, TransactFX, ReverseMovement and CaptureMovement. The hook is not invoked for the replayed movements.
+func (a *API) AddMovementPreCommitHook(hook PreCommitHook[ledger.MovementInfo]) {
+	a.hooks.mu.Lock()
+	defer a.hooks.mu.Unlock()
+	a.hooks.movementPreCommit = append(a.hooks.movementPreCommit, hook)
+}
+
+// AddMovementPostCommitHook registers the hook to be invoked after every movement recorded via Transact, TransactBatch, TransactFX,
+// ReverseMovement and CaptureMovement is committed. The hook is not invoked for the replayed movements.
+func (a *API) AddMovementPostCommitHook(hook PostCommitHook[ledger.MovementInfo]) {
+	a.hooks.mu.Lock()
+	defer a.hooks.mu.Unlock()
+	a.hooks.movementPostCommit = append(a.hooks.movementPostCommit, hook)
+}
+
+// AddAccountsPreCommitHook registers the hook to be invoked inside the transaction of the accounts created via CreateAccounts. The
+// hook is not invoked for the accounts created by the ledger itself, which are the FX clearing accounts and the balance shards.
+func (a *API) AddAccountsPreCommitHook(hook PreCommitHook[[]ledger.AccountInfo]) {
+	a.hooks.mu.Lock()
+	defer a.hooks.mu.Unlock()
+	a.hooks.accountsPreCommit = append(a.hooks.accountsPreCommit, hook)
+}
+
+// AddAccountsPostCommitHook registers the hook to be invoked after the accounts created via CreateAccounts are committed.
+func (a *API) AddAccountsPostCommitHook(hook PostCommitHook[[]ledger.AccountInfo]) {
+	a.hooks.mu.Lock()
+	defer a.hooks.mu.Unlock()
+	a.hooks.accountsPostCommit = append(a.hooks.accountsPostCommit, hook)
+}
+
+// runPreCommitHooks invokes the hooks in order and stops at the first error. The hook that returns after its deadline is treated as
+// timed out even if it doesn't return any error, because the hook might not finish its work.
+func runPreCommitHooks[T any](ctx context.Context, h *hooks, event string, tx *postgres.Postgres, hooks []PreCommitHook[T], info T) error {
+	for _, hook := range hooks {
+		start := time.Now()
+		err := invokeHook(ctx, h.timeout(hook.Timeout), func(ctx context.Context) error {
+			return hook.Fn(ctx, tx, info)
+		})
+		h.record(ctx, event, hookStagePreCommit, hook.Name, start, err)
+		if err != nil {
+			return fmt.Errorf("pre-commit hook %s: %w", hook.Name, err)
+		}
+	}
+	return nil
+}
+
+// runPostCommitHooks invokes all hooks in order. The error of the hook is only logged as the records are already committed.
+func runPostCommitHooks[T any](ctx context.Context, h *hooks, logger *slog.Logger, event string, hooks []PostCommitHook[T], info T) {
+	ctx = context.WithoutCancel(ctx)
+	for _, hook := range hooks {
+		start := time.Now()
+		err := invokeHook(ctx, h.timeout(hook.Timeout), func(ctx context.Context) error {
+			return hook.Fn(ctx, info)
+		})
+		h.record(ctx, event, hookStagePostCommit, hook.Name, start, err)
+		if err != nil {
+			logger.ErrorContext(ctx, "Post-commit hook failed", "event", event, "hook", hook.Name, "error", err)
+		}
+	}
+}
+
+// invokeHook invokes the function with the deadline of the timeout. The function is invoked in the same goroutine, so the function
+// never outlives the caller.
+func invokeHook(ctx context.Context, timeout time.Duration, fn func(ctx context.Context) error) error {
+	hookCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := fn(hookCtx)
+	if errors.Is(hookCtx.Err(), context.DeadlineExceeded) {
+		if err == nil {
+			return ledger.ErrHookDeadlineExceeded
+		}
+		return fmt.Errorf("%w: %w", ledger.ErrHookDeadlineExceeded, err)
+	}
+	return err
+}
@@ -0,0 +1,318 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/studio-asd/pkg/postgres"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+func TestInvokeHook(t *testing.T) {
+	t.Parallel()
+
+	errHook := errors.New("hook error")
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		fn      func(ctx context.Context) error
+		err     error
+	}{
+		{
+			name:    "success",
+			timeout: time.Second,
+			fn: func(ctx context.Context) error {
+				return nil
+			},
+		},
+		{
+			name:    "error",
+			timeout: time.Second,
+			fn: func(ctx context.Context) error {
+				return errHook
+			},
+			err: errHook,
+		},
+		{
+			name:    "deadline exceeded",
+			timeout: time.Millisecond * 50,
+			fn: func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			},
+			err: ledger.ErrHookDeadlineExceeded,
+		},
+		{
+			name:    "returned after deadline without error",
+			timeout: time.Millisecond * 50,
+			fn: func(ctx context.Context) error {
+				time.Sleep(time.Millisecond * 100)
+				return nil
+			},
+			err: ledger.ErrHookDeadlineExceeded,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := invokeHook(context.Background(), test.timeout, test.fn)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expecting error %v but got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestMovementHooks(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	accounts := createSimpleTestAccounts(t, api)
+	user, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[2].AccountId
+
+	errRejected := errors.New("rejected")
+	var postCommitMovements []string
+	api.AddMovementPreCommitHook(PreCommitHook[ledger.MovementInfo]{
+		Name:    "reject_timeout",
+		Timeout: time.Millisecond * 100,
+		Fn: func(ctx context.Context, tx *postgres.Postgres, info ledger.MovementInfo) error {
+			if ctx.Value(rejectKey{}) != nil {
+				return errRejected
+			}
+			if ctx.Value(timeoutKey{}) != nil {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			return nil
+		},
+	})
+	api.AddMovementPostCommitHook(PostCommitHook[ledger.MovementInfo]{
+		Name: "record",
+		Fn: func(ctx context.Context, info ledger.MovementInfo) error {
+			postCommitMovements = append(postCommitMovements, info.MovementID)
+			return nil
+		},
+	})
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+	}{
+		{
+			name: "rejected",
+			ctx:  context.WithValue(context.Background(), rejectKey{}, true),
+			err:  errRejected,
+		},
+		{
+			name: "timeout",
+			ctx:  context.WithValue(context.Background(), timeoutKey{}, true),
+			err:  ledger.ErrHookDeadlineExceeded,
+		},
+		{
+			name: "committed",
+			ctx:  context.Background(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := api.Transact(test.ctx, &ledgerv1.TransactRequest{
+				IdempotencyKey: "hook_" + test.name,
+				MovementEntries: []*ledgerv1.MovementEntry{
+					{FromAccountId: deposit, ToAccountId: user, Amount: "10"},
+				},
+			}, nil)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expecting error %v but got %v", test.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if len(postCommitMovements) != 1 || postCommitMovements[0] != resp.GetMovementId() {
+				t.Fatalf("expecting post-commit hook for movement %s but got %v", resp.GetMovementId(), postCommitMovements)
+			}
+		})
+	}
+
+	// Only the committed movement is recorded, the movements rejected by the hooks are rolled back.
+	balances, err := api.GetAccountsBalance(context.Background(), &ledgerv1.GetAccountsBalanceRequest{AccountIds: []string{user}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if balances.GetBalances()[0].GetBalance() != "10" {
+		t.Fatalf("expecting balance 10 but got %s", balances.GetBalances()[0].GetBalance())
+	}
+}
+
+func TestMovementHooksAllMovements(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	accounts := createSimpleTestAccounts(t, api)
+	user, merchant, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[1].AccountId, accounts.Accounts[2].AccountId
+	usdAccounts, err := api.CreateAccounts(context.Background(), &ledgerv1.CreateLedgerAccountsRequest{
+		Accounts: []*ledgerv1.CreateLedgerAccountsRequest_Account{
+			{CurrencyId: currency.USD.ID},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	usdUser := usdAccounts.Accounts[0].AccountId
+	if _, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+		IdempotencyKey: "deposit",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{FromAccountId: deposit, ToAccountId: user, Amount: "100000"},
+		},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+	// The movement to be reversed.
+	deposited, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+		IdempotencyKey: "deposit_merchant",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{FromAccountId: deposit, ToAccountId: merchant, Amount: "10"},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authorized, err := api.AuthorizeMovement(context.Background(), &ledgerv1.AuthorizeMovementRequest{
+		IdempotencyKey: "authorize",
+		ExpireTime:     timestamppb.New(time.Now().Add(time.Hour)),
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{FromAccountId: user, ToAccountId: merchant, Amount: "100"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errRejected := errors.New("rejected")
+	var preCommitMovements, postCommitMovements []string
+	api.AddMovementPreCommitHook(PreCommitHook[ledger.MovementInfo]{
+		Name: "reject",
+		Fn: func(ctx context.Context, tx *postgres.Postgres, info ledger.MovementInfo) error {
+			if ctx.Value(rejectKey{}) != nil {
+				return errRejected
+			}
+			preCommitMovements = append(preCommitMovements, info.MovementID)
+			return nil
+		},
+	})
+	api.AddMovementPostCommitHook(PostCommitHook[ledger.MovementInfo]{
+		Name: "record",
+		Fn: func(ctx context.Context, info ledger.MovementInfo) error {
+			postCommitMovements = append(postCommitMovements, info.MovementID)
+			return nil
+		},
+	})
+
+	tests := []struct {
+		name string
+		// fn records the movement and returns the id of the recorded movement.
+		fn func(ctx context.Context) (string, error)
+	}{
+		{
+			name: "reverse movement",
+			fn: func(ctx context.Context) (string, error) {
+				resp, err := api.ReverseMovement(ctx, &ledgerv1.ReverseMovementRequest{
+					MovementId:     deposited.GetMovementId(),
+					IdempotencyKey: "reverse",
+					ReversalReason: "hook",
+				})
+				return resp.GetReversalMovementId(), err
+			},
+		},
+		{
+			name: "transact fx",
+			fn: func(ctx context.Context) (string, error) {
+				resp, err := api.TransactFX(ctx, &ledgerv1.TransactFXRequest{
+					IdempotencyKey: "fx",
+					FromAccountId:  user,
+					ToAccountId:    usdUser,
+					Amount:         "16000",
+					Rate:           "0.0000625",
+				})
+				return resp.GetMovementId(), err
+			},
+		},
+		{
+			name: "capture movement",
+			fn: func(ctx context.Context) (string, error) {
+				resp, err := api.CaptureMovement(ctx, &ledgerv1.CaptureMovementRequest{
+					PendingMovementId: authorized.GetPendingMovementId(),
+				})
+				return resp.GetMovementId(), err
+			},
+		},
+		{
+			name: "transact batch",
+			fn: func(ctx context.Context) (string, error) {
+				resp, err := api.TransactBatch(ctx, []*ledgerv1.TransactRequest{
+					{
+						IdempotencyKey: "batch",
+						MovementEntries: []*ledgerv1.MovementEntry{
+							{FromAccountId: deposit, ToAccountId: merchant, Amount: "10"},
+						},
+					},
+				})
+				if err != nil {
+					return "", err
+				}
+				// The rejected movement only fails its own result.
+				if result := resp.GetResults()[0]; result.GetError() != "" {
+					return "", errors.New(result.GetError())
+				}
+				return resp.GetResults()[0].GetResponse().GetMovementId(), nil
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			preCommitMovements, postCommitMovements = nil, nil
+
+			_, err := test.fn(context.WithValue(context.Background(), rejectKey{}, true))
+			if err == nil {
+				t.Fatal("expecting the movement to be rejected by the pre-commit hook")
+			}
+			if len(postCommitMovements) != 0 {
+				t.Fatalf("expecting no post-commit hook for the rejected movement but got %v", postCommitMovements)
+			}
+
+			movementID, err := test.fn(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(preCommitMovements) == 0 || preCommitMovements[len(preCommitMovements)-1] != movementID {
+				t.Fatalf("expecting pre-commit hook for movement %s but got %v", movementID, preCommitMovements)
+			}
+			if len(postCommitMovements) == 0 || postCommitMovements[len(postCommitMovements)-1] != movementID {
+				t.Fatalf("expecting post-commit hook for movement %s but got %v", movementID, postCommitMovements)
+			}
+		})
+	}
+}
+
+type (
+	rejectKey  struct{}
+	timeoutKey struct{}
+)
@@ -56,7 +56,7 @@ func run(m *testing.M) (code int, err error) {
 			}
 		}()
 		testQueries = ledgerpg.New(testHelper.Postgres())
-		testAPI = New(testHelper.Postgres())
+		testAPI = New(testHelper.Postgres(), Options{})
 	}
 	code = m.Run()
 	return
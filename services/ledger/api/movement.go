@@ -17,6 +17,17 @@ import (
 // createLedgerEntries converts the initial movement entries to ledger entries, check and
 // summarize them into a correct entries.
 func createLedgerEntries(movementID, idempotencyKey string, balances map[string]ledgerpg.GetAccountsBalanceRow, entries ...*ledgerv1.MovementEntry) (ledger.MovementLedgerEntries, error) {
+	return newLedgerEntries(movementID, idempotencyKey, balances, true, entries...)
+}
+
+// createUncheckedLedgerEntries is the same as createLedgerEntries, but without checking the balances of the accounts. This is used
+// when the balances might be changed before the movement is recorded, for example by the previous movements in the batch, so the
+// balances are only checked by the data layer under lock.
+func createUncheckedLedgerEntries(movementID, idempotencyKey string, balances map[string]ledgerpg.GetAccountsBalanceRow, entries ...*ledgerv1.MovementEntry) (ledger.MovementLedgerEntries, error) {
+	return newLedgerEntries(movementID, idempotencyKey, balances, false, entries...)
+}
+
+func newLedgerEntries(movementID, idempotencyKey string, balances map[string]ledgerpg.GetAccountsBalanceRow, checkBalance bool, entries ...*ledgerv1.MovementEntry) (ledger.MovementLedgerEntries, error) {
 	le := ledger.MovementLedgerEntries{
 		MovementID:     movementID,
 		IdempotencyKey: idempotencyKey,
@@ -26,6 +37,7 @@ func createLedgerEntries(movementID, idempotencyKey string, balances map[string]
 	}
 
 	createdAt := time.Now()
+	le.CreatedAt = createdAt
 	for idx, entry := range entries {
 		// Check whether we have the correct currencies from and to account as we don't want to mix the currencies in the transfer.
 		currFrom, err := currency.Currencies.GetByID(balances[entry.GetFromAccountId()].CurrencyID)
@@ -37,10 +49,12 @@ func createLedgerEntries(movementID, idempotencyKey string, balances map[string]
 			return ledger.MovementLedgerEntries{}, err
 		}
 		if err := checkEligibleForMovement(checkEligible{
-			FromAccountID: entry.GetFromAccountId(),
-			ToAccountID:   entry.GetToAccountId(),
-			FromCurrency:  currFrom,
-			ToCurrency:    currTo,
+			FromAccountID:     entry.GetFromAccountId(),
+			ToAccountID:       entry.GetToAccountId(),
+			FromAccountStatus: balances[entry.GetFromAccountId()].AccountStatus,
+			ToAccountStatus:   balances[entry.GetToAccountId()].AccountStatus,
+			FromCurrency:      currFrom,
+			ToCurrency:        currTo,
 		}); err != nil {
 			return ledger.MovementLedgerEntries{}, fmt.Errorf("%w: please check entry at index [%d]", err, idx)
 		}
@@ -50,14 +64,14 @@ func createLedgerEntries(movementID, idempotencyKey string, balances map[string]
 			return ledger.MovementLedgerEntries{}, err
 		}
 		// Normalize the amount based on the currency, because the exponent might be more than expected.
-		amount := currFrom.NormalizeDecimal(dec)
+		amount := currency.NewMoney(currFrom.NormalizeDecimal(dec), currFrom)
 		// As we have two entries in the ledger in every movement entry, the starting index will be always idx*2.
 		arrIdx := idx * 2
 		// sequence is always stats from 1, this is why we add the idx(which began with 0) with 1.
 		sequence := idx + 1
 
 		// Create the DEBIT record.
-		debitAmount := amount.Mul(decimal.NewFromInt(-1))
+		debitAmount := amount.Neg()
 		// The ledger_id is a UUIDV5 with namespace_oid and format of: movement_id:from_account_id:sequence.
 		debitLedgerID := uuid.NewSHA1(uuid.NameSpaceOID, []byte(movementID+":"+entry.GetFromAccountId()+":"+strconv.Itoa(sequence))).String()
 		le.LedgerEntries[arrIdx] = ledger.LedgerEntry{
@@ -100,14 +114,22 @@ func createLedgerEntries(movementID, idempotencyKey string, balances map[string]
 		} else {
 			fromSummary = ledger.AccountMovementSummary{
 				LastLedgerID:  balances[entry.GetFromAccountId()].LastLedgerID,
-				EndingBalance: balances[entry.GetFromAccountId()].Balance,
+				EndingBalance: currency.NewMoney(balances[entry.GetFromAccountId()].Balance, currFrom),
 			}
 		}
 		fromSummary.NextLedgerID = debitLedgerID
-		fromSummary.BalanceChanges = fromSummary.BalanceChanges.Add(debitAmount)
-		fromSummary.EndingBalance = fromSummary.EndingBalance.Add(debitAmount)
-		// Check whether the ending balance is negative, we cannot allow negative balance for most the accounts.
-		if fromSummary.EndingBalance.IsNegative() && !balances[entry.GetFromAccountId()].AllowNegative {
+		fromSummary.BalanceChanges, err = fromSummary.BalanceChanges.Add(debitAmount)
+		if err != nil {
+			return ledger.MovementLedgerEntries{}, err
+		}
+		fromSummary.EndingBalance, err = fromSummary.EndingBalance.Add(debitAmount)
+		if err != nil {
+			return ledger.MovementLedgerEntries{}, err
+		}
+		// Check whether the available balance is negative, we cannot allow negative balance for most the accounts. The held and
+		// reserved amount of the account cannot be used by the movement, while the balance of the credit-only balance shards can.
+		fromBalance := balances[entry.GetFromAccountId()]
+		if checkBalance && fromSummary.EndingBalance.Amount().Add(fromBalance.ShardsBalance).Sub(fromBalance.HeldAmount).Sub(fromBalance.ReservedAmount).IsNegative() && !fromBalance.AllowNegative {
 			return ledger.MovementLedgerEntries{}, ledger.ErrInsufficientBalance
 		}
 		le.AccountsSummary[entry.GetFromAccountId()] = fromSummary
@@ -119,14 +141,20 @@ func createLedgerEntries(movementID, idempotencyKey string, balances map[string]
 		} else {
 			toSummary = ledger.AccountMovementSummary{
 				LastLedgerID:  balances[entry.GetToAccountId()].LastLedgerID,
-				EndingBalance: balances[entry.GetToAccountId()].Balance,
+				EndingBalance: currency.NewMoney(balances[entry.GetToAccountId()].Balance, currTo),
 			}
 		}
 		toSummary.NextLedgerID = creditLedgerID
-		toSummary.BalanceChanges = toSummary.BalanceChanges.Add(amount)
-		toSummary.EndingBalance = toSummary.EndingBalance.Add(amount)
+		toSummary.BalanceChanges, err = toSummary.BalanceChanges.Add(amount)
+		if err != nil {
+			return ledger.MovementLedgerEntries{}, err
+		}
+		toSummary.EndingBalance, err = toSummary.EndingBalance.Add(amount)
+		if err != nil {
+			return ledger.MovementLedgerEntries{}, err
+		}
 		// Check whether the ending balance is negative, we cannot allow negative balance for most the accounts.
-		if toSummary.EndingBalance.IsNegative() && !balances[entry.GetToAccountId()].AllowNegative {
+		if checkBalance && toSummary.EndingBalance.IsNegative() && !balances[entry.GetToAccountId()].AllowNegative {
 			return ledger.MovementLedgerEntries{}, ledger.ErrInsufficientBalance
 		}
 		le.AccountsSummary[entry.GetToAccountId()] = toSummary
@@ -134,6 +162,81 @@ func createLedgerEntries(movementID, idempotencyKey string, balances map[string]
 	return le, nil
 }
 
+// movementEntriesFromLedgers converts back the ledger entries of a movement to its movement entries. The ledger entries
+// are grouped by movement_sequence where the DEBIT account is the source and the CREDIT account is the destination.
+func movementEntriesFromLedgers(ledgers []ledgerpg.AccountsLedger) ([]*ledgerv1.MovementEntry, error) {
+	if len(ledgers) == 0 {
+		return nil, ledger.ErrEmptyEntries
+	}
+
+	var entries []*ledgerv1.MovementEntry
+	bySequence := make(map[int32]*ledgerv1.MovementEntry)
+	for _, l := range ledgers {
+		entry, ok := bySequence[l.MovementSequence]
+		if !ok {
+			entry = &ledgerv1.MovementEntry{
+				Amount: l.Amount.Abs().String(),
+			}
+			bySequence[l.MovementSequence] = entry
+			entries = append(entries, entry)
+		}
+		if l.ClientID.Valid {
+			entry.ClientId = l.ClientID.String
+		}
+		if l.Amount.IsNegative() {
+			entry.FromAccountId = l.AccountID
+		} else {
+			entry.ToAccountId = l.AccountID
+		}
+	}
+	for idx, entry := range entries {
+		if entry.FromAccountId == "" || entry.ToAccountId == "" {
+			return nil, fmt.Errorf("%w: invalid ledger entries for movement sequence %d", ledger.ErrAccountSourceOrDestinationEmpty, idx+1)
+		}
+	}
+	return entries, nil
+}
+
+// createReversalMovementEntries creates the movement entries to reverse the ledger entries of a movement. The money is moved
+// back from the CREDIT account to the DEBIT account for each of the movement_sequence.
+func createReversalMovementEntries(ledgers []ledgerpg.AccountsLedger) ([]*ledgerv1.MovementEntry, error) {
+	entries, err := movementEntriesFromLedgers(ledgers)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		entry.FromAccountId, entry.ToAccountId = entry.ToAccountId, entry.FromAccountId
+	}
+	return entries, nil
+}
+
+// sameMovementEntries checks whether the movement entries of a request is the same with the entries that already recorded
+// for a movement. The amount is compared by its value because the recorded amount might already be normalized.
+func sameMovementEntries(recorded, requested []*ledgerv1.MovementEntry) bool {
+	if len(recorded) != len(requested) {
+		return false
+	}
+	for idx := range recorded {
+		if recorded[idx].GetFromAccountId() != requested[idx].GetFromAccountId() ||
+			recorded[idx].GetToAccountId() != requested[idx].GetToAccountId() ||
+			recorded[idx].GetClientId() != requested[idx].GetClientId() {
+			return false
+		}
+		recordedAmount, err := decimal.NewFromString(recorded[idx].GetAmount())
+		if err != nil {
+			return false
+		}
+		requestedAmount, err := decimal.NewFromString(requested[idx].GetAmount())
+		if err != nil {
+			return false
+		}
+		if !recordedAmount.Equal(requestedAmount) {
+			return false
+		}
+	}
+	return true
+}
+
 type checkEligible struct {
 	FromAccountID     string
 	ToAccountID       string
@@ -156,5 +259,12 @@ func checkEligibleForMovement(ce checkEligible) error {
 	if ce.FromCurrency.ID != ce.ToCurrency.ID {
 		return ledger.ErrMismatchCurrencies
 	}
+	// Prevent the money to go out from a frozen account and prevent any movement to/from a closed account.
+	if err := ledger.CheckAccountStatusForMovement(ce.FromAccountStatus, true); err != nil {
+		return fmt.Errorf("%w: account %s", err, ce.FromAccountID)
+	}
+	if err := ledger.CheckAccountStatusForMovement(ce.ToAccountStatus, false); err != nil {
+		return fmt.Errorf("%w: account %s", err, ce.ToAccountID)
+	}
 	return nil
 }
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"database/sql"
 	"errors"
 	"strconv"
 	"testing"
@@ -9,6 +10,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/testing/protocmp"
 
 	"github.com/studio-asd/go-example/internal/currency"
 	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
@@ -63,25 +65,25 @@ func TestCreateLedgerEntries(t *testing.T) {
 						MovementID:       "one",
 						AccountID:        "one",
 						MovementSequence: 1,
-						Amount:           decimal.NewFromInt(-100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(-100), currency.IDR),
 					},
 					{
 						MovementID:       "one",
 						AccountID:        "two",
 						MovementSequence: 1,
-						Amount:           decimal.NewFromInt(100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					},
 				},
 				AccountsSummary: map[string]ledger.AccountMovementSummary{
 					"one": {
-						BalanceChanges: decimal.NewFromInt(-100),
+						BalanceChanges: currency.NewMoney(decimal.NewFromInt(-100), currency.IDR),
 						LastLedgerID:   "one_one",
-						EndingBalance:  decimal.Zero,
+						EndingBalance:  currency.NewMoney(decimal.Zero, currency.IDR),
 					},
 					"two": {
-						BalanceChanges: decimal.NewFromInt(100),
+						BalanceChanges: currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 						LastLedgerID:   "one_two",
-						EndingBalance:  decimal.NewFromInt(100),
+						EndingBalance:  currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					},
 				},
 				Accounts: []string{
@@ -138,42 +140,42 @@ func TestCreateLedgerEntries(t *testing.T) {
 						MovementID:       "one",
 						AccountID:        "one",
 						MovementSequence: 1,
-						Amount:           decimal.NewFromInt(-100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(-100), currency.IDR),
 					},
 					{
 						MovementID:       "one",
 						AccountID:        "two",
 						MovementSequence: 1,
-						Amount:           decimal.NewFromInt(100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					},
 					{
 						MovementID:       "one",
 						AccountID:        "one",
 						MovementSequence: 2,
-						Amount:           decimal.NewFromInt(-100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(-100), currency.IDR),
 					},
 					{
 						MovementID:       "one",
 						AccountID:        "three",
 						MovementSequence: 2,
-						Amount:           decimal.NewFromInt(100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					},
 				},
 				AccountsSummary: map[string]ledger.AccountMovementSummary{
 					"one": {
-						BalanceChanges: decimal.NewFromInt(-200),
+						BalanceChanges: currency.NewMoney(decimal.NewFromInt(-200), currency.IDR),
 						LastLedgerID:   "one_one",
-						EndingBalance:  decimal.NewFromInt(0),
+						EndingBalance:  currency.NewMoney(decimal.NewFromInt(0), currency.IDR),
 					},
 					"two": {
-						BalanceChanges: decimal.NewFromInt(100),
+						BalanceChanges: currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 						LastLedgerID:   "one_two",
-						EndingBalance:  decimal.NewFromInt(100),
+						EndingBalance:  currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					},
 					"three": {
-						BalanceChanges: decimal.NewFromInt(100),
+						BalanceChanges: currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 						LastLedgerID:   "one_three",
-						EndingBalance:  decimal.NewFromInt(100),
+						EndingBalance:  currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					},
 				},
 				Accounts: []string{
@@ -230,12 +232,12 @@ func TestCreateLedgerEntries(t *testing.T) {
 					Amount:        "100",
 				},
 				{
-					FromAccountId: "one",
+					FromAccountId: "three",
 					ToAccountId:   "four",
 					Amount:        "100",
 				},
 				{
-					FromAccountId: "one",
+					FromAccountId: "two",
 					ToAccountId:   "three",
 					Amount:        "100",
 				},
@@ -248,71 +250,71 @@ func TestCreateLedgerEntries(t *testing.T) {
 						MovementID:       "one",
 						AccountID:        "one",
 						MovementSequence: 1,
-						Amount:           decimal.NewFromInt(-100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(-100), currency.IDR),
 					},
 					{
 						MovementID:       "one",
 						AccountID:        "two",
 						MovementSequence: 1,
-						Amount:           decimal.NewFromInt(100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					},
 					{
 						MovementID:       "one",
 						AccountID:        "one",
 						MovementSequence: 2,
-						Amount:           decimal.NewFromInt(-100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(-100), currency.IDR),
 					},
 					{
 						MovementID:       "one",
 						AccountID:        "three",
 						MovementSequence: 2,
-						Amount:           decimal.NewFromInt(100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					},
 					{
 						MovementID:       "one",
 						AccountID:        "three",
 						MovementSequence: 3,
-						Amount:           decimal.NewFromInt(-100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(-100), currency.IDR),
 					},
 					{
 						MovementID:       "one",
 						AccountID:        "four",
 						MovementSequence: 3,
-						Amount:           decimal.NewFromInt(100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					},
 					{
 						MovementID:       "one",
 						AccountID:        "two",
 						MovementSequence: 4,
-						Amount:           decimal.NewFromInt(-100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(-100), currency.IDR),
 					},
 					{
 						MovementID:       "one",
 						AccountID:        "three",
 						MovementSequence: 4,
-						Amount:           decimal.NewFromInt(100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					},
 				},
 				AccountsSummary: map[string]ledger.AccountMovementSummary{
 					"one": {
-						BalanceChanges: decimal.NewFromInt(-200),
+						BalanceChanges: currency.NewMoney(decimal.NewFromInt(-200), currency.IDR),
 						LastLedgerID:   "one_one",
-						EndingBalance:  decimal.Zero,
+						EndingBalance:  currency.NewMoney(decimal.Zero, currency.IDR),
 					},
 					"two": {
-						BalanceChanges: decimal.NewFromInt(0),
+						BalanceChanges: currency.NewMoney(decimal.NewFromInt(0), currency.IDR),
 						LastLedgerID:   "one_two",
-						EndingBalance:  decimal.Zero,
+						EndingBalance:  currency.NewMoney(decimal.Zero, currency.IDR),
 					},
 					"three": {
-						BalanceChanges: decimal.NewFromInt(100),
+						BalanceChanges: currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 						LastLedgerID:   "one_three",
-						EndingBalance:  decimal.NewFromInt(100),
+						EndingBalance:  currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					},
 					"four": {
-						BalanceChanges: decimal.NewFromInt(100),
+						BalanceChanges: currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 						LastLedgerID:   "one_four",
-						EndingBalance:  decimal.NewFromInt(100),
+						EndingBalance:  currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					},
 				},
 				Accounts: []string{
@@ -348,7 +350,7 @@ func TestCreateLedgerEntries(t *testing.T) {
 			opts := []cmp.Option{
 				cmpopts.IgnoreFields(ledger.LedgerEntry{}, "CreatedAt", "Timestamp"),
 				// We ignore the account summary here because it is a map, and the order of the map is not deterministic.
-				cmpopts.IgnoreFields(ledger.MovementLedgerEntries{}, "AccountsSummary"),
+				cmpopts.IgnoreFields(ledger.MovementLedgerEntries{}, "AccountsSummary", "CreatedAt"),
 			}
 			if diff := cmp.Diff(test.expect, le, opts...); diff != "" {
 				t.Fatalf("(-want/+got)\n%s", diff)
@@ -367,6 +369,138 @@ func TestCreateLedgerEntries(t *testing.T) {
 	}
 }
 
+func TestCreateReversalMovementEntries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		ledgers []ledgerpg.AccountsLedger
+		expect  []*ledgerv1.MovementEntry
+		err     error
+	}{
+		{
+			name: "empty ledgers",
+			err:  ledger.ErrEmptyEntries,
+		},
+		{
+			name: "one account to many accounts",
+			ledgers: []ledgerpg.AccountsLedger{
+				{AccountID: "one", MovementSequence: 1, Amount: decimal.NewFromInt(-100)},
+				{AccountID: "two", MovementSequence: 1, Amount: decimal.NewFromInt(100), ClientID: sql.NullString{String: "client", Valid: true}},
+				{AccountID: "one", MovementSequence: 2, Amount: decimal.NewFromInt(-50)},
+				{AccountID: "three", MovementSequence: 2, Amount: decimal.NewFromInt(50)},
+			},
+			expect: []*ledgerv1.MovementEntry{
+				{
+					FromAccountId: "two",
+					ToAccountId:   "one",
+					Amount:        "100",
+					ClientId:      "client",
+				},
+				{
+					FromAccountId: "three",
+					ToAccountId:   "one",
+					Amount:        "50",
+				},
+			},
+		},
+		{
+			name: "incomplete sequence",
+			ledgers: []ledgerpg.AccountsLedger{
+				{AccountID: "one", MovementSequence: 1, Amount: decimal.NewFromInt(-100)},
+			},
+			err: ledger.ErrAccountSourceOrDestinationEmpty,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			entries, err := createReversalMovementEntries(test.ledgers)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expecting error %v but got %v", test.err, err)
+			}
+			if diff := cmp.Diff(test.expect, entries, protocmp.Transform()); diff != "" {
+				t.Fatalf("(-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSameMovementEntries(t *testing.T) {
+	t.Parallel()
+
+	recorded := []*ledgerv1.MovementEntry{
+		{
+			FromAccountId: "one",
+			ToAccountId:   "two",
+			Amount:        "100",
+			ClientId:      "client",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		requested []*ledgerv1.MovementEntry
+		expect    bool
+	}{
+		{
+			name: "same entries",
+			requested: []*ledgerv1.MovementEntry{
+				{
+					FromAccountId: "one",
+					ToAccountId:   "two",
+					Amount:        "100.00",
+					ClientId:      "client",
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "different amount",
+			requested: []*ledgerv1.MovementEntry{
+				{
+					FromAccountId: "one",
+					ToAccountId:   "two",
+					Amount:        "200",
+					ClientId:      "client",
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "different account",
+			requested: []*ledgerv1.MovementEntry{
+				{
+					FromAccountId: "one",
+					ToAccountId:   "three",
+					Amount:        "100",
+					ClientId:      "client",
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "different length",
+			requested: []*ledgerv1.MovementEntry{
+				recorded[0],
+				recorded[0],
+			},
+			expect: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			if got := sameMovementEntries(recorded, test.requested); got != test.expect {
+				t.Fatalf("expecting %v but got %v", test.expect, got)
+			}
+		})
+	}
+}
+
 func TestEligibleForMovement(t *testing.T) {
 	t.Parallel()
 
@@ -423,6 +557,54 @@ func TestEligibleForMovement(t *testing.T) {
 			},
 			err: ledger.ErrAccountSourceOrDestinationEmpty,
 		},
+		{
+			name: "debit frozen account",
+			check: checkEligible{
+				FromAccountID:     "a",
+				ToAccountID:       "b",
+				FromAccountStatus: ledger.AccountStatusFrozen,
+				ToAccountStatus:   ledger.AccountStatusActive,
+				FromCurrency: &currency.Currency{
+					ID: 1,
+				},
+				ToCurrency: &currency.Currency{
+					ID: 1,
+				},
+			},
+			err: ledger.ErrAccountFrozen,
+		},
+		{
+			name: "credit frozen account",
+			check: checkEligible{
+				FromAccountID:     "a",
+				ToAccountID:       "b",
+				FromAccountStatus: ledger.AccountStatusActive,
+				ToAccountStatus:   ledger.AccountStatusFrozen,
+				FromCurrency: &currency.Currency{
+					ID: 1,
+				},
+				ToCurrency: &currency.Currency{
+					ID: 1,
+				},
+			},
+			err: nil,
+		},
+		{
+			name: "credit closed account",
+			check: checkEligible{
+				FromAccountID:     "a",
+				ToAccountID:       "b",
+				FromAccountStatus: ledger.AccountStatusActive,
+				ToAccountStatus:   ledger.AccountStatusClosed,
+				FromCurrency: &currency.Currency{
+					ID: 1,
+				},
+				ToCurrency: &currency.Currency{
+					ID: 1,
+				},
+			},
+			err: ledger.ErrAccountClosed,
+		},
 		{
 			name: "ok",
 			check: checkEligible{
@@ -0,0 +1,114 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/studio-asd/go-example/services/ledger"
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+// Options configures the ledger API. The zero value of the options is valid and uses the default values.
+type Options struct {
+	// Deadlines is the deadline of each operation. The operation without deadline is only bounded by the context of the request.
+	Deadlines Deadlines `yaml:"deadlines"`
+	// LockTimeout is the lock_timeout of the transactions that lock the accounts balance. The lock timeout prevents a stuck lock from
+	// blocking the callers indefinitely, ledger.ErrLockTimeout is returned when the lock can't be acquired after all retries.
+	LockTimeout time.Duration `yaml:"lock_timeout"`
+	// StatementTimeout is the statement_timeout of the transactions that lock the accounts balance.
+	StatementTimeout time.Duration `yaml:"statement_timeout"`
+	// HookTimeout is the deadline of the hooks registered without timeout. The default hook timeout is 3 seconds.
+	HookTimeout time.Duration `yaml:"hook_timeout"`
+	// Retry is the retry policy of the transactions that fail because of the lock timeout, serialization failure or deadlock.
+	Retry RetryPolicy `yaml:"retry"`
+	// FXRateProvider is the rate provider used by TransactFX when the rate is not given in the request.
+	FXRateProvider ledger.FXRateProvider `yaml:"-"`
+}
+
+// Deadlines is the deadline of the operations that record the movements and create the accounts.
+type Deadlines struct {
+	Transact        time.Duration `yaml:"transact"`
+	TransactBatch   time.Duration `yaml:"transact_batch"`
+	TransactFX      time.Duration `yaml:"transact_fx"`
+	ReverseMovement time.Duration `yaml:"reverse_movement"`
+	CaptureMovement time.Duration `yaml:"capture_movement"`
+	CreateAccounts  time.Duration `yaml:"create_accounts"`
+}
+
+// RetryPolicy retries the transaction with an exponential backoff and full jitter, so the retries of the conflicting transactions are
+// spread over time.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts including the first attempt. The default is 3 attempts, set the value to 1 to
+	// disable the retry.
+	MaxAttempts int `yaml:"max_attempts"`
+	// InitialBackoff is the backoff of the first retry. The default initial backoff is 50ms.
+	InitialBackoff time.Duration `yaml:"initial_backoff"`
+	// MaxBackoff is the maximum backoff of the retries. The default max backoff is 1s.
+	MaxBackoff time.Duration `yaml:"max_backoff"`
+}
+
+func (o Options) withDefaults() Options {
+	if o.HookTimeout <= 0 {
+		o.HookTimeout = defaultHookTimeout
+	}
+	if o.Retry.MaxAttempts <= 0 {
+		o.Retry.MaxAttempts = 3
+	}
+	if o.Retry.InitialBackoff <= 0 {
+		o.Retry.InitialBackoff = time.Millisecond * 50
+	}
+	if o.Retry.MaxBackoff <= 0 {
+		o.Retry.MaxBackoff = time.Second
+	}
+	return o
+}
+
+// backoff returns the jittered backoff of the retry attempt, the attempt starts from 1.
+func (r RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := r.InitialBackoff
+	for i := 1; i < attempt && backoff < r.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	return rand.N(min(backoff, r.MaxBackoff)) + 1
+}
+
+// withDeadline returns the context with the deadline of the operation. The deadline of the request context is kept if it is earlier.
+func withDeadline(ctx context.Context, deadline time.Duration) (context.Context, context.CancelFunc) {
+	if deadline <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, deadline)
+}
+
+// transact invokes the function inside a transaction with the lock and statement timeouts of the options. The transaction is retried
+// with the retry policy when it fails because of the lock timeout, serialization failure or deadlock, so the function must be safe to be
+// invoked more than once.
+func (a *API) transact(ctx context.Context, fn func(ctx context.Context, q *ledgerpg.Queries) error) error {
+	for attempt := 1; ; attempt++ {
+		err := a.queries.WithTransact(ctx, sql.LevelReadCommitted, func(ctx context.Context, q *ledgerpg.Queries) error {
+			if err := q.SetLocalTimeouts(ctx, a.options.LockTimeout, a.options.StatementTimeout); err != nil {
+				return err
+			}
+			return fn(ctx, q)
+		})
+		if err == nil || !ledgerpg.IsRetryable(err) {
+			return err
+		}
+		if attempt >= a.options.Retry.MaxAttempts {
+			if ledgerpg.IsLockTimeout(err) {
+				return fmt.Errorf("%w: %w", ledger.ErrLockTimeout, err)
+			}
+			return err
+		}
+		timer := time.NewTimer(a.options.Retry.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/studio-asd/pkg/postgres"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := Options{}.withDefaults().Retry
+	tests := []struct {
+		attempt int
+		max     time.Duration
+	}{
+		{attempt: 1, max: time.Millisecond * 50},
+		{attempt: 2, max: time.Millisecond * 100},
+		{attempt: 3, max: time.Millisecond * 200},
+		// The backoff is capped by the max backoff.
+		{attempt: 10, max: time.Second},
+		{attempt: 100, max: time.Second},
+	}
+
+	for _, test := range tests {
+		for range 100 {
+			backoff := policy.backoff(test.attempt)
+			if backoff <= 0 || backoff > test.max {
+				t.Fatalf("attempt %d: expecting backoff in (0, %s] but got %s", test.attempt, test.max, backoff)
+			}
+		}
+	}
+}
+
+func TestLockTimeout(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{
+		LockTimeout: time.Millisecond * 100,
+		Retry: RetryPolicy{
+			MaxAttempts: 2,
+		},
+	})
+	accounts := createSimpleTestAccounts(t, api)
+	user, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[2].AccountId
+
+	// Hold the lock of the account balance until the movement is timed out.
+	locked := make(chan struct{})
+	release := make(chan struct{})
+	errC := make(chan error, 1)
+	go func() {
+		errC <- th.Postgres().Transact(context.Background(), sql.LevelReadCommitted, func(ctx context.Context, pg *postgres.Postgres) error {
+			if _, err := pg.Exec(ctx, "SELECT account_id FROM accounts_balance WHERE account_id = $1 FOR UPDATE", user); err != nil {
+				close(locked)
+				return err
+			}
+			close(locked)
+			<-release
+			return nil
+		})
+	}()
+	<-locked
+
+	_, err = api.Transact(context.Background(), &ledgerv1.TransactRequest{
+		IdempotencyKey: "lock_timeout",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{FromAccountId: deposit, ToAccountId: user, Amount: "10"},
+		},
+	}, nil)
+	close(release)
+	if !errors.Is(err, ledger.ErrLockTimeout) {
+		t.Fatalf("expecting error %v but got %v", ledger.ErrLockTimeout, err)
+	}
+	if err := <-errC; err != nil {
+		t.Fatal(err)
+	}
+}
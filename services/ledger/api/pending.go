@@ -0,0 +1,337 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/studio-asd/pkg/postgres"
+	"github.com/studio-asd/pkg/srun"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	internal "github.com/studio-asd/go-example/services/ledger/internal"
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+// expirePendingMovementsLimit is the maximum number of pending movements expired in a single batch.
+const expirePendingMovementsLimit = 100
+
+var _ srun.ServiceRunnerAware = (*PendingMovementExpirer)(nil)
+
+// AuthorizeMovement creates a pending movement that reserves the funds of the source accounts. The reserved funds cannot be used
+// by other movements until the pending movement is captured with CaptureMovement, voided with VoidMovement or expired.
+func (a *API) AuthorizeMovement(ctx context.Context, req *ledgerv1.AuthorizeMovementRequest) (*ledgerv1.AuthorizeMovementResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	authorizeTime := time.Now()
+	if !req.GetExpireTime().AsTime().After(authorizeTime) {
+		return nil, fmt.Errorf("%w: expire_time must be in the future", ledger.ErrInvalidTimeRange)
+	}
+	// Replay the pending movement if the pending movement with the same idempotency key is already recorded.
+	response, err := a.replayAuthorizeMovement(ctx, req)
+	if err == nil {
+		return response, nil
+	}
+	if !errors.Is(err, postgres.ErrNoRows) {
+		return nil, err
+	}
+
+	entries := req.GetMovementEntries()
+	accounts := make([]string, len(entries)*2)
+	for idx, entry := range entries {
+		accounts[idx*2] = entry.FromAccountId
+		accounts[idx*2+1] = entry.ToAccountId
+	}
+	accountsBalance, err := a.queries.GetAccountsBalanceMappedByAccID(ctx, accounts...)
+	if err != nil {
+		return nil, err
+	}
+	uuidv7, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	pendingMovementID := uuidv7.String()
+	// Create the ledger entries to check the eligibility and normalize the amount of the entries as if the movement is posted
+	// right now. The entries are re-created when the pending movement is captured.
+	ledgerEntries, err := createLedgerEntries(pendingMovementID, req.GetIdempotencyKey(), accountsBalance, entries...)
+	if err != nil {
+		return nil, err
+	}
+	pendingEntries := make([]ledgerpg.PendingMovementEntry, len(entries))
+	for idx, entry := range entries {
+		// The CREDIT record of the entry is always placed at (idx*2)+1 and has the positive amount.
+		credit := ledgerEntries.LedgerEntries[idx*2+1]
+		pendingEntries[idx] = ledgerpg.PendingMovementEntry{
+			PendingMovementID: pendingMovementID,
+			MovementSequence:  int32(credit.MovementSequence),
+			FromAccountID:     entry.GetFromAccountId(),
+			ToAccountID:       entry.GetToAccountId(),
+			CurrencyID:        credit.Amount.CurrencyID(),
+			Amount:            credit.Amount.Amount(),
+			ClientID: sql.NullString{
+				String: entry.GetClientId(),
+				Valid:  entry.GetClientId() != "",
+			},
+			CreatedAt: authorizeTime,
+		}
+	}
+
+	if err := a.queries.AuthorizeMovement(ctx, ledgerpg.AuthorizeMovementParams{
+		PendingMovementID: pendingMovementID,
+		IdempotencyKey:    req.GetIdempotencyKey(),
+		ExpiresAt:         req.GetExpireTime().AsTime(),
+		CreatedAt:         authorizeTime,
+		Entries:           pendingEntries,
+	}); err != nil {
+		// The unique violation happens when another request with the same idempotency key is recorded concurrently.
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return a.replayAuthorizeMovement(ctx, req)
+		}
+		return nil, err
+	}
+	return &ledgerv1.AuthorizeMovementResponse{
+		PendingMovementId: pendingMovementID,
+		Status:            ledgerv1.PendingMovementStatus_PENDING_MOVEMENT_STATUS_PENDING,
+		ExpireTime:        req.GetExpireTime(),
+		AuthorizeTime:     timestamppb.New(authorizeTime),
+	}, nil
+}
+
+// replayAuthorizeMovement returns the pending movement recorded with the idempotency key of the request. The function returns
+// postgres.ErrNoRows if there is no pending movement recorded with the key.
+func (a *API) replayAuthorizeMovement(ctx context.Context, req *ledgerv1.AuthorizeMovementRequest) (*ledgerv1.AuthorizeMovementResponse, error) {
+	pending, err := a.queries.GetPendingMovementByIdempotencyKey(ctx, req.GetIdempotencyKey())
+	if err != nil {
+		return nil, err
+	}
+	pendingEntries, err := a.queries.GetPendingMovementEntries(ctx, pending.PendingMovementID)
+	if err != nil {
+		return nil, err
+	}
+	if !sameMovementEntries(movementEntriesFromPendingEntries(pendingEntries), req.GetMovementEntries()) {
+		return nil, fmt.Errorf("%w: idempotency key %s", ledger.ErrIdempotencyKeyConflict, req.GetIdempotencyKey())
+	}
+	return &ledgerv1.AuthorizeMovementResponse{
+		PendingMovementId: pending.PendingMovementID,
+		Status:            ledgerv1.PendingMovementStatus(pending.PendingStatus),
+		ExpireTime:        timestamppb.New(pending.ExpiresAt),
+		AuthorizeTime:     timestamppb.New(pending.CreatedAt),
+	}, nil
+}
+
+// CaptureMovement posts the pending movement as a movement. The pending movement_id is used as the idempotency key of the
+// movement, so a pending movement can only be posted once.
+func (a *API) CaptureMovement(ctx context.Context, req *ledgerv1.CaptureMovementRequest) (*ledgerv1.CaptureMovementResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	ctx, cancel := withDeadline(ctx, a.options.Deadlines.CaptureMovement)
+	defer cancel()
+
+	pending, err := a.queries.GetPendingMovement(ctx, req.GetPendingMovementId())
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, ledger.ErrPendingMovementNotFound
+		}
+		return nil, err
+	}
+	// Check the status early so we don't have to construct the entries. The status will be checked again inside the data layer
+	// under lock.
+	if pending.PendingStatus != ledger.PendingMovementStatusPending {
+		return nil, ledger.ErrPendingMovementNotPending
+	}
+	if !pending.ExpiresAt.After(time.Now()) {
+		return nil, ledger.ErrPendingMovementExpired
+	}
+	pendingEntries, err := a.queries.GetPendingMovementEntries(ctx, pending.PendingMovementID)
+	if err != nil {
+		return nil, err
+	}
+	entries := movementEntriesFromPendingEntries(pendingEntries)
+
+	accounts := make([]string, len(entries)*2)
+	for idx, entry := range entries {
+		accounts[idx*2] = entry.FromAccountId
+		accounts[idx*2+1] = entry.ToAccountId
+	}
+	accountsBalance, err := a.queries.GetAccountsBalanceMappedByAccID(ctx, accounts...)
+	if err != nil {
+		return nil, err
+	}
+	// The funds reserved by the pending movement itself are used by the movement, so exclude them from the reserved amount.
+	for _, entry := range pendingEntries {
+		balance := accountsBalance[entry.FromAccountID]
+		balance.ReservedAmount = balance.ReservedAmount.Sub(entry.Amount)
+		accountsBalance[entry.FromAccountID] = balance
+	}
+	// Record the movements of the sharded accounts to their balance shards.
+	entries, err = a.routeBalanceShards(ctx, pending.PendingMovementID, accountsBalance, entries)
+	if err != nil {
+		return nil, err
+	}
+	uuidv7, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	ledgerEntries, err := createLedgerEntries(uuidv7.String(), pending.PendingMovementID, accountsBalance, entries...)
+	if err != nil {
+		return nil, err
+	}
+	preCommitHooks, postCommitHooks := a.hooks.movementHooks()
+	var result internal.MovementResult
+	err = a.transact(ctx, func(ctx context.Context, q *ledgerpg.Queries) error {
+		result, err = q.CapturePendingMovement(ctx, pending.PendingMovementID, ledgerEntries)
+		if err != nil {
+			return err
+		}
+		return runPreCommitHooks(ctx, a.hooks, hookEventMovement, q.Postgres(), preCommitHooks, ledger.MovementInfo{MovementID: result.MovementID})
+	})
+	if err != nil {
+		return nil, err
+	}
+	runPostCommitHooks(ctx, a.hooks, a.logger, hookEventMovement, postCommitHooks, ledger.MovementInfo{MovementID: result.MovementID})
+
+	response := &ledgerv1.CaptureMovementResponse{
+		PendingMovementId: pending.PendingMovementID,
+		MovementId:        ledgerEntries.MovementID,
+		CaptureTime:       timestamppb.New(result.Time),
+		LedgerEntries:     make([]*ledgerv1.TransactResponse_LedgerEntry, len(ledgerEntries.LedgerEntries)),
+		EndingBalances:    make([]*ledgerv1.TransactResponse_Balance, len(result.Balances)),
+	}
+	for idx, entry := range ledgerEntries.LedgerEntries {
+		response.LedgerEntries[idx] = &ledgerv1.TransactResponse_LedgerEntry{
+			LedgerId:         entry.LedgerID,
+			ClientId:         entry.ClientID,
+			MovementSequence: int32(entry.MovementSequence),
+		}
+	}
+	counter := 0
+	for _, balance := range result.Balances {
+		response.EndingBalances[counter] = &ledgerv1.TransactResponse_Balance{
+			AccountId:          balance.AccountID,
+			LedgerId:           balance.NextLedgerID,
+			NewBalance:         balance.NewBalance.String(),
+			PreviousBalance:    balance.PreviousBalance.String(),
+			PreviousLedgerId:   balance.PreviousLedgerID,
+			PreviousMovementId: balance.PreviousMovementID,
+		}
+		counter++
+	}
+	return response, nil
+}
+
+// VoidMovement releases the funds reserved by the pending movement without posting the movement.
+func (a *API) VoidMovement(ctx context.Context, req *ledgerv1.VoidMovementRequest) (*ledgerv1.VoidMovementResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	voidTime := time.Now()
+	if err := a.queries.VoidPendingMovement(ctx, req.GetPendingMovementId(), voidTime); err != nil {
+		return nil, err
+	}
+	return &ledgerv1.VoidMovementResponse{
+		PendingMovementId: req.GetPendingMovementId(),
+		Status:            ledgerv1.PendingMovementStatus_PENDING_MOVEMENT_STATUS_VOIDED,
+		VoidTime:          timestamppb.New(voidTime),
+	}, nil
+}
+
+// ExpirePendingMovements marks the pending movements passing their expiry time as expired and returns the number of expired
+// pending movements. The reserved funds of the pending movements are already released once the expiry time is passed, so
+// the function only updates the status of the pending movements.
+func (a *API) ExpirePendingMovements(ctx context.Context, at time.Time) (int, error) {
+	var total int
+	for {
+		expired, err := a.queries.ExpirePendingMovements(ctx, ledgerpg.ExpirePendingMovementsParams{
+			ExpiredAt:   at,
+			ExpireLimit: expirePendingMovementsLimit,
+		})
+		if err != nil {
+			return total, err
+		}
+		total += len(expired)
+		if len(expired) < expirePendingMovementsLimit {
+			return total, nil
+		}
+	}
+}
+
+// movementEntriesFromPendingEntries converts the pending movement entries to the movement entries.
+func movementEntriesFromPendingEntries(pendingEntries []ledgerpg.PendingMovementEntry) []*ledgerv1.MovementEntry {
+	entries := make([]*ledgerv1.MovementEntry, len(pendingEntries))
+	for idx, entry := range pendingEntries {
+		entries[idx] = &ledgerv1.MovementEntry{
+			FromAccountId: entry.FromAccountID,
+			ToAccountId:   entry.ToAccountID,
+			Amount:        entry.Amount.String(),
+			ClientId:      entry.ClientID.String,
+		}
+	}
+	return entries
+}
+
+// PendingMovementExpirer periodically expires the pending movements passing their expiry time.
+type PendingMovementExpirer struct {
+	api      *API
+	interval time.Duration
+	logger   *slog.Logger
+	stopC    chan struct{}
+}
+
+// NewPendingMovementExpirer creates a new service runner to expire the pending movements for every interval.
+func NewPendingMovementExpirer(api *API, interval time.Duration) *PendingMovementExpirer {
+	return &PendingMovementExpirer{
+		api:      api,
+		interval: interval,
+		stopC:    make(chan struct{}),
+	}
+}
+
+func (p *PendingMovementExpirer) Name() string {
+	return "ledger_pending_movement_expirer"
+}
+
+func (p *PendingMovementExpirer) Init(ctx srun.Context) error {
+	p.logger = ctx.Logger
+	return nil
+}
+
+func (p *PendingMovementExpirer) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-p.stopC:
+			return nil
+		case t := <-ticker.C:
+			expired, err := p.api.ExpirePendingMovements(ctx, t)
+			if err != nil {
+				p.logger.ErrorContext(ctx, "Failed to expire pending movements", "error", err)
+				continue
+			}
+			if expired > 0 {
+				p.logger.InfoContext(ctx, "Pending movements expired", "expired", expired)
+			}
+		}
+	}
+}
+
+func (p *PendingMovementExpirer) Ready(ctx context.Context) error {
+	return nil
+}
+
+func (p *PendingMovementExpirer) Stop(ctx context.Context) error {
+	close(p.stopC)
+	return nil
+}
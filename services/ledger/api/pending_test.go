@@ -0,0 +1,156 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+func TestPendingMovement(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	accounts := createSimpleTestAccounts(t, api)
+	user, merchant, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[1].AccountId, accounts.Accounts[2].AccountId
+
+	if _, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+		IdempotencyKey: "deposit",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{FromAccountId: deposit, ToAccountId: user, Amount: "100"},
+		},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	authorizeReq := &ledgerv1.AuthorizeMovementRequest{
+		IdempotencyKey: "authorize",
+		ExpireTime:     timestamppb.New(time.Now().Add(time.Hour)),
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{FromAccountId: user, ToAccountId: merchant, Amount: "70"},
+		},
+	}
+	authorized, err := api.AuthorizeMovement(context.Background(), authorizeReq)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("replay authorize", func(t *testing.T) {
+		replayed, err := api.AuthorizeMovement(context.Background(), authorizeReq)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if replayed.GetPendingMovementId() != authorized.GetPendingMovementId() {
+			t.Fatalf("expecting pending movement %s but got %s", authorized.GetPendingMovementId(), replayed.GetPendingMovementId())
+		}
+	})
+
+	t.Run("reserved funds cannot be used", func(t *testing.T) {
+		_, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+			IdempotencyKey: "use_reserved",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "50"},
+			},
+		}, nil)
+		if !errors.Is(err, ledger.ErrInsufficientBalance) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrInsufficientBalance, err)
+		}
+		_, err = api.AuthorizeMovement(context.Background(), &ledgerv1.AuthorizeMovementRequest{
+			IdempotencyKey: "authorize_reserved",
+			ExpireTime:     timestamppb.New(time.Now().Add(time.Hour)),
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "40"},
+			},
+		})
+		if !errors.Is(err, ledger.ErrInsufficientBalance) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrInsufficientBalance, err)
+		}
+	})
+
+	t.Run("capture", func(t *testing.T) {
+		if _, err := api.CaptureMovement(context.Background(), &ledgerv1.CaptureMovementRequest{
+			PendingMovementId: authorized.GetPendingMovementId(),
+		}); err != nil {
+			t.Fatal(err)
+		}
+		_, err := api.CaptureMovement(context.Background(), &ledgerv1.CaptureMovementRequest{
+			PendingMovementId: authorized.GetPendingMovementId(),
+		})
+		if !errors.Is(err, ledger.ErrPendingMovementNotPending) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrPendingMovementNotPending, err)
+		}
+	})
+
+	t.Run("void", func(t *testing.T) {
+		resp, err := api.AuthorizeMovement(context.Background(), &ledgerv1.AuthorizeMovementRequest{
+			IdempotencyKey: "authorize_void",
+			ExpireTime:     timestamppb.New(time.Now().Add(time.Hour)),
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "30"},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := api.VoidMovement(context.Background(), &ledgerv1.VoidMovementRequest{
+			PendingMovementId: resp.GetPendingMovementId(),
+		}); err != nil {
+			t.Fatal(err)
+		}
+		_, err = api.CaptureMovement(context.Background(), &ledgerv1.CaptureMovementRequest{
+			PendingMovementId: resp.GetPendingMovementId(),
+		})
+		if !errors.Is(err, ledger.ErrPendingMovementNotPending) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrPendingMovementNotPending, err)
+		}
+	})
+
+	t.Run("expire", func(t *testing.T) {
+		resp, err := api.AuthorizeMovement(context.Background(), &ledgerv1.AuthorizeMovementRequest{
+			IdempotencyKey: "authorize_expire",
+			ExpireTime:     timestamppb.New(time.Now().Add(time.Second)),
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "30"},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expired, err := api.ExpirePendingMovements(context.Background(), time.Now().Add(time.Second*2))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expired != 1 {
+			t.Fatalf("expecting 1 expired pending movement but got %d", expired)
+		}
+		_, err = api.CaptureMovement(context.Background(), &ledgerv1.CaptureMovementRequest{
+			PendingMovementId: resp.GetPendingMovementId(),
+		})
+		if !errors.Is(err, ledger.ErrPendingMovementNotPending) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrPendingMovementNotPending, err)
+		}
+	})
+
+	balances, err := api.GetAccountsBalance(context.Background(), &ledgerv1.GetAccountsBalanceRequest{AccountIds: []string{user, merchant}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectBalances := map[string]string{
+		user:     "30",
+		merchant: "70",
+	}
+	for _, balance := range balances.GetBalances() {
+		if balance.GetBalance() != expectBalances[balance.GetAccountId()] {
+			t.Fatalf("expecting account %s balance to be %s but got %s", balance.GetAccountId(), expectBalances[balance.GetAccountId()], balance.GetBalance())
+		}
+	}
+}
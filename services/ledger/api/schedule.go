@@ -0,0 +1,460 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/studio-asd/pkg/postgres"
+	"github.com/studio-asd/pkg/srun"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/studio-asd/go-example/internal/cron"
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+const (
+	defaultListScheduledMovementsPageSize = 100
+	// runScheduledMovementsLimit is the maximum number of scheduled movements claimed in a single batch.
+	runScheduledMovementsLimit = 100
+	// scheduledMovementClaimTimeout is the duration of the claim of a due scheduled movement. The occurrence is claimed again by
+	// the scheduler after the timeout if its result is not recorded, for example when the service is restarted.
+	scheduledMovementClaimTimeout = time.Minute * 5
+	// scheduledMovementMaxAttempts is the maximum number of attempts to post an occurrence of the scheduled movement.
+	scheduledMovementMaxAttempts = 5
+	// scheduledMovementRetryBackoff is the backoff of the first retry of a failed occurrence, the backoff is doubled for each retry.
+	scheduledMovementRetryBackoff = time.Minute
+)
+
+var _ srun.ServiceRunnerAware = (*MovementScheduler)(nil)
+
+// ScheduleMovement schedules the movement to be posted once at the start_time, or repeatedly following the recurrence until the
+// end_time. The recurrence is evaluated in UTC. Each occurrence is posted via Transact with the idempotency key derived from the
+// schedule_id and the occurrence number, so an occurrence is never posted twice even if the scheduler retries it.
+func (a *API) ScheduleMovement(ctx context.Context, req *ledgerv1.ScheduleMovementRequest) (*ledgerv1.ScheduleMovementResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	transact := req.GetTransact()
+	// Replay the scheduled movement if the scheduled movement with the same idempotency key is already recorded.
+	response, err := a.replayScheduleMovement(ctx, req)
+	if err == nil {
+		return response, nil
+	}
+	if !errors.Is(err, postgres.ErrNoRows) {
+		return nil, err
+	}
+
+	createdAt := time.Now()
+	startTime := req.GetStartTime().AsTime()
+	occurrenceAt := startTime
+	if req.GetRecurrence() != "" {
+		schedule, err := cron.Parse(req.GetRecurrence())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ledger.ErrInvalidRecurrence, err)
+		}
+		// The occurrences before the schedule is created are not posted, so the recurring scheduled movement that starts in the
+		// past doesn't post all of the missed occurrences at once.
+		from := startTime
+		if from.Before(createdAt) {
+			from = createdAt.UTC()
+		}
+		// Next returns the time after the given time, subtract the time so the start time itself is included.
+		occurrenceAt = schedule.Next(from.Add(-time.Nanosecond))
+		if occurrenceAt.IsZero() {
+			return nil, fmt.Errorf("%w: %s never occurs", ledger.ErrInvalidRecurrence, req.GetRecurrence())
+		}
+	}
+	var endAt sql.NullTime
+	if req.GetEndTime() != nil {
+		endAt = sql.NullTime{Time: req.GetEndTime().AsTime(), Valid: true}
+		if !endAt.Time.After(startTime) {
+			return nil, fmt.Errorf("%w: end_time must be after start_time", ledger.ErrInvalidTimeRange)
+		}
+		if !endAt.Time.After(occurrenceAt) {
+			return nil, fmt.Errorf("%w: the first occurrence at %s is not before end_time", ledger.ErrInvalidTimeRange, occurrenceAt.Format(time.RFC3339))
+		}
+	}
+
+	uuidv7, err := uuid.NewV7()
+	if err != nil {
+		return nil, err
+	}
+	scheduleID := uuidv7.String()
+	entries := transact.GetMovementEntries()
+	accountIDs := make([]string, 0, len(entries)*2)
+	scheduledEntries := make([]ledgerpg.ScheduledMovementEntry, len(entries))
+	for idx, entry := range entries {
+		amount, err := decimal.NewFromString(entry.GetAmount())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v, please check entry at index [%d]", ledger.ErrInvalidAmount, err, idx)
+		}
+		if !amount.IsPositive() {
+			return nil, fmt.Errorf("%w: amount must be positive, please check entry at index [%d]", ledger.ErrInvalidAmount, idx)
+		}
+		if entry.GetFromAccountId() == entry.GetToAccountId() {
+			return nil, fmt.Errorf("%w: please check entry at index [%d]", ledger.ErrCannotMoveToSelf, idx)
+		}
+		accountIDs = append(accountIDs, entry.GetFromAccountId(), entry.GetToAccountId())
+		scheduledEntries[idx] = ledgerpg.ScheduledMovementEntry{
+			ScheduleID:       scheduleID,
+			MovementSequence: int32(idx + 1),
+			FromAccountID:    entry.GetFromAccountId(),
+			ToAccountID:      entry.GetToAccountId(),
+			Amount:           amount,
+			ClientID: sql.NullString{
+				String: entry.GetClientId(),
+				Valid:  entry.GetClientId() != "",
+			},
+			CreatedAt: createdAt,
+		}
+	}
+	// Check the accounts early, the balance and the status of the accounts are checked when each occurrence is posted.
+	accountIDs = slices.Compact(slices.Sorted(slices.Values(accountIDs)))
+	accounts, err := a.queries.GetAccounts(ctx, accountIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(accounts) != len(accountIDs) {
+		return nil, fmt.Errorf("%w: account of the scheduled movement does not exist", ledger.ErrAccountNotFound)
+	}
+
+	recurrence := sql.NullString{String: req.GetRecurrence(), Valid: req.GetRecurrence() != ""}
+	if err := a.queries.ScheduleMovement(ctx, ledgerpg.ScheduleMovementParams{
+		ScheduleID:     scheduleID,
+		IdempotencyKey: transact.GetIdempotencyKey(),
+		Recurrence:     recurrence,
+		OccurrenceAt:   occurrenceAt,
+		EndAt:          endAt,
+		CreatedAt:      createdAt,
+		Entries:        scheduledEntries,
+	}); err != nil {
+		// The unique violation happens when another request with the same idempotency key is recorded concurrently.
+		if errors.Is(err, postgres.ErrUniqueViolation) {
+			return a.replayScheduleMovement(ctx, req)
+		}
+		return nil, err
+	}
+	return &ledgerv1.ScheduleMovementResponse{
+		ScheduledMovement: newScheduledMovement(ledgerpg.ScheduledMovement{
+			ScheduleID:     scheduleID,
+			IdempotencyKey: transact.GetIdempotencyKey(),
+			ScheduleStatus: ledger.ScheduledMovementStatusActive,
+			Recurrence:     recurrence,
+			OccurrenceAt:   occurrenceAt,
+			NextRunAt:      occurrenceAt,
+			EndAt:          endAt,
+			CreatedAt:      createdAt,
+		}, scheduledEntries),
+	}, nil
+}
+
+// replayScheduleMovement returns the scheduled movement recorded with the idempotency key of the request. The function returns
+// postgres.ErrNoRows if there is no scheduled movement recorded with the key.
+func (a *API) replayScheduleMovement(ctx context.Context, req *ledgerv1.ScheduleMovementRequest) (*ledgerv1.ScheduleMovementResponse, error) {
+	idempotencyKey := req.GetTransact().GetIdempotencyKey()
+	schedule, err := a.queries.GetScheduledMovementByIdempotencyKey(ctx, idempotencyKey)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := a.queries.GetScheduledMovementEntries(ctx, schedule.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if !sameMovementEntries(movementEntriesFromScheduledEntries(entries), req.GetTransact().GetMovementEntries()) {
+		return nil, fmt.Errorf("%w: idempotency key %s", ledger.ErrIdempotencyKeyConflict, idempotencyKey)
+	}
+	return &ledgerv1.ScheduleMovementResponse{
+		ScheduledMovement: newScheduledMovement(schedule, entries),
+	}, nil
+}
+
+// ListScheduledMovements returns the scheduled movements ordered by their creation time. The API uses keyset pagination on the
+// schedule_id, the client need to pass the next_page_token to retrieve the next page.
+func (a *API) ListScheduledMovements(ctx context.Context, req *ledgerv1.ListScheduledMovementsRequest) (*ledgerv1.ListScheduledMovementsResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	if req.GetPageToken() != "" {
+		if _, err := uuid.Parse(req.GetPageToken()); err != nil {
+			return nil, fmt.Errorf("%w: %s", ledger.ErrInvalidPageToken, req.GetPageToken())
+		}
+	}
+	pageSize := req.GetPageSize()
+	if pageSize == 0 {
+		pageSize = defaultListScheduledMovementsPageSize
+	}
+
+	// Retrieve one more scheduled movement to know whether there is a next page.
+	schedules, err := a.queries.ListScheduledMovements(ctx, ledgerpg.ListScheduledMovementsParams{
+		ScheduleStatus:  int32(req.GetStatus()),
+		AfterScheduleID: req.GetPageToken(),
+		LimitSize:       pageSize + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &ledgerv1.ListScheduledMovementsResponse{}
+	if len(schedules) > int(pageSize) {
+		schedules = schedules[:pageSize]
+		resp.NextPageToken = schedules[len(schedules)-1].ScheduleID
+	}
+	resp.ScheduledMovements = make([]*ledgerv1.ScheduledMovement, len(schedules))
+	for idx, schedule := range schedules {
+		entries, err := a.queries.GetScheduledMovementEntries(ctx, schedule.ScheduleID)
+		if err != nil {
+			return nil, err
+		}
+		resp.ScheduledMovements[idx] = newScheduledMovement(schedule, entries)
+	}
+	return resp, nil
+}
+
+// CancelScheduledMovement cancels the remaining occurrences of the scheduled movement. The occurrence that is being posted while
+// the scheduled movement is cancelled is still posted.
+func (a *API) CancelScheduledMovement(ctx context.Context, req *ledgerv1.CancelScheduledMovementRequest) (*ledgerv1.CancelScheduledMovementResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	cancelTime := time.Now()
+	if _, err := a.queries.CancelScheduledMovement(ctx, req.GetScheduleId(), cancelTime); err != nil {
+		return nil, err
+	}
+	return &ledgerv1.CancelScheduledMovementResponse{
+		ScheduleId: req.GetScheduleId(),
+		Status:     ledgerv1.ScheduledMovementStatus_SCHEDULED_MOVEMENT_STATUS_CANCELLED,
+		CancelTime: timestamppb.New(cancelTime),
+	}, nil
+}
+
+// RunScheduledMovements posts the occurrences of the scheduled movements that are due at the given time and returns the number of
+// posted occurrences. Only the due occurrence of a recurring scheduled movement is posted, the other occurrences before the given
+// time are skipped. The failed occurrence is retried with backoff, the error of the occurrence is recorded in the last_error of
+// the scheduled movement and is not returned by the function.
+func (a *API) RunScheduledMovements(ctx context.Context, at time.Time) (int, error) {
+	var (
+		total int
+		errs  []error
+	)
+	for {
+		schedules, err := a.queries.ClaimDueScheduledMovements(ctx, ledgerpg.ClaimDueScheduledMovementsParams{
+			ClaimedUntil: at.Add(scheduledMovementClaimTimeout),
+			DueAt:        at,
+			ClaimLimit:   runScheduledMovementsLimit,
+		})
+		if err != nil {
+			return total, errors.Join(append(errs, err)...)
+		}
+		for _, schedule := range schedules {
+			posted, err := a.runScheduledMovement(ctx, schedule, at)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("scheduled movement %s: %w", schedule.ScheduleID, err))
+				continue
+			}
+			if posted {
+				total++
+			}
+		}
+		if len(schedules) < runScheduledMovementsLimit {
+			return total, errors.Join(errs...)
+		}
+	}
+}
+
+// runScheduledMovement posts the current occurrence of the claimed scheduled movement and records the result. The function returns
+// true if the occurrence is posted.
+func (a *API) runScheduledMovement(ctx context.Context, schedule ledgerpg.ScheduledMovement, at time.Time) (bool, error) {
+	entries, err := a.queries.GetScheduledMovementEntries(ctx, schedule.ScheduleID)
+	if err != nil {
+		return false, err
+	}
+	resp, transactErr := a.Transact(ctx, &ledgerv1.TransactRequest{
+		IdempotencyKey:  scheduledMovementIdempotencyKey(schedule.ScheduleID, schedule.RunCount+1),
+		MovementEntries: movementEntriesFromScheduledEntries(entries),
+	}, nil)
+
+	params := ledgerpg.UpdateScheduledMovementRunParams{
+		ScheduleStatus:  ledger.ScheduledMovementStatusActive,
+		OccurrenceAt:    schedule.OccurrenceAt,
+		RunCount:        schedule.RunCount,
+		Attempts:        schedule.Attempts,
+		LastError:       schedule.LastError,
+		LastMovementID:  schedule.LastMovementID,
+		UpdatedAt:       sql.NullTime{Time: at, Valid: true},
+		ScheduleID:      schedule.ScheduleID,
+		ClaimedRunCount: schedule.RunCount,
+		ClaimedAttempts: schedule.Attempts,
+	}
+	if transactErr != nil {
+		params.Attempts++
+		params.LastError = sql.NullString{String: transactErr.Error(), Valid: true}
+		switch {
+		case params.Attempts < scheduledMovementMaxAttempts:
+			params.NextRunAt = at.Add(scheduledMovementRetryBackoff << (params.Attempts - 1))
+		case !schedule.Recurrence.Valid:
+			params.ScheduleStatus = ledger.ScheduledMovementStatusFailed
+			params.NextRunAt = at
+		default:
+			// Skip the occurrence of the recurring scheduled movement, so a single failed occurrence doesn't stop the next
+			// occurrences. The error of the skipped occurrence is kept in the last_error.
+			if err := nextScheduledOccurrence(schedule, at, &params); err != nil {
+				return false, err
+			}
+		}
+	} else {
+		params.LastError = sql.NullString{}
+		params.LastMovementID = sql.NullString{String: resp.GetMovementId(), Valid: true}
+		if err := nextScheduledOccurrence(schedule, at, &params); err != nil {
+			return false, err
+		}
+	}
+
+	_, err = a.queries.UpdateScheduledMovementRun(ctx, params)
+	if err != nil && !errors.Is(err, postgres.ErrNoRows) {
+		return false, err
+	}
+	// The no rows error means the scheduled movement is cancelled or claimed again after the claim is expired, the result of the
+	// occurrence is recorded by the other scheduler.
+	return transactErr == nil, nil
+}
+
+// nextScheduledOccurrence moves the scheduled movement to its next occurrence after the given time, the scheduled movement is completed
+// when there is no next occurrence before its end time.
+func nextScheduledOccurrence(schedule ledgerpg.ScheduledMovement, at time.Time, params *ledgerpg.UpdateScheduledMovementRunParams) error {
+	params.RunCount = schedule.RunCount + 1
+	params.Attempts = 0
+	params.NextRunAt = schedule.OccurrenceAt
+	if !schedule.Recurrence.Valid {
+		params.ScheduleStatus = ledger.ScheduledMovementStatusCompleted
+		return nil
+	}
+	recurrence, err := cron.Parse(schedule.Recurrence.String)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ledger.ErrInvalidRecurrence, err)
+	}
+	// The next occurrence follows the recurrence rather than the time the current occurrence is posted, so a delayed occurrence
+	// doesn't shift the next occurrences. The occurrences missed while the scheduler is down are skipped the same way as the
+	// occurrences before the schedule is created, so the recurring scheduled movement doesn't post all of them at once.
+	from := schedule.OccurrenceAt
+	if at.After(from) {
+		from = at
+	}
+	next := recurrence.Next(from.UTC())
+	if next.IsZero() || (schedule.EndAt.Valid && !next.Before(schedule.EndAt.Time)) {
+		params.ScheduleStatus = ledger.ScheduledMovementStatusCompleted
+		return nil
+	}
+	params.OccurrenceAt = next
+	params.NextRunAt = next
+	return nil
+}
+
+// scheduledMovementIdempotencyKey returns the idempotency key of the movement posted for the occurrence of the scheduled movement.
+// The occurrence starts from 1.
+func scheduledMovementIdempotencyKey(scheduleID string, occurrence int32) string {
+	return scheduleID + ":" + strconv.Itoa(int(occurrence))
+}
+
+// movementEntriesFromScheduledEntries converts the scheduled movement entries to the movement entries.
+func movementEntriesFromScheduledEntries(scheduledEntries []ledgerpg.ScheduledMovementEntry) []*ledgerv1.MovementEntry {
+	entries := make([]*ledgerv1.MovementEntry, len(scheduledEntries))
+	for idx, entry := range scheduledEntries {
+		entries[idx] = &ledgerv1.MovementEntry{
+			FromAccountId: entry.FromAccountID,
+			ToAccountId:   entry.ToAccountID,
+			Amount:        entry.Amount.String(),
+			ClientId:      entry.ClientID.String,
+		}
+	}
+	return entries
+}
+
+func newScheduledMovement(schedule ledgerpg.ScheduledMovement, entries []ledgerpg.ScheduledMovementEntry) *ledgerv1.ScheduledMovement {
+	scheduled := &ledgerv1.ScheduledMovement{
+		ScheduleId:         schedule.ScheduleID,
+		IdempotencyKey:     schedule.IdempotencyKey,
+		Status:             ledgerv1.ScheduledMovementStatus(schedule.ScheduleStatus),
+		Recurrence:         schedule.Recurrence.String,
+		MovementEntries:    movementEntriesFromScheduledEntries(entries),
+		NextOccurrenceTime: timestamppb.New(schedule.OccurrenceAt),
+		RunCount:           schedule.RunCount,
+		Attempts:           schedule.Attempts,
+		LastError:          schedule.LastError.String,
+		LastMovementId:     schedule.LastMovementID.String,
+		CreateTime:         timestamppb.New(schedule.CreatedAt),
+	}
+	if schedule.EndAt.Valid {
+		scheduled.EndTime = timestamppb.New(schedule.EndAt.Time)
+	}
+	if schedule.UpdatedAt.Valid {
+		scheduled.UpdateTime = timestamppb.New(schedule.UpdatedAt.Time)
+	}
+	return scheduled
+}
+
+// MovementScheduler periodically posts the occurrences of the scheduled movements that are due.
+type MovementScheduler struct {
+	api      *API
+	interval time.Duration
+	logger   *slog.Logger
+	stopC    chan struct{}
+}
+
+// NewMovementScheduler creates a new service runner to post the due scheduled movements for every interval.
+func NewMovementScheduler(api *API, interval time.Duration) *MovementScheduler {
+	return &MovementScheduler{
+		api:      api,
+		interval: interval,
+		stopC:    make(chan struct{}),
+	}
+}
+
+func (m *MovementScheduler) Name() string {
+	return "ledger_movement_scheduler"
+}
+
+func (m *MovementScheduler) Init(ctx srun.Context) error {
+	m.logger = ctx.Logger
+	return nil
+}
+
+func (m *MovementScheduler) Run(ctx context.Context) error {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-m.stopC:
+			return nil
+		case t := <-ticker.C:
+			posted, err := m.api.RunScheduledMovements(ctx, t)
+			if err != nil {
+				m.logger.ErrorContext(ctx, "Failed to run scheduled movements", "error", err)
+			}
+			if posted > 0 {
+				m.logger.InfoContext(ctx, "Scheduled movements posted", "posted", posted)
+			}
+		}
+	}
+}
+
+func (m *MovementScheduler) Ready(ctx context.Context) error {
+	return nil
+}
+
+func (m *MovementScheduler) Stop(ctx context.Context) error {
+	close(m.stopC)
+	return nil
+}
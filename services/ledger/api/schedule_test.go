@@ -0,0 +1,344 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+func TestScheduledMovement(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	accounts := createSimpleTestAccounts(t, api)
+	user, merchant, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[1].AccountId, accounts.Accounts[2].AccountId
+
+	startTime := time.Now().Add(time.Hour).UTC().Truncate(time.Minute)
+	scheduleReq := &ledgerv1.ScheduleMovementRequest{
+		Transact: &ledgerv1.TransactRequest{
+			IdempotencyKey: "monthly_deposit",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: deposit, ToAccountId: user, Amount: "100"},
+			},
+		},
+		StartTime:  timestamppb.New(startTime),
+		Recurrence: "*/30 * * * *",
+		EndTime:    timestamppb.New(startTime.Add(time.Hour * 2)),
+	}
+	scheduled, err := api.ScheduleMovement(context.Background(), scheduleReq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scheduleID := scheduled.GetScheduledMovement().GetScheduleId()
+
+	t.Run("replay schedule", func(t *testing.T) {
+		replayed, err := api.ScheduleMovement(context.Background(), scheduleReq)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if replayed.GetScheduledMovement().GetScheduleId() != scheduleID {
+			t.Fatalf("expecting scheduled movement %s but got %s", scheduleID, replayed.GetScheduledMovement().GetScheduleId())
+		}
+		_, err = api.ScheduleMovement(context.Background(), &ledgerv1.ScheduleMovementRequest{
+			Transact: &ledgerv1.TransactRequest{
+				IdempotencyKey: "monthly_deposit",
+				MovementEntries: []*ledgerv1.MovementEntry{
+					{FromAccountId: deposit, ToAccountId: user, Amount: "200"},
+				},
+			},
+			StartTime: timestamppb.New(startTime),
+		})
+		if !errors.Is(err, ledger.ErrIdempotencyKeyConflict) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrIdempotencyKeyConflict, err)
+		}
+	})
+
+	t.Run("not due", func(t *testing.T) {
+		posted, err := api.RunScheduledMovements(context.Background(), time.Now())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if posted != 0 {
+			t.Fatalf("expecting no posted occurrence but got %d", posted)
+		}
+	})
+
+	t.Run("post occurrences", func(t *testing.T) {
+		// The occurrences are planned at +0, +30m, +60m and +90m of the start time.
+		runs := []struct {
+			at           time.Time
+			expectPosted int
+		}{
+			// On time, the first occurrence is posted.
+			{at: startTime, expectPosted: 1},
+			// Late by more than one occurrence, the due occurrence at +30m is posted and the occurrence at +60m is skipped.
+			{at: startTime.Add(time.Minute * 65), expectPosted: 1},
+			// Several periods late, the due occurrence at +90m is posted and there is no next occurrence before the end time.
+			{at: startTime.Add(time.Hour * 3), expectPosted: 1},
+		}
+		for _, run := range runs {
+			posted, err := api.RunScheduledMovements(context.Background(), run.at)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if posted != run.expectPosted {
+				t.Fatalf("expecting %d posted occurrences at %s but got %d", run.expectPosted, run.at, posted)
+			}
+		}
+		balances, err := api.GetAccountsBalance(context.Background(), &ledgerv1.GetAccountsBalanceRequest{AccountIds: []string{user}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balances.GetBalances()[0].GetBalance() != "300" {
+			t.Fatalf("expecting balance 300 but got %s", balances.GetBalances()[0].GetBalance())
+		}
+		// The occurrence is posted with the idempotency key derived from the schedule id.
+		if _, err := api.queries.GetMovementByIdempotencyKey(context.Background(), scheduleID+":1"); err != nil {
+			t.Fatal(err)
+		}
+
+		list, err := api.ListScheduledMovements(context.Background(), &ledgerv1.ListScheduledMovementsRequest{
+			Status: ledgerv1.ScheduledMovementStatus_SCHEDULED_MOVEMENT_STATUS_COMPLETED,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(list.GetScheduledMovements()) != 1 {
+			t.Fatalf("expecting 1 completed scheduled movement but got %d", len(list.GetScheduledMovements()))
+		}
+		if list.GetScheduledMovements()[0].GetRunCount() != 3 {
+			t.Fatalf("expecting run count 3 but got %d", list.GetScheduledMovements()[0].GetRunCount())
+		}
+	})
+
+	t.Run("retry and fail", func(t *testing.T) {
+		failed, err := api.ScheduleMovement(context.Background(), &ledgerv1.ScheduleMovementRequest{
+			Transact: &ledgerv1.TransactRequest{
+				IdempotencyKey: "insufficient",
+				MovementEntries: []*ledgerv1.MovementEntry{
+					{FromAccountId: merchant, ToAccountId: user, Amount: "100"},
+				},
+			},
+			StartTime: timestamppb.New(startTime),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		at := startTime
+		for range scheduledMovementMaxAttempts {
+			at = at.Add(time.Hour)
+			posted, err := api.RunScheduledMovements(context.Background(), at)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if posted != 0 {
+				t.Fatalf("expecting no posted occurrence but got %d", posted)
+			}
+		}
+		schedule, err := api.queries.GetScheduledMovement(context.Background(), failed.GetScheduledMovement().GetScheduleId())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if schedule.ScheduleStatus != ledger.ScheduledMovementStatusFailed {
+			t.Fatalf("expecting status %d but got %d", ledger.ScheduledMovementStatusFailed, schedule.ScheduleStatus)
+		}
+		if schedule.Attempts != scheduledMovementMaxAttempts {
+			t.Fatalf("expecting %d attempts but got %d", scheduledMovementMaxAttempts, schedule.Attempts)
+		}
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		cancelled, err := api.ScheduleMovement(context.Background(), &ledgerv1.ScheduleMovementRequest{
+			Transact: &ledgerv1.TransactRequest{
+				IdempotencyKey: "cancelled",
+				MovementEntries: []*ledgerv1.MovementEntry{
+					{FromAccountId: deposit, ToAccountId: user, Amount: "100"},
+				},
+			},
+			StartTime:  timestamppb.New(time.Now().Add(time.Hour * 24)),
+			Recurrence: "@daily",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		cancelReq := &ledgerv1.CancelScheduledMovementRequest{ScheduleId: cancelled.GetScheduledMovement().GetScheduleId()}
+		if _, err := api.CancelScheduledMovement(context.Background(), cancelReq); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := api.CancelScheduledMovement(context.Background(), cancelReq); !errors.Is(err, ledger.ErrScheduledMovementNotActive) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrScheduledMovementNotActive, err)
+		}
+		posted, err := api.RunScheduledMovements(context.Background(), time.Now().Add(time.Hour*48))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if posted != 0 {
+			t.Fatalf("expecting no posted occurrence but got %d", posted)
+		}
+	})
+}
+
+func TestScheduleMovementValidation(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	accounts := createSimpleTestAccounts(t, api)
+	user, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[2].AccountId
+	startTime := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name   string
+		req    *ledgerv1.ScheduleMovementRequest
+		expect error
+	}{
+		{
+			name: "invalid recurrence",
+			req: &ledgerv1.ScheduleMovementRequest{
+				Transact: &ledgerv1.TransactRequest{
+					IdempotencyKey:  "invalid_recurrence",
+					MovementEntries: []*ledgerv1.MovementEntry{{FromAccountId: deposit, ToAccountId: user, Amount: "100"}},
+				},
+				StartTime:  timestamppb.New(startTime),
+				Recurrence: "0 0 * *",
+			},
+			expect: ledger.ErrInvalidRecurrence,
+		},
+		{
+			name: "end time before start time",
+			req: &ledgerv1.ScheduleMovementRequest{
+				Transact: &ledgerv1.TransactRequest{
+					IdempotencyKey:  "invalid_end_time",
+					MovementEntries: []*ledgerv1.MovementEntry{{FromAccountId: deposit, ToAccountId: user, Amount: "100"}},
+				},
+				StartTime:  timestamppb.New(startTime),
+				Recurrence: "@hourly",
+				EndTime:    timestamppb.New(startTime.Add(-time.Minute)),
+			},
+			expect: ledger.ErrInvalidTimeRange,
+		},
+		{
+			name: "account not found",
+			req: &ledgerv1.ScheduleMovementRequest{
+				Transact: &ledgerv1.TransactRequest{
+					IdempotencyKey:  "account_not_found",
+					MovementEntries: []*ledgerv1.MovementEntry{{FromAccountId: deposit, ToAccountId: "not_found", Amount: "100"}},
+				},
+				StartTime: timestamppb.New(startTime),
+			},
+			expect: ledger.ErrAccountNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := api.ScheduleMovement(context.Background(), test.req)
+			if !errors.Is(err, test.expect) {
+				t.Fatalf("expecting error %v but got %v", test.expect, err)
+			}
+		})
+	}
+}
+
+func TestNextScheduledOccurrence(t *testing.T) {
+	occurrenceAt := time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		schedule     ledgerpg.ScheduledMovement
+		at           time.Time
+		expectStatus int32
+		expectNext   time.Time
+	}{
+		{
+			name: "one-off",
+			schedule: ledgerpg.ScheduledMovement{
+				OccurrenceAt: occurrenceAt,
+			},
+			expectStatus: ledger.ScheduledMovementStatusCompleted,
+			expectNext:   occurrenceAt,
+		},
+		{
+			name: "recurring",
+			schedule: ledgerpg.ScheduledMovement{
+				Recurrence:   sql.NullString{String: "0 9 * * *", Valid: true},
+				OccurrenceAt: occurrenceAt,
+			},
+			expectStatus: ledger.ScheduledMovementStatusActive,
+			expectNext:   occurrenceAt.AddDate(0, 0, 1),
+		},
+		{
+			name: "recurring passing end time",
+			schedule: ledgerpg.ScheduledMovement{
+				Recurrence:   sql.NullString{String: "0 9 * * *", Valid: true},
+				OccurrenceAt: occurrenceAt,
+				EndAt:        sql.NullTime{Time: occurrenceAt.AddDate(0, 0, 1), Valid: true},
+			},
+			expectStatus: ledger.ScheduledMovementStatusCompleted,
+			expectNext:   occurrenceAt,
+		},
+		{
+			name: "recurring posted late",
+			schedule: ledgerpg.ScheduledMovement{
+				Recurrence:   sql.NullString{String: "0 9 * * *", Valid: true},
+				OccurrenceAt: occurrenceAt,
+			},
+			// The scheduler is down for 30 days, the missed occurrences are skipped.
+			at:           occurrenceAt.AddDate(0, 0, 30).Add(time.Hour),
+			expectStatus: ledger.ScheduledMovementStatusActive,
+			expectNext:   occurrenceAt.AddDate(0, 0, 31),
+		},
+		{
+			name: "recurring posted late passing end time",
+			schedule: ledgerpg.ScheduledMovement{
+				Recurrence:   sql.NullString{String: "0 9 * * *", Valid: true},
+				OccurrenceAt: occurrenceAt,
+				EndAt:        sql.NullTime{Time: occurrenceAt.AddDate(0, 0, 10), Valid: true},
+			},
+			at:           occurrenceAt.AddDate(0, 0, 30),
+			expectStatus: ledger.ScheduledMovementStatusCompleted,
+			expectNext:   occurrenceAt,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			params := ledgerpg.UpdateScheduledMovementRunParams{
+				ScheduleStatus: ledger.ScheduledMovementStatusActive,
+				OccurrenceAt:   test.schedule.OccurrenceAt,
+				Attempts:       2,
+			}
+			at := test.at
+			if at.IsZero() {
+				at = test.schedule.OccurrenceAt
+			}
+			if err := nextScheduledOccurrence(test.schedule, at, &params); err != nil {
+				t.Fatal(err)
+			}
+			if params.ScheduleStatus != test.expectStatus {
+				t.Fatalf("expecting status %d but got %d", test.expectStatus, params.ScheduleStatus)
+			}
+			if !params.NextRunAt.Equal(test.expectNext) {
+				t.Fatalf("expecting next run at %s but got %s", test.expectNext, params.NextRunAt)
+			}
+			if params.RunCount != 1 || params.Attempts != 0 {
+				t.Fatalf("expecting run count 1 and attempts 0 but got %d and %d", params.RunCount, params.Attempts)
+			}
+		})
+	}
+}
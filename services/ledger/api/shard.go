@@ -0,0 +1,172 @@
+package api
+
+import (
+	"context"
+	"hash/fnv"
+	"time"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+// CreateBalanceShards shards the balance of a hot account, for example the deposit and withdrawal system accounts, so the movements of
+// the account don't need to wait for the lock of a single balance row. The movements of the account are recorded to one of its balance
+// shards, and the balance of the account is the total balance of the account and its shards.
+//
+// The account that cannot have negative balance is sharded for its credits only, as the balance of a single shard cannot be used to
+// check the balance of the account. The debits of the account are recorded to the account itself under lock, and the balance of its
+// shards can be used by the debits as the balance of the credit-only shards never goes down.
+func (a *API) CreateBalanceShards(ctx context.Context, req *ledgerv1.CreateBalanceShardsRequest) (*ledgerv1.CreateBalanceShardsResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	shardAccountIDs, err := a.queries.CreateBalanceShards(ctx, ledgerpg.CreateBalanceShardsParams{
+		AccountID: req.GetAccountId(),
+		Shards:    req.GetShards(),
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ledgerv1.CreateBalanceShardsResponse{
+		AccountId:       req.GetAccountId(),
+		Shards:          req.GetShards(),
+		ShardAccountIds: shardAccountIDs,
+	}, nil
+}
+
+// routeBalanceShards replaces the sharded accounts in the movement entries with one of their balance shards. The shard is chosen from
+// the hash of the key and the account, so the retries of the same movement are routed to the same shard. The balances of the shards
+// are added to the balances, so the entries can be converted to the ledger entries.
+//
+// The entries are only routed when the sharded account is active, the inactive account is kept so the movement is rejected when the
+// ledger entries are created. The debits of the account that cannot have negative balance are not routed, as its balance shards are
+// credit-only. The status of the sharded account is checked again under lock by the data layer, so the movement of
+// the balance shard is rejected when the account is frozen or closed concurrently.
+func (a *API) routeBalanceShards(ctx context.Context, key string, balances map[string]ledgerpg.GetAccountsBalanceRow, entries []*ledgerv1.MovementEntry) ([]*ledgerv1.MovementEntry, error) {
+	shardAccountID := func(accountID string, debit bool) (string, bool) {
+		balance, ok := balances[accountID]
+		if !ok || balance.BalanceShards == 0 || balance.AccountStatus != ledger.AccountStatusActive || (debit && !balance.AllowNegative) {
+			return accountID, false
+		}
+		h := fnv.New32a()
+		h.Write([]byte(key + ":" + accountID))
+		return ledger.BalanceShardAccountID(accountID, int32(h.Sum32()%uint32(balance.BalanceShards))), true
+	}
+
+	var shards []string
+	routed := make([]*ledgerv1.MovementEntry, len(entries))
+	for idx, entry := range entries {
+		fromAccountID, fromSharded := shardAccountID(entry.GetFromAccountId(), true)
+		toAccountID, toSharded := shardAccountID(entry.GetToAccountId(), false)
+		// Only retrieve the balances of the shards which are not yet retrieved.
+		if _, ok := balances[fromAccountID]; fromSharded && !ok {
+			shards = append(shards, fromAccountID)
+		}
+		if _, ok := balances[toAccountID]; toSharded && !ok {
+			shards = append(shards, toAccountID)
+		}
+		routed[idx] = &ledgerv1.MovementEntry{
+			FromAccountId: fromAccountID,
+			ToAccountId:   toAccountID,
+			Amount:        entry.GetAmount(),
+			ClientId:      entry.GetClientId(),
+		}
+	}
+	if len(shards) == 0 {
+		return routed, nil
+	}
+	shardBalances, err := a.queries.GetAccountsBalanceMappedByAccID(ctx, shards...)
+	if err != nil {
+		return nil, err
+	}
+	for accountID, balance := range shardBalances {
+		balances[accountID] = balance
+	}
+	return routed, nil
+}
+
+// routeCreditOnlyShardDebits replaces the credit-only balance shards debited by the entries with their sharded account, as the credit-only
+// shards are never debited. For example, the reversal of a movement credited to the balance shard debits the sharded account instead.
+// The function returns the sharded account of the replaced balance shards, and the balances of the sharded accounts are added to the
+// balances.
+func (a *API) routeCreditOnlyShardDebits(ctx context.Context, balances map[string]ledgerpg.GetAccountsBalanceRow, entries []*ledgerv1.MovementEntry) (map[string]string, error) {
+	var candidates []string
+	for _, entry := range entries {
+		balance, ok := balances[entry.GetFromAccountId()]
+		if ok && balance.ParentAccountID.Valid && !balance.AllowNegative {
+			candidates = append(candidates, entry.GetFromAccountId())
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+	accounts, err := a.queries.GetAccounts(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+	parents := make(map[string]string)
+	var missing []string
+	for _, account := range accounts {
+		if !account.IsBalanceShard {
+			continue
+		}
+		parents[account.AccountID] = account.ParentAccountID.String
+		if _, ok := balances[account.ParentAccountID.String]; !ok {
+			missing = append(missing, account.ParentAccountID.String)
+		}
+	}
+	for _, entry := range entries {
+		if parent, ok := parents[entry.GetFromAccountId()]; ok {
+			entry.FromAccountId = parent
+		}
+	}
+	if len(missing) == 0 {
+		return parents, nil
+	}
+	parentBalances, err := a.queries.GetAccountsBalanceMappedByAccID(ctx, missing...)
+	if err != nil {
+		return nil, err
+	}
+	for accountID, balance := range parentBalances {
+		balances[accountID] = balance
+	}
+	return parents, nil
+}
+
+// unshardMovementEntries replaces the balance shards in the movement entries with their sharded account, so the recorded entries can
+// be compared with the requested entries.
+func (a *API) unshardMovementEntries(ctx context.Context, entries []*ledgerv1.MovementEntry) error {
+	accountIDs := make([]string, 0, len(entries)*2)
+	for _, entry := range entries {
+		accountIDs = append(accountIDs, entry.GetFromAccountId(), entry.GetToAccountId())
+	}
+	accounts, err := a.queries.GetAccounts(ctx, accountIDs)
+	if err != nil {
+		return err
+	}
+	parents := make(map[string]string)
+	for _, account := range accounts {
+		if account.IsBalanceShard {
+			parents[account.AccountID] = account.ParentAccountID.String
+		}
+	}
+	for _, entry := range entries {
+		if parent, ok := parents[entry.FromAccountId]; ok {
+			entry.FromAccountId = parent
+		}
+		if parent, ok := parents[entry.ToAccountId]; ok {
+			entry.ToAccountId = parent
+		}
+	}
+	return nil
+}
+
+// addBalanceShards adds the total balance of the balance shards to the balance of the sharded accounts.
+func addBalanceShards(balances []ledgerpg.GetAccountsBalanceRow) {
+	for idx, balance := range balances {
+		balances[idx].Balance = balance.Balance.Add(balance.ShardsBalance)
+	}
+}
@@ -0,0 +1,252 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/studio-asd/pkg/postgres"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+func TestBalanceShards(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	accounts := createSimpleTestAccounts(t, api)
+	user, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[2].AccountId
+
+	resp, err := api.CreateBalanceShards(context.Background(), &ledgerv1.CreateBalanceShardsRequest{
+		AccountId: deposit,
+		Shards:    4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetShardAccountIds()) != 4 {
+		t.Fatalf("expecting 4 shards but got %d", len(resp.GetShardAccountIds()))
+	}
+
+	t.Run("decrease shards", func(t *testing.T) {
+		_, err := api.CreateBalanceShards(context.Background(), &ledgerv1.CreateBalanceShardsRequest{
+			AccountId: deposit,
+			Shards:    2,
+		})
+		if !errors.Is(err, ledger.ErrInvalidBalanceShards) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrInvalidBalanceShards, err)
+		}
+	})
+
+	var first *ledgerv1.TransactResponse
+	for i := range 10 {
+		resp, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+			IdempotencyKey: "deposit_" + strconv.Itoa(i),
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: deposit, ToAccountId: user, Amount: "10"},
+			},
+		}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			first = resp
+		}
+		// The balance of the sharded account itself is never changed by the movement.
+		for _, balance := range resp.GetEndingBalances() {
+			if balance.GetAccountId() == deposit {
+				t.Fatal("expecting the movement to be recorded to the balance shard")
+			}
+		}
+	}
+
+	t.Run("replay", func(t *testing.T) {
+		resp, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+			IdempotencyKey: "deposit_0",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: deposit, ToAccountId: user, Amount: "10"},
+			},
+		}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetMovementId() != first.GetMovementId() {
+			t.Fatalf("expecting movement %s but got %s", first.GetMovementId(), resp.GetMovementId())
+		}
+	})
+
+	balances, err := api.GetAccountsBalance(context.Background(), &ledgerv1.GetAccountsBalanceRequest{AccountIds: []string{user, deposit}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectBalances := map[string]string{
+		user:    "100",
+		deposit: "-100",
+	}
+	for _, balance := range balances.GetBalances() {
+		if balance.GetBalance() != expectBalances[balance.GetAccountId()] {
+			t.Fatalf("expecting account %s balance to be %s but got %s", balance.GetAccountId(), expectBalances[balance.GetAccountId()], balance.GetBalance())
+		}
+	}
+
+	t.Run("freeze sharded account", func(t *testing.T) {
+		// The status of the sharded account cannot be changed while the movement of its balance shard is not committed, so the
+		// freeze times out inside the movement.
+		api.AddMovementPreCommitHook(PreCommitHook[ledger.MovementInfo]{
+			Name: "freeze",
+			Fn: func(ctx context.Context, tx *postgres.Postgres, info ledger.MovementInfo) error {
+				ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+				defer cancel()
+				_, err := api.UpdateAccountStatus(ctx, &ledgerv1.UpdateAccountStatusRequest{
+					AccountId: deposit,
+					Status:    ledgerv1.AccountStatus_ACCOUNT_STATUS_FROZEN,
+				})
+				if !errors.Is(err, context.DeadlineExceeded) {
+					return fmt.Errorf("expecting the freeze to wait for the movement but got %v", err)
+				}
+				return nil
+			},
+		})
+		if _, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+			IdempotencyKey: "deposit_freeze",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: deposit, ToAccountId: user, Amount: "10"},
+			},
+		}, nil); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := api.UpdateAccountStatus(context.Background(), &ledgerv1.UpdateAccountStatusRequest{
+			AccountId: deposit,
+			Status:    ledgerv1.AccountStatus_ACCOUNT_STATUS_FROZEN,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		_, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+			IdempotencyKey: "deposit_frozen",
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: deposit, ToAccountId: user, Amount: "10"},
+			},
+		}, nil)
+		if !errors.Is(err, ledger.ErrAccountFrozen) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrAccountFrozen, err)
+		}
+	})
+}
+
+// TestCreditOnlyBalanceShards tests the balance shards of the account that cannot have negative balance, where only the credits are
+// recorded to the shards and the debits use the balance of the shards.
+func TestCreditOnlyBalanceShards(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	accounts := createSimpleTestAccounts(t, api)
+	user, merchant, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[1].AccountId, accounts.Accounts[2].AccountId
+	if _, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+		IdempotencyKey: "deposit",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{FromAccountId: deposit, ToAccountId: user, Amount: "100"},
+		},
+	}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.CreateBalanceShards(context.Background(), &ledgerv1.CreateBalanceShardsRequest{
+		AccountId: merchant,
+		Shards:    2,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	var payments []*ledgerv1.TransactResponse
+	for i := range 2 {
+		resp, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+			IdempotencyKey: "pay_" + strconv.Itoa(i),
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: user, ToAccountId: merchant, Amount: "30"},
+			},
+		}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, balance := range resp.GetEndingBalances() {
+			if balance.GetAccountId() == merchant {
+				t.Fatal("expecting the credit to be recorded to the balance shard")
+			}
+		}
+		payments = append(payments, resp)
+	}
+
+	// The reversal debits the merchant account itself, as the credit-only balance shard is never debited.
+	reversal, err := api.ReverseMovement(context.Background(), &ledgerv1.ReverseMovementRequest{
+		MovementId:     payments[0].GetMovementId(),
+		ReversalReason: "refund",
+		IdempotencyKey: "refund",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var debited bool
+	for _, balance := range reversal.GetEndingBalances() {
+		if balance.GetAccountId() == merchant {
+			debited = true
+		}
+	}
+	if !debited {
+		t.Fatal("expecting the reversal to debit the sharded account")
+	}
+
+	// The debit uses the balance of the shards, the merchant only have 30 left after the reversal.
+	resp, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+		IdempotencyKey: "payout",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{FromAccountId: merchant, ToAccountId: user, Amount: "30"},
+		},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var recorded bool
+	for _, balance := range resp.GetEndingBalances() {
+		if balance.GetAccountId() == merchant {
+			recorded = true
+		}
+	}
+	if !recorded {
+		t.Fatal("expecting the debit to be recorded to the sharded account")
+	}
+	_, err = api.Transact(context.Background(), &ledgerv1.TransactRequest{
+		IdempotencyKey: "payout_insufficient",
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{FromAccountId: merchant, ToAccountId: user, Amount: "1"},
+		},
+	}, nil)
+	if !errors.Is(err, ledger.ErrInsufficientBalance) {
+		t.Fatalf("expecting error %v but got %v", ledger.ErrInsufficientBalance, err)
+	}
+
+	balances, err := api.GetAccountsBalance(context.Background(), &ledgerv1.GetAccountsBalanceRequest{AccountIds: []string{user, merchant}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectBalances := map[string]string{
+		user:     "100",
+		merchant: "0",
+	}
+	for _, balance := range balances.GetBalances() {
+		if balance.GetBalance() != expectBalances[balance.GetAccountId()] {
+			t.Fatalf("expecting account %s balance to be %s but got %s", balance.GetAccountId(), expectBalances[balance.GetAccountId()], balance.GetBalance())
+		}
+	}
+}
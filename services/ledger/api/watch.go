@@ -0,0 +1,302 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/studio-asd/pkg/postgres"
+	"github.com/studio-asd/pkg/srun"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+const (
+	// watchAccountsBufferSize is the number of balance changes buffered for each watcher. The watcher is closed with ErrWatchLagged
+	// when the buffer is full.
+	watchAccountsBufferSize = 256
+	// watchOutboxBatchSize is the number of outbox events read by the AccountBalanceWatcher at once.
+	watchOutboxBatchSize = 500
+)
+
+var _ srun.ServiceRunnerAware = (*AccountBalanceWatcher)(nil)
+
+// WatchAccounts sends the balance changes of the accounts via the send function until the context is canceled. The changes after the
+// resume_ledger_id of the accounts are sent first from the balance histories, then the new changes are sent from the outbox events
+// dispatched by the AccountBalanceWatcher.
+//
+// Please note that the changes of the sharded account are recorded to its balance shards, so the shard account ids need to be watched
+// to receive the changes of the sharded account.
+func (a *API) WatchAccounts(ctx context.Context, req *ledgerv1.WatchAccountsRequest, send func(*ledgerv1.AccountBalanceChange) error) error {
+	if err := validator.Validate(req); err != nil {
+		return err
+	}
+	accountIDs := make([]string, len(req.GetAccounts()))
+	for idx, account := range req.GetAccounts() {
+		accountIDs[idx] = account.GetAccountId()
+	}
+	accounts, err := a.queries.GetAccounts(ctx, accountIDs)
+	if err != nil {
+		return err
+	}
+	for _, accountID := range accountIDs {
+		found := false
+		for _, account := range accounts {
+			if account.AccountID == accountID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%w: %s", ledger.ErrAccountNotFound, accountID)
+		}
+	}
+
+	// Subscribe to the new changes before reading the balance histories, so the changes committed while the histories are read are
+	// not missed. The changes that are sent from the histories are skipped when they are dispatched again.
+	watcher := a.watchers.subscribe(accountIDs)
+	defer a.watchers.unsubscribe(watcher)
+
+	sent := make(map[string]struct{})
+	for _, account := range req.GetAccounts() {
+		if account.GetResumeLedgerId() == "" {
+			continue
+		}
+		histories, err := a.queries.ListAccountBalanceHistoryFromLedger(ctx, ledgerpg.ListAccountBalanceHistoryFromLedgerParams{
+			AccountID: account.GetAccountId(),
+			LedgerID:  account.GetResumeLedgerId(),
+		})
+		if err != nil {
+			return err
+		}
+		// The first history is always the history of the resume ledger id, which is already received by the client.
+		if len(histories) == 0 {
+			return fmt.Errorf("%w: %s", ledger.ErrResumeLedgerNotFound, account.GetResumeLedgerId())
+		}
+		for _, history := range histories[1:] {
+			err := send(&ledgerv1.AccountBalanceChange{
+				AccountId:       history.AccountID,
+				MovementId:      history.MovementID,
+				LedgerId:        history.LedgerID,
+				NewBalance:      history.Balance.String(),
+				PreviousBalance: history.PreviousBalance.String(),
+				CreateTime:      timestamppb.New(history.CreatedAt),
+			})
+			if err != nil {
+				return err
+			}
+			sent[history.LedgerID] = struct{}{}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-watcher.laggedC:
+			return ledger.ErrWatchLagged
+		case change := <-watcher.changes:
+			if _, ok := sent[change.GetLedgerId()]; ok {
+				delete(sent, change.GetLedgerId())
+				continue
+			}
+			if err := send(change); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// balanceWatcher receives the balance changes of the watched accounts.
+type balanceWatcher struct {
+	accounts map[string]struct{}
+	changes  chan *ledgerv1.AccountBalanceChange
+	// laggedC is closed when the watcher can't receive the changes because its buffer is full.
+	laggedC chan struct{}
+}
+
+// trySend sends the change to the watcher without blocking, false is returned when the buffer of the watcher is full.
+func (w *balanceWatcher) trySend(change *ledgerv1.AccountBalanceChange) bool {
+	select {
+	case w.changes <- change:
+		return true
+	default:
+		return false
+	}
+}
+
+// balanceWatchers dispatches the balance changes to the watchers of the accounts.
+type balanceWatchers struct {
+	mu       sync.Mutex
+	watchers map[*balanceWatcher]struct{}
+}
+
+func newBalanceWatchers() *balanceWatchers {
+	return &balanceWatchers{
+		watchers: make(map[*balanceWatcher]struct{}),
+	}
+}
+
+func (b *balanceWatchers) subscribe(accountIDs []string) *balanceWatcher {
+	watcher := &balanceWatcher{
+		accounts: make(map[string]struct{}, len(accountIDs)),
+		changes:  make(chan *ledgerv1.AccountBalanceChange, watchAccountsBufferSize),
+		laggedC:  make(chan struct{}),
+	}
+	for _, accountID := range accountIDs {
+		watcher.accounts[accountID] = struct{}{}
+	}
+	b.mu.Lock()
+	b.watchers[watcher] = struct{}{}
+	b.mu.Unlock()
+	return watcher
+}
+
+func (b *balanceWatchers) unsubscribe(watcher *balanceWatcher) {
+	b.mu.Lock()
+	delete(b.watchers, watcher)
+	b.mu.Unlock()
+}
+
+// dispatch sends the changes to the watchers of the accounts. The dispatch never blocks, the watcher with a full buffer is removed
+// and closed as lagged, so a slow client doesn't block the changes of the other clients.
+func (b *balanceWatchers) dispatch(changes []*ledgerv1.AccountBalanceChange) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for watcher := range b.watchers {
+		for _, change := range changes {
+			if _, ok := watcher.accounts[change.GetAccountId()]; !ok {
+				continue
+			}
+			if !watcher.trySend(change) {
+				delete(b.watchers, watcher)
+				close(watcher.laggedC)
+				break
+			}
+		}
+	}
+}
+
+// AccountBalanceWatcher reads the movement_created events from the outbox and dispatches the balance changes to the WatchAccounts
+// streams. The watcher starts from the latest event of the outbox and keeps its position in memory, as the WatchAccounts client
+// resumes from its own ledger id.
+type AccountBalanceWatcher struct {
+	api      *API
+	interval time.Duration
+	logger   *slog.Logger
+	stopC    chan struct{}
+
+	position       ledgerpg.GetOutboxLatestPositionRow
+	positionLoaded bool
+}
+
+// NewAccountBalanceWatcher creates a new service runner to dispatch the balance changes for every interval.
+func NewAccountBalanceWatcher(api *API, interval time.Duration) *AccountBalanceWatcher {
+	return &AccountBalanceWatcher{
+		api:      api,
+		interval: interval,
+		stopC:    make(chan struct{}),
+	}
+}
+
+func (w *AccountBalanceWatcher) Name() string {
+	return "ledger_account_balance_watcher"
+}
+
+func (w *AccountBalanceWatcher) Init(ctx srun.Context) error {
+	w.logger = ctx.Logger
+	return nil
+}
+
+func (w *AccountBalanceWatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-w.stopC:
+			return nil
+		case <-ticker.C:
+			if err := w.Dispatch(ctx); err != nil {
+				w.logger.ErrorContext(ctx, "Failed to dispatch account balance changes", "error", err)
+			}
+		}
+	}
+}
+
+func (w *AccountBalanceWatcher) Ready(ctx context.Context) error {
+	return nil
+}
+
+func (w *AccountBalanceWatcher) Stop(ctx context.Context) error {
+	close(w.stopC)
+	return nil
+}
+
+// Dispatch reads the new events from the outbox and dispatches the balance changes to the watchers.
+func (w *AccountBalanceWatcher) Dispatch(ctx context.Context) error {
+	if !w.positionLoaded {
+		position, err := w.api.queries.GetOutboxLatestPosition(ctx)
+		if err != nil && !errors.Is(err, postgres.ErrNoRows) {
+			return err
+		}
+		w.position = position
+		w.positionLoaded = true
+	}
+
+	for {
+		rows, err := w.api.queries.ListOutboxEvents(ctx, ledgerpg.ListOutboxEventsParams{
+			TransactionID: w.position.TransactionID,
+			EventID:       w.position.EventID,
+			LimitSize:     watchOutboxBatchSize,
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+
+		var changes []*ledgerv1.AccountBalanceChange
+		for _, row := range rows {
+			if row.EventType != ledger.EventTypeMovementCreated {
+				continue
+			}
+			event := &ledgerv1.Event{}
+			if err := proto.Unmarshal(row.Payload, event); err != nil {
+				return err
+			}
+			movement := event.GetMovementCreated()
+			for _, balance := range movement.GetEndingBalances() {
+				changes = append(changes, &ledgerv1.AccountBalanceChange{
+					AccountId:       balance.GetAccountId(),
+					MovementId:      movement.GetMovementId(),
+					LedgerId:        balance.GetLedgerId(),
+					NewBalance:      balance.GetNewBalance(),
+					PreviousBalance: balance.GetPreviousBalance(),
+					CreateTime:      event.GetCreateTime(),
+				})
+			}
+		}
+		w.api.watchers.dispatch(changes)
+
+		last := rows[len(rows)-1]
+		w.position = ledgerpg.GetOutboxLatestPositionRow{
+			TransactionID: last.TransactionID,
+			EventID:       last.EventID,
+		}
+		if len(rows) < watchOutboxBatchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+func TestWatchAccounts(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "ledger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), Options{})
+	watcher := NewAccountBalanceWatcher(api, time.Hour)
+	accounts := createSimpleTestAccounts(t, api)
+	user, deposit := accounts.Accounts[0].AccountId, accounts.Accounts[2].AccountId
+
+	transact := func(key string) string {
+		t.Helper()
+		resp, err := api.Transact(context.Background(), &ledgerv1.TransactRequest{
+			IdempotencyKey: key,
+			MovementEntries: []*ledgerv1.MovementEntry{
+				{FromAccountId: deposit, ToAccountId: user, Amount: "10"},
+			},
+		}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, balance := range resp.GetEndingBalances() {
+			if balance.GetAccountId() == user {
+				return balance.GetLedgerId()
+			}
+		}
+		t.Fatal("expecting the balance of the user in the ending balances")
+		return ""
+	}
+
+	resumeLedgerID := transact("watch_1")
+	// Load the position of the watcher, so the second movement is dispatched again after it is sent from the balance histories.
+	if err := watcher.Dispatch(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	secondLedgerID := transact("watch_2")
+
+	t.Run("resume ledger not found", func(t *testing.T) {
+		err := api.WatchAccounts(context.Background(), &ledgerv1.WatchAccountsRequest{
+			Accounts: []*ledgerv1.WatchAccountsRequest_Account{
+				{AccountId: user, ResumeLedgerId: "unknown"},
+			},
+		}, func(*ledgerv1.AccountBalanceChange) error { return nil })
+		if !errors.Is(err, ledger.ErrResumeLedgerNotFound) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrResumeLedgerNotFound, err)
+		}
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := make(chan *ledgerv1.AccountBalanceChange, 10)
+	errC := make(chan error, 1)
+	go func() {
+		errC <- api.WatchAccounts(ctx, &ledgerv1.WatchAccountsRequest{
+			Accounts: []*ledgerv1.WatchAccountsRequest_Account{
+				{AccountId: user, ResumeLedgerId: resumeLedgerID},
+			},
+		}, func(change *ledgerv1.AccountBalanceChange) error {
+			changes <- change
+			return nil
+		})
+	}()
+
+	change := <-changes
+	if change.GetLedgerId() != secondLedgerID || change.GetNewBalance() != "20" {
+		t.Fatalf("expecting the change of ledger %s with balance 20 but got %v", secondLedgerID, change)
+	}
+
+	thirdLedgerID := transact("watch_3")
+	timeout := time.After(10 * time.Second)
+	for change = nil; change == nil; {
+		// The events are only dispatched when all older transactions are finished, so keep dispatching until the change arrives.
+		if err := watcher.Dispatch(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case change = <-changes:
+		case <-time.After(100 * time.Millisecond):
+		case <-timeout:
+			t.Fatal("timeout waiting for the balance change")
+		}
+	}
+	if change.GetLedgerId() != thirdLedgerID || change.GetNewBalance() != "30" {
+		t.Fatalf("expecting the change of ledger %s with balance 30 but got %v", thirdLedgerID, change)
+	}
+
+	cancel()
+	if err := <-errC; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expecting error %v but got %v", context.Canceled, err)
+	}
+}
+
+func TestBalanceWatchersLagged(t *testing.T) {
+	t.Parallel()
+
+	watchers := newBalanceWatchers()
+	slow := watchers.subscribe([]string{"one"})
+	other := watchers.subscribe([]string{"two"})
+
+	changes := make([]*ledgerv1.AccountBalanceChange, watchAccountsBufferSize+1)
+	for idx := range changes {
+		changes[idx] = &ledgerv1.AccountBalanceChange{AccountId: "one"}
+	}
+	watchers.dispatch(changes)
+
+	select {
+	case <-slow.laggedC:
+	default:
+		t.Fatal("expecting the slow watcher to be lagged")
+	}
+	select {
+	case <-other.laggedC:
+		t.Fatal("expecting the other watcher to not be lagged")
+	default:
+	}
+	if _, ok := watchers.watchers[slow]; ok {
+		t.Fatal("expecting the slow watcher to be removed")
+	}
+}
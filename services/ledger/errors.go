@@ -12,4 +12,33 @@ var (
 	ErrEmptyEntries                    = errors.New("movement entries is required")
 	ErrInsufficientBalance             = errors.New("insufficient balance")
 	ErrCannotMoveToSelf                = errors.New("cannot move money to the same account")
+	ErrMovementNotFound                = errors.New("movement not found")
+	ErrMovementAlreadyReversed         = errors.New("movement already reversed")
+	ErrInvalidTimeRange                = errors.New("invalid time range")
+	ErrInvalidPageToken                = errors.New("invalid page token")
+	ErrIdempotencyKeyConflict          = errors.New("idempotency key already used with different movement entries")
+	ErrAccountFrozen                   = errors.New("account is frozen")
+	ErrAccountClosed                   = errors.New("account is closed")
+	ErrInvalidAccountStatusTransition  = errors.New("invalid account status transition")
+	ErrPendingMovementNotFound         = errors.New("pending movement not found")
+	ErrPendingMovementNotPending       = errors.New("pending movement is already captured, voided or expired")
+	ErrPendingMovementExpired          = errors.New("pending movement is expired")
+	ErrInvalidAmount                   = errors.New("invalid amount")
+	ErrHoldNotFound                    = errors.New("hold not found")
+	ErrHoldAlreadyReleased             = errors.New("hold already released")
+	ErrHoldReferenceConflict           = errors.New("client reference already used with different hold")
+	ErrFXSameCurrency                  = errors.New("fx movement requires different currencies")
+	ErrFXRateRequired                  = errors.New("fx rate is required")
+	ErrCurrencyAlreadyExists           = errors.New("currency already exists")
+	ErrEmptyBatch                      = errors.New("batch movements is required")
+	ErrBatchTooLarge                   = errors.New("too many movements in a batch")
+	ErrBalanceShardsNotAllowed         = errors.New("balance shards are only allowed for account without parent")
+	ErrInvalidBalanceShards            = errors.New("invalid number of balance shards")
+	ErrResumeLedgerNotFound            = errors.New("resume ledger id not found")
+	ErrWatchLagged                     = errors.New("account watcher is lagging behind the balance changes")
+	ErrHookDeadlineExceeded            = errors.New("hook deadline exceeded")
+	ErrLockTimeout                     = errors.New("timeout waiting for the accounts lock")
+	ErrInvalidRecurrence               = errors.New("invalid recurrence")
+	ErrScheduledMovementNotFound       = errors.New("scheduled movement not found")
+	ErrScheduledMovementNotActive      = errors.New("scheduled movement is already completed, cancelled or failed")
 )
@@ -0,0 +1,7 @@
+package ledger
+
+// The types of the events written to the ledger outbox.
+const (
+	EventTypeMovementCreated = "movement_created"
+	EventTypeAccountCreated  = "account_created"
+)
@@ -0,0 +1,24 @@
+package ledger
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/studio-asd/go-example/internal/currency"
+)
+
+// FXRateProvider provides the conversion rate between two currencies. The rate is used to convert the amount in the source
+// currency to the destination currency, so the destination amount is amount * rate.
+type FXRateProvider interface {
+	GetRate(ctx context.Context, from, to *currency.Currency) (decimal.Decimal, error)
+}
+
+// FXClearingAccountID returns the account id of the FX clearing account of the currency. Every cross-currency movement is routed
+// through the clearing account of both currencies, so each currency leg of the movement is balanced. The account id is a UUIDV5
+// with namespace_oid and format of: fx_clearing:currency_id.
+func FXClearingAccountID(currencyID int32) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte("fx_clearing:"+strconv.Itoa(int(currencyID)))).String()
+}
@@ -0,0 +1,9 @@
+package ledger
+
+// Hold statuses, the status is persisted in the account_holds.hold_status column.
+const (
+	// HoldStatusActive means the amount of the hold is excluded from the available balance of the account.
+	HoldStatusActive int32 = 1
+	// HoldStatusReleased means the amount of the hold is released back to the available balance of the account.
+	HoldStatusReleased int32 = 2
+)
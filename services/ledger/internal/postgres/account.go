@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/studio-asd/pkg/postgres"
 
 	"github.com/studio-asd/go-example/internal/currency"
+	"github.com/studio-asd/go-example/services/ledger"
 )
 
 type CreateLedgerAccount struct {
@@ -20,7 +22,9 @@ type CreateLedgerAccount struct {
 	ParentAccountID string
 	AllowNegative   bool
 	Currency        *currency.Currency
-	CreatedAt       time.Time
+	// Status is the status of the account, the account is active by default.
+	Status    int32
+	CreatedAt time.Time
 	// balance can only be set internally for testing purpose.
 	balance decimal.Decimal
 }
@@ -54,6 +58,11 @@ func (q *Queries) CreateLedgerAccount(ctx context.Context, c CreateLedgerAccount
 		}
 	}
 
+	status := c.Status
+	if status == 0 {
+		status = ledger.AccountStatusActive
+	}
+
 	fn := func(ctx context.Context, qr *Queries) error {
 		if err := qr.CreateAccount(ctx, CreateAccountParams{
 			AccountID:       c.AccountID,
@@ -61,6 +70,7 @@ func (q *Queries) CreateLedgerAccount(ctx context.Context, c CreateLedgerAccount
 			Description:     c.Description,
 			ParentAccountID: parentAccountID,
 			CurrencyID:      c.Currency.ID,
+			AccountStatus:   status,
 			CreatedAt:       c.CreatedAt,
 		}); err != nil {
 			return err
@@ -77,13 +87,20 @@ func (q *Queries) CreateLedgerAccount(ctx context.Context, c CreateLedgerAccount
 		}); err != nil {
 			return err
 		}
-		return nil
+		// Write the event of the account to the outbox inside the same transaction.
+		return createAccountCreatedEvent(ctx, qr, c)
 	}
 	return q.WithMetrics(ctx, "createLedgerAccount", func(ctx context.Context, q *Queries) error {
 		return q.ensureInTransact(ctx, sql.LevelReadCommitted, fn)
 	})
 }
 
+// AvailableBalance returns the balance that can be used by the movements. The held amount and the amount reserved by the pending
+// movements are excluded from the available balance.
+func (g GetAccountsBalanceRow) AvailableBalance() decimal.Decimal {
+	return g.Balance.Sub(g.HeldAmount).Sub(g.ReservedAmount)
+}
+
 // GetAccountsBalanceMappByAccID returns the account balance of accounts using map and account_id as its key. The function can be used to quickly look into
 // the account information(O(1)) rather than looking from the entire accounts range(O(n)).
 func (q *Queries) GetAccountsBalanceMappedByAccID(ctx context.Context, accounts ...string) (map[string]GetAccountsBalanceRow, error) {
@@ -93,6 +110,7 @@ func (q *Queries) GetAccountsBalanceMappedByAccID(ctx context.Context, accounts
 			var i GetAccountsBalanceRow
 			if err := rows.Scan(
 				&i.AccountID,
+				&i.ParentAccountID,
 				&i.AllowNegative,
 				&i.Balance,
 				&i.CurrencyID,
@@ -100,6 +118,11 @@ func (q *Queries) GetAccountsBalanceMappedByAccID(ctx context.Context, accounts
 				&i.LastMovementID,
 				&i.CreatedAt,
 				&i.UpdatedAt,
+				&i.AccountStatus,
+				&i.HeldAmount,
+				&i.ReservedAmount,
+				&i.BalanceShards,
+				&i.ShardsBalance,
 			); err != nil {
 				return err
 			}
@@ -108,7 +131,7 @@ func (q *Queries) GetAccountsBalanceMappedByAccID(ctx context.Context, accounts
 			}
 			accountsBalance[i.AccountID] = i
 			return nil
-		})
+		}, accounts)
 	})
 	return accountsBalance, err
 }
@@ -145,3 +168,110 @@ func (q *Queries) GetAccountsBalanceWithChildMappedByAccID(ctx context.Context,
 	}
 	return accountsBalance, nil
 }
+
+type ChangeAccountStatusParams struct {
+	AccountID string
+	Status    int32
+	UpdatedAt time.Time
+}
+
+// ChangeAccountStatus changes the status of the account and returns the previous status of the account. The account is locked
+// while the status is changed, so the ongoing movements of the account need to finish before the status is changed. This
+// ensures that no movement goes through the account once the function returns.
+func (q *Queries) ChangeAccountStatus(ctx context.Context, params ChangeAccountStatusParams) (int32, error) {
+	var previousStatus int32
+	fn := func(ctx context.Context, q *Queries) error {
+		account, err := q.GetAccountStatusForUpdate(ctx, params.AccountID)
+		if err != nil {
+			if errors.Is(err, postgres.ErrNoRows) {
+				return fmt.Errorf("%w: account %s does not exist", ledger.ErrAccountNotFound, params.AccountID)
+			}
+			return err
+		}
+		if err := ledger.CheckAccountStatusTransition(account.AccountStatus, params.Status); err != nil {
+			return err
+		}
+		previousStatus = account.AccountStatus
+		return q.UpdateAccountStatus(ctx, UpdateAccountStatusParams{
+			AccountStatus: params.Status,
+			UpdatedAt: sql.NullTime{
+				Time:  params.UpdatedAt,
+				Valid: true,
+			},
+			AccountID: params.AccountID,
+		})
+	}
+	err := q.WithMetrics(ctx, "changeAccountStatus", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelReadCommitted, fn)
+	})
+	return previousStatus, err
+}
+
+type GetAccountLedgerStatementParams struct {
+	AccountID string
+	FromTime  time.Time
+	ToTime    time.Time
+	// AfterInternalID is the keyset cursor of the page. Only ledger entries with internal_id greater than the cursor
+	// will be returned.
+	AfterInternalID int64
+	Limit           int32
+}
+
+// AccountLedgerStatement is the ledger entries of an account within a time range, along with the balances needed to
+// calculate the running balance of each entry.
+type AccountLedgerStatement struct {
+	// OpeningBalance is the balance of the account at the start of the time range.
+	OpeningBalance decimal.Decimal
+	// ClosingBalance is the balance of the account at the end of the time range.
+	ClosingBalance decimal.Decimal
+	// PageOpeningBalance is the balance of the account before the first ledger entry of the page.
+	PageOpeningBalance decimal.Decimal
+	Ledgers            []AccountsLedger
+}
+
+// GetAccountLedgerStatement returns the ledger entries of an account within a time range using keyset pagination on the internal_id.
+// The opening balance is taken from the accounts_balance_history, so we don't have to summarize all the ledger entries of the account
+// before the time range. All queries are executed in a repeatable read transaction so the balances and entries are consistent.
+func (q *Queries) GetAccountLedgerStatement(ctx context.Context, params GetAccountLedgerStatementParams) (AccountLedgerStatement, error) {
+	var statement AccountLedgerStatement
+	fn := func(ctx context.Context, q *Queries) error {
+		openingBalance, err := q.GetAccountBalanceBeforeTime(ctx, GetAccountBalanceBeforeTimeParams{
+			AccountID: params.AccountID,
+			CreatedAt: params.FromTime,
+		})
+		// No rows means there are no movements for the account before the time range.
+		if err != nil && !errors.Is(err, postgres.ErrNoRows) {
+			return err
+		}
+		summary, err := q.GetAccountLedgerSummary(ctx, GetAccountLedgerSummaryParams{
+			InternalID: params.AfterInternalID,
+			AccountID:  params.AccountID,
+			FromTime:   params.FromTime,
+			ToTime:     params.ToTime,
+		})
+		if err != nil {
+			return err
+		}
+		ledgers, err := q.ListAccountLedger(ctx, ListAccountLedgerParams{
+			AccountID:  params.AccountID,
+			FromTime:   params.FromTime,
+			ToTime:     params.ToTime,
+			InternalID: params.AfterInternalID,
+			PageLimit:  params.Limit,
+		})
+		if err != nil {
+			return err
+		}
+		statement = AccountLedgerStatement{
+			OpeningBalance:     openingBalance,
+			ClosingBalance:     openingBalance.Add(summary.TotalAmount),
+			PageOpeningBalance: openingBalance.Add(summary.CursorAmount),
+			Ledgers:            ledgers,
+		}
+		return nil
+	}
+	err := q.WithMetrics(ctx, "getAccountLedgerStatement", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelRepeatableRead, fn)
+	})
+	return statement, err
+}
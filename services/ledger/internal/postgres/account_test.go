@@ -11,6 +11,7 @@ import (
 	"github.com/studio-asd/pkg/postgres"
 
 	"github.com/studio-asd/go-example/internal/currency"
+	"github.com/studio-asd/go-example/services/ledger"
 )
 
 func TestCreateLedgerAccounts(t *testing.T) {
@@ -46,14 +47,16 @@ func TestCreateLedgerAccounts(t *testing.T) {
 			},
 			expectAccounts: []Account{
 				{
-					AccountID:  "one",
-					CurrencyID: 1,
-					CreatedAt:  now.Add(time.Second),
+					AccountID:     "one",
+					CurrencyID:    1,
+					CreatedAt:     now.Add(time.Second),
+					AccountStatus: ledger.AccountStatusActive,
 				},
 				{
-					AccountID:  "two",
-					CurrencyID: 2,
-					CreatedAt:  now.Add(time.Second * 2),
+					AccountID:     "two",
+					CurrencyID:    2,
+					CreatedAt:     now.Add(time.Second * 2),
+					AccountStatus: ledger.AccountStatusActive,
 				},
 			},
 			expectAccountsBalance: []GetAccountsBalanceRow{
@@ -64,6 +67,7 @@ func TestCreateLedgerAccounts(t *testing.T) {
 					Balance:       decimal.Zero,
 					LastLedgerID:  "",
 					CreatedAt:     now.Add(time.Second * 1),
+					AccountStatus: ledger.AccountStatusActive,
 				},
 				{
 					AccountID:     "two",
@@ -72,6 +76,7 @@ func TestCreateLedgerAccounts(t *testing.T) {
 					Balance:       decimal.Zero,
 					LastLedgerID:  "",
 					CreatedAt:     now.Add(time.Second * 2),
+					AccountStatus: ledger.AccountStatusActive,
 				},
 			},
 			err: nil,
@@ -88,9 +93,10 @@ func TestCreateLedgerAccounts(t *testing.T) {
 			},
 			expectAccounts: []Account{
 				{
-					AccountID:  "one_one",
-					CurrencyID: 1,
-					CreatedAt:  now.Add(time.Second * 3),
+					AccountID:     "one_one",
+					CurrencyID:    1,
+					CreatedAt:     now.Add(time.Second * 3),
+					AccountStatus: ledger.AccountStatusActive,
 				},
 			},
 			expectAccountsBalance: []GetAccountsBalanceRow{
@@ -101,6 +107,7 @@ func TestCreateLedgerAccounts(t *testing.T) {
 					Balance:       decimal.Zero,
 					LastLedgerID:  "",
 					CreatedAt:     now.Add(time.Second * 3),
+					AccountStatus: ledger.AccountStatusActive,
 				},
 			},
 			err: nil,
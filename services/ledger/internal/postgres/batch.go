@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/studio-asd/go-example/services/ledger"
+	internal "github.com/studio-asd/go-example/services/ledger/internal"
+)
+
+// MoveBatch moves the balances for many independent movements inside a single database transaction.
+//
+// All accounts affected by the movements are locked once with SELECT FOR UPDATE in the order of the account_id before any
+// movement is recorded, so the movements inside the batch don't need to wait for the lock of each account again. Each movement
+// is recorded inside its own savepoint, a failed movement is rolled back to the savepoint and reported in the errors without
+// failing the rest of the movements. The fn is invoked inside the savepoint of each recorded movement when it is not nil, the
+// movement is rolled back to the savepoint if the fn returns an error.
+//
+// The results and the errors are returned in the same order of the movements, only one of them is set for each movement. The
+// returned error is not nil when the batch itself cannot be recorded, and none of the movements are recorded in this case.
+func (q *Queries) MoveBatch(ctx context.Context, les []ledger.MovementLedgerEntries, fn func(context.Context, *Queries, internal.MovementResult) error) ([]internal.MovementResult, []error, error) {
+	results := make([]internal.MovementResult, len(les))
+	errs := make([]error, len(les))
+
+	var accounts []string
+	for _, le := range les {
+		accounts = append(accounts, le.Accounts...)
+	}
+	// Sort the accounts so the lock is always acquired in the same order.
+	slices.Sort(accounts)
+	accounts = slices.Compact(accounts)
+
+	moveBatch := func(ctx context.Context, q *Queries) error {
+		if _, err := q.LockAccountsBalance(ctx, accounts); err != nil {
+			return fmt.Errorf("failed to lock accounts balance: %w", err)
+		}
+		for idx, le := range les {
+			if _, err := q.db.Exec(ctx, "SAVEPOINT batch_movement"); err != nil {
+				return err
+			}
+			result, err := q.Move(ctx, le)
+			if err == nil && fn != nil {
+				err = fn(ctx, q, result)
+			}
+			if err != nil {
+				errs[idx] = err
+				if _, err := q.db.Exec(ctx, "ROLLBACK TO SAVEPOINT batch_movement"); err != nil {
+					return err
+				}
+				continue
+			}
+			if _, err := q.db.Exec(ctx, "RELEASE SAVEPOINT batch_movement"); err != nil {
+				return err
+			}
+			results[idx] = result
+		}
+		return nil
+	}
+	err := q.WithMetrics(ctx, "ledgerMovementBatch", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelReadCommitted, moveBatch)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return results, errs, nil
+}
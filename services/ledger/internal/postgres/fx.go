@@ -0,0 +1,75 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/studio-asd/pkg/postgres"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	"github.com/studio-asd/go-example/services/ledger"
+	internal "github.com/studio-asd/go-example/services/ledger/internal"
+)
+
+// MovementFX is the conversion information of a cross-currency movement.
+type MovementFX struct {
+	FromAccountID string
+	ToAccountID   string
+	FromCurrency  *currency.Currency
+	ToCurrency    *currency.Currency
+	FromAmount    decimal.Decimal
+	ToAmount      decimal.Decimal
+	Rate          decimal.Decimal
+}
+
+// MoveFX records the cross-currency movement and its conversion information in a single transaction. The ledger entries must
+// already be routed through the FX clearing accounts, so the entries of each currency are balanced.
+func (q *Queries) MoveFX(ctx context.Context, le ledger.MovementLedgerEntries, fx MovementFX) (internal.MovementResult, error) {
+	var result internal.MovementResult
+	fn := func(ctx context.Context, q *Queries) error {
+		var err error
+		result, err = q.Move(ctx, le)
+		if err != nil {
+			return err
+		}
+		return q.CreateMovementFX(ctx, CreateMovementFXParams{
+			MovementID:     le.MovementID,
+			FromAccountID:  fx.FromAccountID,
+			ToAccountID:    fx.ToAccountID,
+			FromCurrencyID: fx.FromCurrency.ID,
+			ToCurrencyID:   fx.ToCurrency.ID,
+			FromAmount:     fx.FromAmount,
+			ToAmount:       fx.ToAmount,
+			Rate:           fx.Rate,
+			CreatedAt:      le.CreatedAt,
+		})
+	}
+	err := q.WithMetrics(ctx, "moveFX", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelReadCommitted, fn)
+	})
+	return result, err
+}
+
+// EnsureFXClearingAccounts creates the FX clearing accounts of the currencies if the accounts are not yet exist. The clearing
+// accounts are allowed to have negative balance as the accounts hold the conversion position of the currency.
+func (q *Queries) EnsureFXClearingAccounts(ctx context.Context, currencies ...*currency.Currency) error {
+	for _, curr := range currencies {
+		err := q.CreateLedgerAccount(ctx, CreateLedgerAccount{
+			AccountID:     ledger.FXClearingAccountID(curr.ID),
+			Name:          "FX Clearing " + curr.Name,
+			Description:   fmt.Sprintf("FX clearing account for %s", curr.Name),
+			AllowNegative: true,
+			Currency:      curr,
+			CreatedAt:     time.Now(),
+		})
+		// The clearing account might be created concurrently by another movement.
+		if err != nil && !errors.Is(err, postgres.ErrUniqueViolation) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/studio-asd/pkg/postgres"
+
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+type PlaceHoldParams struct {
+	HoldID          string
+	AccountID       string
+	ClientReference string
+	Amount          decimal.Decimal
+	Reason          string
+	CreatedAt       time.Time
+}
+
+// PlaceHold holds the amount from the available balance of the account and returns the available balance after the hold is
+// placed. The account balance is locked with SELECT FOR UPDATE, so the available balance cannot be changed by the ongoing
+// movements while the hold is placed. Placing a hold with the same client reference and amount returns the existing hold.
+func (q *Queries) PlaceHold(ctx context.Context, params PlaceHoldParams) (AccountHold, decimal.Decimal, error) {
+	var (
+		hold      AccountHold
+		available decimal.Decimal
+	)
+	fn := func(ctx context.Context, q *Queries) error {
+		balance, err := q.GetAccountBalanceForHold(ctx, GetAccountBalanceForHoldParams{
+			At:        params.CreatedAt,
+			AccountID: params.AccountID,
+		})
+		if err != nil {
+			if errors.Is(err, postgres.ErrNoRows) {
+				return fmt.Errorf("%w: account %s does not exist", ledger.ErrAccountNotFound, params.AccountID)
+			}
+			return err
+		}
+		available = balance.Balance.Sub(balance.HeldAmount).Sub(balance.ReservedAmount)
+
+		// The hold with the same client reference can only be placed concurrently by the same account, and the account balance
+		// is already locked. So we can safely check the existing hold here.
+		hold, err = q.GetAccountHoldByClientReference(ctx, GetAccountHoldByClientReferenceParams{
+			AccountID:       params.AccountID,
+			ClientReference: params.ClientReference,
+		})
+		if err == nil {
+			if !hold.Amount.Equal(params.Amount) {
+				return fmt.Errorf("%w: client reference %s", ledger.ErrHoldReferenceConflict, params.ClientReference)
+			}
+			return nil
+		}
+		if !errors.Is(err, postgres.ErrNoRows) {
+			return err
+		}
+
+		if err := ledger.CheckAccountStatusForMovement(balance.AccountStatus, true); err != nil {
+			return fmt.Errorf("%w: account %s", err, params.AccountID)
+		}
+		available = available.Sub(params.Amount)
+		if available.IsNegative() && !balance.AllowNegative {
+			return fmt.Errorf("%w: account %s", ledger.ErrInsufficientBalance, params.AccountID)
+		}
+
+		hold = AccountHold{
+			HoldID:          params.HoldID,
+			AccountID:       params.AccountID,
+			ClientReference: params.ClientReference,
+			Amount:          params.Amount,
+			Reason:          params.Reason,
+			HoldStatus:      ledger.HoldStatusActive,
+			CreatedAt:       params.CreatedAt,
+		}
+		if err := q.CreateAccountHold(ctx, CreateAccountHoldParams{
+			HoldID:          hold.HoldID,
+			AccountID:       hold.AccountID,
+			ClientReference: hold.ClientReference,
+			Amount:          hold.Amount,
+			Reason:          hold.Reason,
+			HoldStatus:      hold.HoldStatus,
+			CreatedAt:       hold.CreatedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to create hold: %w", err)
+		}
+		return q.UpdateAccountHeldAmount(ctx, UpdateAccountHeldAmountParams{
+			Amount:    params.Amount,
+			AccountID: params.AccountID,
+		})
+	}
+	err := q.WithMetrics(ctx, "placeHold", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelReadCommitted, fn)
+	})
+	return hold, available, err
+}
+
+type ReleaseHoldParams struct {
+	AccountID       string
+	ClientReference string
+	ReleasedAt      time.Time
+}
+
+// ReleaseHold releases the amount of the hold back to the available balance of the account. The hold is locked with SELECT
+// FOR UPDATE so the same hold cannot be released twice.
+func (q *Queries) ReleaseHold(ctx context.Context, params ReleaseHoldParams) (AccountHold, error) {
+	var hold AccountHold
+	fn := func(ctx context.Context, q *Queries) error {
+		var err error
+		hold, err = q.GetAccountHoldByClientReferenceForUpdate(ctx, GetAccountHoldByClientReferenceForUpdateParams{
+			AccountID:       params.AccountID,
+			ClientReference: params.ClientReference,
+		})
+		if err != nil {
+			if errors.Is(err, postgres.ErrNoRows) {
+				return ledger.ErrHoldNotFound
+			}
+			return err
+		}
+		if hold.HoldStatus != ledger.HoldStatusActive {
+			return ledger.ErrHoldAlreadyReleased
+		}
+
+		hold.HoldStatus = ledger.HoldStatusReleased
+		hold.ReleasedAt = sql.NullTime{Time: params.ReleasedAt, Valid: true}
+		hold.UpdatedAt = hold.ReleasedAt
+		if err := q.ReleaseAccountHold(ctx, ReleaseAccountHoldParams{
+			ReleasedAt: hold.ReleasedAt,
+			HoldID:     hold.HoldID,
+		}); err != nil {
+			return fmt.Errorf("failed to release hold: %w", err)
+		}
+		return q.UpdateAccountHeldAmount(ctx, UpdateAccountHeldAmountParams{
+			Amount:    hold.Amount.Neg(),
+			AccountID: params.AccountID,
+		})
+	}
+	err := q.WithMetrics(ctx, "releaseHold", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelReadCommitted, fn)
+	})
+	return hold, err
+}
@@ -43,6 +43,7 @@ func (q *Queries) Move(ctx context.Context, le ledger.MovementLedgerEntries) (in
 		"previous_ledger_id",
 		"client_id",
 		"created_at",
+		"reversal_of",
 	}
 	accountsBalanceHistoryColumns := []string{
 		"movement_id",
@@ -60,10 +61,16 @@ func (q *Queries) Move(ctx context.Context, le ledger.MovementLedgerEntries) (in
 	// be fast. As we are locking the users balances here, the user won't be able to create new movement if the account is still being locked in the
 	// database transaction.
 	fn := func(ctx context.Context, q *Queries) error {
-		bulkUpdateParams, endingBalances, err := selectAccountsBalanceForMovement(ctx, q, le.AccountsSummary, le.CreatedAt, le.Accounts)
+		bulkUpdateParams, endingBalances, err := selectAccountsBalanceForMovement(ctx, q, le.MovementID, le.AccountsSummary, le.CreatedAt, le.Accounts)
 		if err != nil {
 			return err
 		}
+		// Check the accounts status after the balances are locked. The status changes need to lock the balance as well, so the status
+		// cannot be changed by the time we check the status until the movement is committed. The balance shards are never locked by the
+		// status changes of their parent account, so the parent account is locked while checking the status instead.
+		if err := checkAccountsStatusForMovement(ctx, q, le); err != nil {
+			return err
+		}
 		// Create the bulk insert parameters for accounts_balance_history. This is imporatnt as we want to record the histories
 		// of the balance based on the movement and not per ledger-record basis.
 		bulkInsertBalanceHistoryParams := make([]any, len(accountsBalanceHistoryColumns)*len(endingBalances))
@@ -84,19 +91,34 @@ func (q *Queries) Move(ctx context.Context, le ledger.MovementLedgerEntries) (in
 		// Build the bulk insert parameters for ledger. We are doing the bulk insert as we need to insert the ledger for both DEBIT and CREDIT for each
 		// movement. The length of the params is (columns * entries) as the parameters are concattenated in a single array.
 		bulkInsertLedgerParams := make([]any, len(accountsLedgerColumns)*len(le.LedgerEntries))
+		// lastLedgerIDs tracks the latest ledger_id of each account inside the movement, so every entry of the same account
+		// is chained to the previous entry of the account.
+		lastLedgerIDs := make(map[string]string, len(endingBalances))
+		for accID, info := range endingBalances {
+			lastLedgerIDs[accID] = info.PreviousLedgerID
+		}
+		previousLedgerIDs := make([]string, len(le.LedgerEntries))
 		for idx, entry := range le.LedgerEntries {
 			// There will be a condition of when the previous ledger_id is empty, because we need to lock the balance row first to get the
 			// exact previous identifier. This will always be the case for the first entry of an account movement. When this happen, then we will fill the entry
 			// data with the information of when the lock/SELECT FOR UPDATE happened.
 			if entry.PreviousLedgerID == "" {
-				entry.PreviousLedgerID = endingBalances[entry.AccountID].NextLedgerID
+				entry.PreviousLedgerID = lastLedgerIDs[entry.AccountID]
 			}
+			lastLedgerIDs[entry.AccountID] = entry.LedgerID
+			previousLedgerIDs[idx] = entry.PreviousLedgerID
 			// Set the client id if the client_id is not null.
 			clientID := sql.Null[string]{}
 			if entry.ClientID != "" {
 				clientID.V = entry.ClientID
 				clientID.Valid = true
 			}
+			// Set the reversal_of if the entry is reversing another ledger entry.
+			reversalOf := sql.Null[string]{}
+			if entry.ReversalOf != "" {
+				reversalOf.V = entry.ReversalOf
+				reversalOf.Valid = true
+			}
 			// The beginning of the offset is always (idx * len(accountsLedgerColumns)) because the parameters are concattenated based on the columns length.
 			// If the number of columns are increased/decreased, the (offset + x) need to be modified based on the number of the columns/fields.
 			offset := idx * len(accountsLedgerColumns)
@@ -104,11 +126,12 @@ func (q *Queries) Move(ctx context.Context, le ledger.MovementLedgerEntries) (in
 			bulkInsertLedgerParams[offset+1] = le.MovementID
 			bulkInsertLedgerParams[offset+2] = entry.AccountID
 			bulkInsertLedgerParams[offset+3] = entry.MovementSequence
-			bulkInsertLedgerParams[offset+4] = entry.CurrencyID
-			bulkInsertLedgerParams[offset+5] = entry.Amount.String()
+			bulkInsertLedgerParams[offset+4] = entry.Amount.CurrencyID()
+			bulkInsertLedgerParams[offset+5] = entry.Amount.Amount().String()
 			bulkInsertLedgerParams[offset+6] = entry.PreviousLedgerID
 			bulkInsertLedgerParams[offset+7] = clientID
 			bulkInsertLedgerParams[offset+8] = entry.CreatedAt
+			bulkInsertLedgerParams[offset+9] = reversalOf
 		}
 
 		// Update the affected users balance. We updated the balance first because it will affects less row than inserting the records to ledger.
@@ -138,7 +161,6 @@ func (q *Queries) Move(ctx context.Context, le ledger.MovementLedgerEntries) (in
 			IdempotencyKey: le.IdempotencyKey,
 			CreatedAt:      le.CreatedAt,
 		}); err != nil {
-			fmt.Println(le.MovementID)
 			return fmt.Errorf("failed to create movement: %w", err)
 		}
 		// Insert to the accounts ledger.
@@ -151,6 +173,10 @@ func (q *Queries) Move(ctx context.Context, le ledger.MovementLedgerEntries) (in
 		); err != nil {
 			return fmt.Errorf("failed to insert accounts ledger: %w", err)
 		}
+		// Write the event of the movement to the outbox inside the same transaction.
+		if err := createMovementCreatedEvent(ctx, q, le, previousLedgerIDs, endingBalances); err != nil {
+			return err
+		}
 		// Provide the result information.
 		result = internal.MovementResult{
 			MovementID: le.MovementID,
@@ -165,9 +191,83 @@ func (q *Queries) Move(ctx context.Context, le ledger.MovementLedgerEntries) (in
 	return result, err
 }
 
+// ReverseMovementParams is the parameters to reverse a movement. The ledger entries of the reversal movement is passed
+// separately as the entries are constructed in the api layer.
+type ReverseMovementParams struct {
+	MovementID     string
+	ReversalReason string
+}
+
+// ReverseMovement reverses a movement by recording the reversal ledger entries and marking the original movement as reversed.
+// The original movement is locked with SELECT FOR UPDATE so the same movement cannot be reversed twice concurrently.
+func (q *Queries) ReverseMovement(ctx context.Context, le ledger.MovementLedgerEntries, params ReverseMovementParams) (internal.MovementResult, error) {
+	var result internal.MovementResult
+	fn := func(ctx context.Context, q *Queries) error {
+		movement, err := q.GetMovementForUpdate(ctx, params.MovementID)
+		if err != nil {
+			if errors.Is(err, postgres.ErrNoRows) {
+				return ledger.ErrMovementNotFound
+			}
+			return err
+		}
+		if movement.ReversedAt.Valid {
+			return ledger.ErrMovementAlreadyReversed
+		}
+
+		result, err = q.Move(ctx, le)
+		if err != nil {
+			return err
+		}
+		if err := q.SetMovementReversed(ctx, SetMovementReversedParams{
+			MovementID:         params.MovementID,
+			ReversedAt:         sql.NullTime{Time: le.CreatedAt, Valid: true},
+			ReversalMovementID: sql.NullString{String: le.MovementID, Valid: true},
+			UpdatedAt:          sql.NullTime{Time: le.CreatedAt, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("failed to set movement reversed: %w", err)
+		}
+		if err := q.CreateReversedMovement(ctx, CreateReversedMovementParams{
+			MovementID:         params.MovementID,
+			ReversalMovementID: le.MovementID,
+			ReversalReason:     params.ReversalReason,
+			CreatedAt:          le.CreatedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to create reversed movement: %w", err)
+		}
+		return nil
+	}
+	err := q.WithMetrics(ctx, "reverseMovement", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelReadCommitted, fn)
+	})
+	return result, err
+}
+
+// reservedAmountColumn is the select column to calculate the amount reserved by the active pending movements of the account.
+const reservedAmountColumn = `COALESCE((
+	SELECT SUM(pme.amount)
+	FROM pending_movement_entries pme,
+		pending_movements pm
+	WHERE pme.from_account_id = accounts_balance.account_id
+		AND pm.pending_movement_id = pme.pending_movement_id
+		AND pm.pending_status = 1
+		AND pm.expires_at > ?
+), 0)::numeric AS reserved_amount`
+
+// shardsBalanceColumn is the select column to calculate the total balance of the credit-only balance shards of the account. The shards
+// are not locked, as the balance of the credit-only shards never goes down so the committed balance is always available.
+const shardsBalanceColumn = `COALESCE((
+	SELECT SUM(sab.balance)
+	FROM accounts_balance sab,
+		accounts sac
+	WHERE sab.parent_account_id = accounts_balance.account_id
+		AND NOT sab.allow_negative
+		AND sac.account_id = sab.account_id
+		AND sac.is_balance_shard
+), 0)::numeric AS shards_balance`
+
 // selectAccountsBalanceForMovement do SELECT FOR UPDATE to the account_balances and lock specific account_id balance. The function also returns the update statements
 // for all accounts so we can also tests whether the update statement is contstructed as we expected or not.
-func selectAccountsBalanceForMovement(ctx context.Context, q *Queries, changes map[string]ledger.AccountMovementSummary, createdAt time.Time, accounts []string) (bulkUpdate, map[string]internal.MovementEndingBalance, error) {
+func selectAccountsBalanceForMovement(ctx context.Context, q *Queries, movementID string, changes map[string]ledger.AccountMovementSummary, createdAt time.Time, accounts []string) (bulkUpdate, map[string]internal.MovementEndingBalance, error) {
 	if len(accounts) == 0 {
 		return bulkUpdate{}, nil, errors.New("account_id is required to select accounts balance for movement")
 	}
@@ -178,7 +278,12 @@ func selectAccountsBalanceForMovement(ctx context.Context, q *Queries, changes m
 		"balance",
 		"last_ledger_id",
 		"last_movement_id",
+		"held_amount",
 	).
+		// reserved_amount is the amount of funds reserved by the pending movements of the account.
+		Column(squirrel.Expr(reservedAmountColumn, createdAt)).
+		// shards_balance is the balance of the credit-only balance shards which can be used by the account.
+		Column(shardsBalanceColumn).
 		From("ledger.accounts_balance").
 		Where(squirrel.Eq{"account_id": accounts}).
 		Suffix("FOR UPDATE").
@@ -194,12 +299,14 @@ func selectAccountsBalanceForMovement(ctx context.Context, q *Queries, changes m
 			"account_id",
 			"balance",
 			"last_ledger_id",
+			"last_movement_id",
 			"updated_at",
 		},
 		Types: []string{
 			"VARCHAR",
 			"NUMERIC",
 			"VARCHAR",
+			"VARCHAR",
 			"TIMESTAMP",
 		},
 	}
@@ -210,13 +317,19 @@ func selectAccountsBalanceForMovement(ctx context.Context, q *Queries, changes m
 	endingBalances := make(map[string]internal.MovementEndingBalance)
 	// accounts is the new information of the accounts which we want to change. We will use this to create UPDATE query.
 	err = q.db.RunQuery(ctx, selectForUpdate, func(rc *postgres.RowsCompat) error {
-		ab := GetAccountsBalanceRow{}
+		var (
+			ab             GetAccountsBalanceRow
+			reservedAmount decimal.Decimal
+		)
 		if err := rc.Scan(
 			&ab.AccountID,
 			&ab.AllowNegative,
 			&ab.Balance,
 			&ab.LastLedgerID,
 			&ab.LastMovementID,
+			&ab.HeldAmount,
+			&reservedAmount,
+			&ab.ShardsBalance,
 		); err != nil {
 			return err
 		}
@@ -225,14 +338,20 @@ func selectAccountsBalanceForMovement(ctx context.Context, q *Queries, changes m
 		// If the last ledger id is still the same under lock, then we should use the ending balance given, as there are no
 		// transactions being recorded concurrently for this account.
 		if changes[ab.AccountID].LastLedgerID == ab.LastLedgerID {
-			newBalance = changes[ab.AccountID].EndingBalance
+			newBalance = changes[ab.AccountID].EndingBalance.Amount()
 		} else {
 			// Check if the account have enough balance to be deducted and whether negative balance is allowed for the account.
-			newBalance = ab.Balance.Add(changes[ab.AccountID].BalanceChanges)
-			if newBalance.IsNegative() && !ab.AllowNegative {
+			newBalance = ab.Balance.Add(changes[ab.AccountID].BalanceChanges.Amount())
+			if newBalance.Add(ab.ShardsBalance).IsNegative() && !ab.AllowNegative {
 				return ledger.ErrInsufficientBalance
 			}
 		}
+		// The funds held and reserved by the pending movements cannot be used by the movement, so the balance after the movement
+		// must still be able to cover the held and reserved amount. The balance of the account itself can go below zero when the
+		// balance of its credit-only shards covers the debit.
+		if changes[ab.AccountID].BalanceChanges.IsNegative() && !ab.AllowNegative && newBalance.Add(ab.ShardsBalance).Sub(ab.HeldAmount).Sub(reservedAmount).IsNegative() {
+			return ledger.ErrInsufficientBalance
+		}
 		// Store the accounts information before we change it.
 		endingBalances[ab.AccountID] = internal.MovementEndingBalance{
 			AccountID:          ab.AccountID,
@@ -246,7 +365,8 @@ func selectAccountsBalanceForMovement(ctx context.Context, q *Queries, changes m
 		bulkUpdate.Values[0] = append(bulkUpdate.Values[0], ab.AccountID)
 		bulkUpdate.Values[1] = append(bulkUpdate.Values[1], newBalance.String())
 		bulkUpdate.Values[2] = append(bulkUpdate.Values[2], changes[ab.AccountID].NextLedgerID)
-		bulkUpdate.Values[3] = append(bulkUpdate.Values[3], createdAt)
+		bulkUpdate.Values[3] = append(bulkUpdate.Values[3], movementID)
+		bulkUpdate.Values[4] = append(bulkUpdate.Values[4], createdAt)
 		return nil
 	}, selectForUpdateArgs...)
 	if err != nil {
@@ -254,3 +374,30 @@ func selectAccountsBalanceForMovement(ctx context.Context, q *Queries, changes m
 	}
 	return bulkUpdate, endingBalances, nil
 }
+
+// checkAccountsStatusForMovement checks whether the accounts inside the movement is allowed to be debited or credited based
+// on the account status. The balance shards are checked against the status of their parent account, and the parent account is
+// locked so its status cannot be changed until the movement is committed.
+func checkAccountsStatusForMovement(ctx context.Context, q *Queries, le ledger.MovementLedgerEntries) error {
+	statuses, err := q.GetAccountsStatus(ctx, le.Accounts)
+	if err != nil {
+		return err
+	}
+	accountsStatus := make(map[string]int32, len(statuses))
+	for _, status := range statuses {
+		accountsStatus[status.AccountID] = status.AccountStatus
+	}
+	parentStatuses, err := q.GetBalanceShardsParentStatusForShare(ctx, le.Accounts)
+	if err != nil {
+		return err
+	}
+	for _, status := range parentStatuses {
+		accountsStatus[status.AccountID] = status.AccountStatus
+	}
+	for _, entry := range le.LedgerEntries {
+		if err := ledger.CheckAccountStatusForMovement(accountsStatus[entry.AccountID], entry.Amount.IsNegative()); err != nil {
+			return fmt.Errorf("%w: account %s", err, entry.AccountID)
+		}
+	}
+	return nil
+}
@@ -47,7 +47,7 @@ func TestMove(t *testing.T) {
 					{
 						AccountID:        "1",
 						MovementSequence: 1,
-						Amount:           decimal.NewFromInt(-100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(-100), currency.IDR),
 						LedgerID:         "one",
 						CreatedAt:        createdAt,
 						Timestamp:        timestamp + 1,
@@ -55,7 +55,7 @@ func TestMove(t *testing.T) {
 					{
 						AccountID:        "2",
 						MovementSequence: 1,
-						Amount:           decimal.NewFromInt(100),
+						Amount:           currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 						LedgerID:         "two",
 						CreatedAt:        createdAt,
 						Timestamp:        timestamp + 2,
@@ -63,14 +63,14 @@ func TestMove(t *testing.T) {
 				},
 				AccountsSummary: map[string]ledger.AccountMovementSummary{
 					"1": {
-						BalanceChanges: decimal.NewFromInt(-100),
+						BalanceChanges: currency.NewMoney(decimal.NewFromInt(-100), currency.IDR),
 						NextLedgerID:   "one",
-						EndingBalance:  decimal.Zero,
+						EndingBalance:  currency.NewMoney(decimal.Zero, currency.IDR),
 					},
 					"2": {
-						BalanceChanges: decimal.NewFromInt(100),
+						BalanceChanges: currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 						NextLedgerID:   "two",
-						EndingBalance:  decimal.NewFromInt(200),
+						EndingBalance:  currency.NewMoney(decimal.NewFromInt(200), currency.IDR),
 					},
 				},
 				Accounts:  []string{"1", "2"},
@@ -106,22 +106,26 @@ func TestMove(t *testing.T) {
 			},
 			expectAccountsBalance: map[string]GetAccountsBalanceRow{
 				"1": {
-					AccountID:     "1",
-					AllowNegative: false,
-					Balance:       decimal.NewFromInt(0),
-					CurrencyID:    1,
-					LastLedgerID:  "one",
-					CreatedAt:     createdAt,
-					UpdatedAt:     sql.NullTime{Time: createdAt, Valid: true},
+					AccountID:      "1",
+					AllowNegative:  false,
+					Balance:        decimal.NewFromInt(0),
+					CurrencyID:     1,
+					LastLedgerID:   "one",
+					LastMovementID: "one",
+					CreatedAt:      createdAt,
+					UpdatedAt:      sql.NullTime{Time: createdAt, Valid: true},
+					AccountStatus:  ledger.AccountStatusActive,
 				},
 				"2": {
-					AccountID:     "2",
-					AllowNegative: false,
-					Balance:       decimal.NewFromInt(200),
-					CurrencyID:    1,
-					LastLedgerID:  "two",
-					CreatedAt:     createdAt,
-					UpdatedAt:     sql.NullTime{Time: createdAt, Valid: true},
+					AccountID:      "2",
+					AllowNegative:  false,
+					Balance:        decimal.NewFromInt(200),
+					CurrencyID:     1,
+					LastLedgerID:   "two",
+					LastMovementID: "one",
+					CreatedAt:      createdAt,
+					UpdatedAt:      sql.NullTime{Time: createdAt, Valid: true},
+					AccountStatus:  ledger.AccountStatusActive,
 				},
 			},
 			expectAccountsLedger: []GetAccountsLedgerByMovementIDRow{
@@ -131,7 +135,7 @@ func TestMove(t *testing.T) {
 					AccountID:        "1",
 					MovementSequence: 1,
 					Amount:           decimal.NewFromInt(-100),
-					PreviousLedgerID: "one",
+					PreviousLedgerID: "",
 					CreatedAt:        createdAt,
 					ClientID:         sql.NullString{},
 				},
@@ -141,7 +145,7 @@ func TestMove(t *testing.T) {
 					AccountID:        "2",
 					MovementSequence: 1,
 					Amount:           decimal.NewFromInt(100),
-					PreviousLedgerID: "two",
+					PreviousLedgerID: "",
 					CreatedAt:        createdAt,
 					ClientID:         sql.NullString{},
 				},
@@ -261,22 +265,22 @@ func TestSelectAccountsBalanceForMovement(t *testing.T) {
 			},
 			accountChanges: map[string]ledger.AccountMovementSummary{
 				"one": {
-					BalanceChanges: decimal.NewFromInt(100),
+					BalanceChanges: currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					NextLedgerID:   "one",
 					LastLedgerID:   "last",
 				},
 				"two": {
-					BalanceChanges: decimal.NewFromInt(100),
+					BalanceChanges: currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					NextLedgerID:   "two",
 					LastLedgerID:   "last",
 				},
 				"three": {
-					BalanceChanges: decimal.NewFromInt(100),
+					BalanceChanges: currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					NextLedgerID:   "three",
 					LastLedgerID:   "last",
 				},
 				"four": {
-					BalanceChanges: decimal.NewFromInt(-100),
+					BalanceChanges: currency.NewMoney(decimal.NewFromInt(-100), currency.IDR),
 					NextLedgerID:   "four",
 					LastLedgerID:   "last",
 				},
@@ -313,12 +317,14 @@ func TestSelectAccountsBalanceForMovement(t *testing.T) {
 					"account_id",
 					"balance",
 					"last_ledger_id",
+					"last_movement_id",
 					"updated_at",
 				},
 				Types: []string{
 					"VARCHAR",
 					"NUMERIC",
 					"VARCHAR",
+					"VARCHAR",
 					"TIMESTAMP",
 				},
 				Values: [][]any{
@@ -340,6 +346,12 @@ func TestSelectAccountsBalanceForMovement(t *testing.T) {
 						"three",
 						"four",
 					},
+					{
+						"movement_id",
+						"movement_id",
+						"movement_id",
+						"movement_id",
+					},
 					{
 						createdAt,
 						createdAt,
@@ -382,22 +394,22 @@ func TestSelectAccountsBalanceForMovement(t *testing.T) {
 			},
 			accountChanges: map[string]ledger.AccountMovementSummary{
 				"one": {
-					BalanceChanges: decimal.NewFromInt(-300),
+					BalanceChanges: currency.NewMoney(decimal.NewFromInt(-300), currency.IDR),
 					NextLedgerID:   "one",
 					LastLedgerID:   "last",
 				},
 				"two": {
-					BalanceChanges: decimal.NewFromInt(100),
+					BalanceChanges: currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					NextLedgerID:   "two",
 					LastLedgerID:   "last",
 				},
 				"three": {
-					BalanceChanges: decimal.NewFromInt(100),
+					BalanceChanges: currency.NewMoney(decimal.NewFromInt(100), currency.IDR),
 					NextLedgerID:   "three",
 					LastLedgerID:   "last",
 				},
 				"four": {
-					BalanceChanges: decimal.NewFromInt(-100),
+					BalanceChanges: currency.NewMoney(decimal.NewFromInt(-100), currency.IDR),
 					NextLedgerID:   "four",
 					LastLedgerID:   "last",
 				},
@@ -434,7 +446,7 @@ func TestSelectAccountsBalanceForMovement(t *testing.T) {
 			)
 			// Test inside the transaction as we are doing SELECT FOR UPDATE.
 			gotErr := tq.WithTransact(context.Background(), sql.LevelReadUncommitted, func(ctx context.Context, q *Queries) error {
-				bulkUpdateParams, gotInfo, err = selectAccountsBalanceForMovement(ctx, q, test.accountChanges, test.createdAt, accounts)
+				bulkUpdateParams, gotInfo, err = selectAccountsBalanceForMovement(ctx, q, test.movementID, test.accountChanges, test.createdAt, accounts)
 				return err
 			})
 			if !errors.Is(gotErr, test.selectForUpdateErr) {
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	internal "github.com/studio-asd/go-example/services/ledger/internal"
+)
+
+// createMovementCreatedEvent writes the movement_created event to the outbox. The function must be invoked inside the transaction
+// of the movement, so the event is only recorded when the movement is recorded.
+func createMovementCreatedEvent(ctx context.Context, q *Queries, le ledger.MovementLedgerEntries, previousLedgerIDs []string, endingBalances map[string]internal.MovementEndingBalance) error {
+	event := &ledgerv1.MovementCreated{
+		MovementId:     le.MovementID,
+		IdempotencyKey: le.IdempotencyKey,
+		LedgerEntries:  make([]*ledgerv1.MovementCreated_LedgerEntry, len(le.LedgerEntries)),
+		EndingBalances: make([]*ledgerv1.MovementCreated_Balance, 0, len(endingBalances)),
+	}
+	for idx, entry := range le.LedgerEntries {
+		event.LedgerEntries[idx] = &ledgerv1.MovementCreated_LedgerEntry{
+			LedgerId:         entry.LedgerID,
+			AccountId:        entry.AccountID,
+			MovementSequence: int32(entry.MovementSequence),
+			CurrencyId:       entry.Amount.CurrencyID(),
+			Amount:           entry.Amount.Amount().String(),
+			ClientId:         entry.ClientID,
+			PreviousLedgerId: previousLedgerIDs[idx],
+			ReversalOf:       entry.ReversalOf,
+		}
+	}
+	for _, balance := range endingBalances {
+		event.EndingBalances = append(event.EndingBalances, &ledgerv1.MovementCreated_Balance{
+			AccountId:       balance.AccountID,
+			LedgerId:        balance.NextLedgerID,
+			NewBalance:      balance.NewBalance.String(),
+			PreviousBalance: balance.PreviousBalance.String(),
+		})
+	}
+	return writeOutboxEvent(ctx, q, ledger.EventTypeMovementCreated, le.MovementID, &ledgerv1.Event{
+		CreateTime: timestamppb.New(le.CreatedAt),
+		Payload:    &ledgerv1.Event_MovementCreated{MovementCreated: event},
+	})
+}
+
+// createAccountCreatedEvent writes the account_created event to the outbox. The function must be invoked inside the transaction
+// of the account creation.
+func createAccountCreatedEvent(ctx context.Context, q *Queries, c CreateLedgerAccount) error {
+	return writeOutboxEvent(ctx, q, ledger.EventTypeAccountCreated, c.AccountID, &ledgerv1.Event{
+		CreateTime: timestamppb.New(c.CreatedAt),
+		Payload: &ledgerv1.Event_AccountCreated{
+			AccountCreated: &ledgerv1.AccountCreated{
+				AccountId:       c.AccountID,
+				ParentAccountId: c.ParentAccountID,
+				CurrencyId:      c.Currency.ID,
+				AllowNegative:   c.AllowNegative,
+				Name:            c.Name,
+			},
+		},
+	})
+}
+
+// writeOutboxEvent encodes the event and writes the event to the outbox.
+func writeOutboxEvent(ctx context.Context, q *Queries, eventType, aggregateID string, event *ledgerv1.Event) error {
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err := q.CreateOutboxEvent(ctx, CreateOutboxEventParams{
+		EventType:   eventType,
+		AggregateID: aggregateID,
+		Payload:     payload,
+		CreatedAt:   event.GetCreateTime().AsTime(),
+	}); err != nil {
+		return fmt.Errorf("failed to create outbox event: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/studio-asd/pkg/postgres"
+
+	"github.com/studio-asd/go-example/services/ledger"
+	internal "github.com/studio-asd/go-example/services/ledger/internal"
+)
+
+// AuthorizeMovementParams is the parameters to create a pending movement. The entries are normalized in the api layer, so
+// the amount of each entry is already checked against the currency of the accounts.
+type AuthorizeMovementParams struct {
+	PendingMovementID string
+	IdempotencyKey    string
+	ExpiresAt         time.Time
+	CreatedAt         time.Time
+	Entries           []PendingMovementEntry
+}
+
+// AuthorizeMovement creates a pending movement and reserves the funds of the source accounts. The source accounts balance are
+// locked with SELECT FOR UPDATE, so the available balance(balance - held amount - reserved amount) cannot be changed by the ongoing movements
+// while the funds are being reserved.
+func (q *Queries) AuthorizeMovement(ctx context.Context, params AuthorizeMovementParams) error {
+	pendingMovementEntriesColumns := []string{
+		"pending_movement_id",
+		"movement_sequence",
+		"from_account_id",
+		"to_account_id",
+		"currency_id",
+		"amount",
+		"client_id",
+		"created_at",
+	}
+
+	// Summarize the amount to be reserved for each source account.
+	reserves := make(map[string]decimal.Decimal)
+	for _, entry := range params.Entries {
+		reserves[entry.FromAccountID] = reserves[entry.FromAccountID].Add(entry.Amount)
+	}
+	accounts := make([]string, 0, len(reserves))
+	for accountID := range reserves {
+		accounts = append(accounts, accountID)
+	}
+	// Sort the accounts so the lock is always acquired in the same order.
+	slices.Sort(accounts)
+
+	fn := func(ctx context.Context, q *Queries) error {
+		balances, err := q.GetAccountsBalanceForPendingMovement(ctx, GetAccountsBalanceForPendingMovementParams{
+			At:         params.CreatedAt,
+			AccountIds: accounts,
+		})
+		if err != nil {
+			return err
+		}
+		if len(balances) != len(accounts) {
+			return fmt.Errorf("%w: source account of the pending movement does not exist", ledger.ErrAccountNotFound)
+		}
+		for _, balance := range balances {
+			if err := ledger.CheckAccountStatusForMovement(balance.AccountStatus, true); err != nil {
+				return fmt.Errorf("%w: account %s", err, balance.AccountID)
+			}
+			available := balance.Balance.Sub(balance.HeldAmount).Sub(balance.ReservedAmount).Sub(reserves[balance.AccountID])
+			if available.IsNegative() && !balance.AllowNegative {
+				return fmt.Errorf("%w: account %s", ledger.ErrInsufficientBalance, balance.AccountID)
+			}
+		}
+
+		if err := q.CreatePendingMovement(ctx, CreatePendingMovementParams{
+			PendingMovementID: params.PendingMovementID,
+			IdempotencyKey:    params.IdempotencyKey,
+			PendingStatus:     ledger.PendingMovementStatusPending,
+			ExpiresAt:         params.ExpiresAt,
+			CreatedAt:         params.CreatedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to create pending movement: %w", err)
+		}
+		bulkInsertEntriesParams := make([]any, len(pendingMovementEntriesColumns)*len(params.Entries))
+		for idx, entry := range params.Entries {
+			offset := idx * len(pendingMovementEntriesColumns)
+			bulkInsertEntriesParams[offset] = params.PendingMovementID
+			bulkInsertEntriesParams[offset+1] = entry.MovementSequence
+			bulkInsertEntriesParams[offset+2] = entry.FromAccountID
+			bulkInsertEntriesParams[offset+3] = entry.ToAccountID
+			bulkInsertEntriesParams[offset+4] = entry.CurrencyID
+			bulkInsertEntriesParams[offset+5] = entry.Amount.String()
+			bulkInsertEntriesParams[offset+6] = entry.ClientID
+			bulkInsertEntriesParams[offset+7] = params.CreatedAt
+		}
+		if err := q.db.BulkInsert(
+			ctx,
+			"pending_movement_entries",
+			pendingMovementEntriesColumns,
+			bulkInsertEntriesParams,
+			"",
+		); err != nil {
+			return fmt.Errorf("failed to insert pending movement entries: %w", err)
+		}
+		return nil
+	}
+	return q.WithMetrics(ctx, "authorizeMovement", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelReadCommitted, fn)
+	})
+}
+
+// CapturePendingMovement posts the pending movement as a movement. The pending movement is locked with SELECT FOR UPDATE so
+// the same pending movement cannot be captured twice or voided while it is being captured. The status of the pending movement
+// is changed before the movement is posted, so the reserved funds are released and can be used by the movement itself.
+func (q *Queries) CapturePendingMovement(ctx context.Context, pendingMovementID string, le ledger.MovementLedgerEntries) (internal.MovementResult, error) {
+	var result internal.MovementResult
+	fn := func(ctx context.Context, q *Queries) error {
+		if err := lockPendingMovement(ctx, q, pendingMovementID, le.CreatedAt); err != nil {
+			return err
+		}
+		if err := q.UpdatePendingMovementStatus(ctx, UpdatePendingMovementStatusParams{
+			PendingStatus:     ledger.PendingMovementStatusCaptured,
+			MovementID:        sql.NullString{String: le.MovementID, Valid: true},
+			UpdatedAt:         sql.NullTime{Time: le.CreatedAt, Valid: true},
+			PendingMovementID: pendingMovementID,
+		}); err != nil {
+			return fmt.Errorf("failed to capture pending movement: %w", err)
+		}
+		var err error
+		result, err = q.Move(ctx, le)
+		return err
+	}
+	err := q.WithMetrics(ctx, "capturePendingMovement", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelReadCommitted, fn)
+	})
+	return result, err
+}
+
+// VoidPendingMovement releases the reserved funds of the pending movement without posting the movement.
+func (q *Queries) VoidPendingMovement(ctx context.Context, pendingMovementID string, voidedAt time.Time) error {
+	fn := func(ctx context.Context, q *Queries) error {
+		if err := lockPendingMovement(ctx, q, pendingMovementID, voidedAt); err != nil {
+			return err
+		}
+		return q.UpdatePendingMovementStatus(ctx, UpdatePendingMovementStatusParams{
+			PendingStatus:     ledger.PendingMovementStatusVoided,
+			UpdatedAt:         sql.NullTime{Time: voidedAt, Valid: true},
+			PendingMovementID: pendingMovementID,
+		})
+	}
+	return q.WithMetrics(ctx, "voidPendingMovement", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelReadCommitted, fn)
+	})
+}
+
+// lockPendingMovement locks the pending movement and checks whether the pending movement can still be captured or voided at
+// the given time.
+func lockPendingMovement(ctx context.Context, q *Queries, pendingMovementID string, at time.Time) error {
+	pending, err := q.GetPendingMovementForUpdate(ctx, pendingMovementID)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return ledger.ErrPendingMovementNotFound
+		}
+		return err
+	}
+	if pending.PendingStatus != ledger.PendingMovementStatusPending {
+		return ledger.ErrPendingMovementNotPending
+	}
+	// The pending movement is expired even though the status is not yet changed by the expiry process.
+	if !pending.ExpiresAt.After(at) {
+		return ledger.ErrPendingMovementExpired
+	}
+	return nil
+}
@@ -13,6 +13,64 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+const claimDueScheduledMovements = `-- name: ClaimDueScheduledMovements :many
+UPDATE scheduled_movements
+SET next_run_at = $1::timestamptz
+WHERE schedule_id IN (
+	SELECT sm.schedule_id
+	FROM scheduled_movements sm
+	WHERE sm.schedule_status = 1
+		AND sm.next_run_at <= $2::timestamptz
+	ORDER BY sm.next_run_at
+	LIMIT $3::int
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING schedule_id, idempotency_key, schedule_status, recurrence, occurrence_at, next_run_at, end_at, run_count, attempts, last_error, last_movement_id, created_at, updated_at
+`
+
+type ClaimDueScheduledMovementsParams struct {
+	ClaimedUntil time.Time
+	DueAt        time.Time
+	ClaimLimit   int32
+}
+
+// ClaimDueScheduledMovements claims the active scheduled movements that are due by moving their next_run_at to claimed_until. The
+// claimed scheduled movements are not claimed again by the other schedulers until claimed_until is passed, so the occurrence is
+// retried when the scheduler that claims it stops before the result is recorded.
+func (q *Queries) ClaimDueScheduledMovements(ctx context.Context, arg ClaimDueScheduledMovementsParams) ([]ScheduledMovement, error) {
+	rows, err := q.db.Query(ctx, claimDueScheduledMovements, arg.ClaimedUntil, arg.DueAt, arg.ClaimLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduledMovement
+	for rows.Next() {
+		var i ScheduledMovement
+		if err := rows.Scan(
+			&i.ScheduleID,
+			&i.IdempotencyKey,
+			&i.ScheduleStatus,
+			&i.Recurrence,
+			&i.OccurrenceAt,
+			&i.NextRunAt,
+			&i.EndAt,
+			&i.RunCount,
+			&i.Attempts,
+			&i.LastError,
+			&i.LastMovementID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createAccount = `-- name: CreateAccount :exec
 INSERT INTO accounts(
 	account_id,
@@ -20,8 +78,9 @@ INSERT INTO accounts(
 	description,
 	parent_account_id,
 	currency_id,
+	account_status,
 	created_at
-) VALUES($1,$2,$3,$4,$5,$6)
+) VALUES($1,$2,$3,$4,$5,$6,$7)
 `
 
 type CreateAccountParams struct {
@@ -30,6 +89,7 @@ type CreateAccountParams struct {
 	Description     string
 	ParentAccountID sql.NullString
 	CurrencyID      int32
+	AccountStatus   int32
 	CreatedAt       time.Time
 }
 
@@ -40,6 +100,7 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) er
 		arg.Description,
 		arg.ParentAccountID,
 		arg.CurrencyID,
+		arg.AccountStatus,
 		arg.CreatedAt,
 	)
 	return err
@@ -83,6 +144,73 @@ func (q *Queries) CreateAccountBalance(ctx context.Context, arg CreateAccountBal
 	return err
 }
 
+const createAccountHold = `-- name: CreateAccountHold :exec
+INSERT INTO account_holds(
+	hold_id,
+	account_id,
+	client_reference,
+	amount,
+	reason,
+	hold_status,
+	created_at
+) VALUES($1,$2,$3,$4,$5,$6,$7)
+`
+
+type CreateAccountHoldParams struct {
+	HoldID          string
+	AccountID       string
+	ClientReference string
+	Amount          decimal.Decimal
+	Reason          string
+	HoldStatus      int32
+	CreatedAt       time.Time
+}
+
+func (q *Queries) CreateAccountHold(ctx context.Context, arg CreateAccountHoldParams) error {
+	_, err := q.db.Exec(ctx, createAccountHold,
+		arg.HoldID,
+		arg.AccountID,
+		arg.ClientReference,
+		arg.Amount,
+		arg.Reason,
+		arg.HoldStatus,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createCurrency = `-- name: CreateCurrency :exec
+INSERT INTO currencies(
+	currency_id,
+	currency_code,
+	exponent,
+	rounding_mode,
+	is_active,
+	created_at
+) VALUES($1,$2,$3,$4,$5,$6)
+`
+
+type CreateCurrencyParams struct {
+	CurrencyID   int32
+	CurrencyCode string
+	Exponent     int32
+	RoundingMode int32
+	IsActive     bool
+	CreatedAt    time.Time
+}
+
+func (q *Queries) CreateCurrency(ctx context.Context, arg CreateCurrencyParams) error {
+	_, err := q.db.Exec(ctx, createCurrency,
+		arg.CurrencyID,
+		arg.CurrencyCode,
+		arg.Exponent,
+		arg.RoundingMode,
+		arg.IsActive,
+		arg.CreatedAt,
+	)
+	return err
+}
+
 const createMovement = `-- name: CreateMovement :exec
 INSERT INTO movements(
 	movement_id,
@@ -109,34 +237,206 @@ func (q *Queries) CreateMovement(ctx context.Context, arg CreateMovementParams)
 	return err
 }
 
-const getAccounts = `-- name: GetAccounts :many
-SELECT account_id, name, description, parent_account_id, currency_id, created_at, updated_at
-FROM accounts
-WHERE account_id = ANY($1::varchar[])
-ORDER BY created_at
+const createMovementFX = `-- name: CreateMovementFX :exec
+INSERT INTO movements_fx(
+	movement_id,
+	from_account_id,
+	to_account_id,
+	from_currency_id,
+	to_currency_id,
+	from_amount,
+	to_amount,
+	rate,
+	created_at
+) VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9)
 `
 
-func (q *Queries) GetAccounts(ctx context.Context, dollar_1 []string) ([]Account, error) {
-	rows, err := q.db.Query(ctx, getAccounts, dollar_1)
+type CreateMovementFXParams struct {
+	MovementID     string
+	FromAccountID  string
+	ToAccountID    string
+	FromCurrencyID int32
+	ToCurrencyID   int32
+	FromAmount     decimal.Decimal
+	ToAmount       decimal.Decimal
+	Rate           decimal.Decimal
+	CreatedAt      time.Time
+}
+
+func (q *Queries) CreateMovementFX(ctx context.Context, arg CreateMovementFXParams) error {
+	_, err := q.db.Exec(ctx, createMovementFX,
+		arg.MovementID,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.FromCurrencyID,
+		arg.ToCurrencyID,
+		arg.FromAmount,
+		arg.ToAmount,
+		arg.Rate,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createOutboxEvent = `-- name: CreateOutboxEvent :exec
+INSERT INTO ledger_outbox(
+	event_type,
+	aggregate_id,
+	payload,
+	created_at
+) VALUES($1,$2,$3,$4)
+`
+
+type CreateOutboxEventParams struct {
+	EventType   string
+	AggregateID string
+	Payload     []byte
+	CreatedAt   time.Time
+}
+
+func (q *Queries) CreateOutboxEvent(ctx context.Context, arg CreateOutboxEventParams) error {
+	_, err := q.db.Exec(ctx, createOutboxEvent,
+		arg.EventType,
+		arg.AggregateID,
+		arg.Payload,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createPendingMovement = `-- name: CreatePendingMovement :exec
+INSERT INTO pending_movements(
+	pending_movement_id,
+	idempotency_key,
+	pending_status,
+	expires_at,
+	created_at
+) VALUES($1,$2,$3,$4,$5)
+`
+
+type CreatePendingMovementParams struct {
+	PendingMovementID string
+	IdempotencyKey    string
+	PendingStatus     int32
+	ExpiresAt         time.Time
+	CreatedAt         time.Time
+}
+
+func (q *Queries) CreatePendingMovement(ctx context.Context, arg CreatePendingMovementParams) error {
+	_, err := q.db.Exec(ctx, createPendingMovement,
+		arg.PendingMovementID,
+		arg.IdempotencyKey,
+		arg.PendingStatus,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createReversedMovement = `-- name: CreateReversedMovement :exec
+INSERT INTO reversed_movements(
+	movement_id,
+	reversal_movement_id,
+	reversal_reason,
+	created_at
+) VALUES($1,$2,$3,$4)
+`
+
+type CreateReversedMovementParams struct {
+	MovementID         string
+	ReversalMovementID string
+	ReversalReason     string
+	CreatedAt          time.Time
+}
+
+func (q *Queries) CreateReversedMovement(ctx context.Context, arg CreateReversedMovementParams) error {
+	_, err := q.db.Exec(ctx, createReversedMovement,
+		arg.MovementID,
+		arg.ReversalMovementID,
+		arg.ReversalReason,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createScheduledMovement = `-- name: CreateScheduledMovement :exec
+INSERT INTO scheduled_movements(
+	schedule_id,
+	idempotency_key,
+	schedule_status,
+	recurrence,
+	occurrence_at,
+	next_run_at,
+	end_at,
+	created_at
+) VALUES($1,$2,$3,$4,$5,$6,$7,$8)
+`
+
+type CreateScheduledMovementParams struct {
+	ScheduleID     string
+	IdempotencyKey string
+	ScheduleStatus int32
+	Recurrence     sql.NullString
+	OccurrenceAt   time.Time
+	NextRunAt      time.Time
+	EndAt          sql.NullTime
+	CreatedAt      time.Time
+}
+
+func (q *Queries) CreateScheduledMovement(ctx context.Context, arg CreateScheduledMovementParams) error {
+	_, err := q.db.Exec(ctx, createScheduledMovement,
+		arg.ScheduleID,
+		arg.IdempotencyKey,
+		arg.ScheduleStatus,
+		arg.Recurrence,
+		arg.OccurrenceAt,
+		arg.NextRunAt,
+		arg.EndAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const deleteOutboxEvents = `-- name: DeleteOutboxEvents :many
+DELETE FROM ledger_outbox
+WHERE event_id IN (
+	SELECT event_id
+	FROM ledger_outbox
+	WHERE (transaction_id, event_id) <= ($1::bigint, $2::bigint)
+		AND created_at < $3
+	ORDER BY transaction_id, event_id
+	LIMIT $4
+)
+RETURNING event_id
+`
+
+type DeleteOutboxEventsParams struct {
+	TransactionID int64
+	EventID       int64
+	CreatedBefore time.Time
+	LimitSize     int32
+}
+
+// DeleteOutboxEvents deletes the events up to the position of (transaction_id, event_id) which are created before the given time,
+// and returns the id of the deleted events.
+func (q *Queries) DeleteOutboxEvents(ctx context.Context, arg DeleteOutboxEventsParams) ([]int64, error) {
+	rows, err := q.db.Query(ctx, deleteOutboxEvents,
+		arg.TransactionID,
+		arg.EventID,
+		arg.CreatedBefore,
+		arg.LimitSize,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Account
+	var items []int64
 	for rows.Next() {
-		var i Account
-		if err := rows.Scan(
-			&i.AccountID,
-			&i.Name,
-			&i.Description,
-			&i.ParentAccountID,
-			&i.CurrencyID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
+		var event_id int64
+		if err := rows.Scan(&event_id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, event_id)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -144,57 +444,42 @@ func (q *Queries) GetAccounts(ctx context.Context, dollar_1 []string) ([]Account
 	return items, nil
 }
 
-const getAccountsBalance = `-- name: GetAccountsBalance :many
-SELECT ab.account_id,
-    ab.parent_account_id,
-	ab.allow_negative,
-	ab.balance,
-	ab.currency_id,
-	ab.last_ledger_id,
-	ab.last_movement_id,
-	ab.created_at,
-	ab.updated_at
-FROM accounts_balance ab,
-	accounts ac
-WHERE ab.account_id = ANY($1::varchar[])
-	AND ab.account_id = ac.account_id
+const expirePendingMovements = `-- name: ExpirePendingMovements :many
+UPDATE pending_movements
+SET pending_status = 4,
+	updated_at = $1::timestamptz
+WHERE pending_movement_id IN (
+	SELECT pm.pending_movement_id
+	FROM pending_movements pm
+	WHERE pm.pending_status = 1
+		AND pm.expires_at <= $1::timestamptz
+	ORDER BY pm.expires_at
+	LIMIT $2::int
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING pending_movement_id
 `
 
-type GetAccountsBalanceRow struct {
-	AccountID       string
-	ParentAccountID sql.NullString
-	AllowNegative   bool
-	Balance         decimal.Decimal
-	CurrencyID      int32
-	LastLedgerID    string
-	LastMovementID  string
-	CreatedAt       time.Time
-	UpdatedAt       sql.NullTime
+type ExpirePendingMovementsParams struct {
+	ExpiredAt   time.Time
+	ExpireLimit int32
 }
 
-func (q *Queries) GetAccountsBalance(ctx context.Context, dollar_1 []string) ([]GetAccountsBalanceRow, error) {
-	rows, err := q.db.Query(ctx, getAccountsBalance, dollar_1)
+// ExpirePendingMovements expires the pending movements that are not captured before expires_at. The rows are locked
+// with SKIP LOCKED so the pending movements being captured or voided are skipped.
+func (q *Queries) ExpirePendingMovements(ctx context.Context, arg ExpirePendingMovementsParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, expirePendingMovements, arg.ExpiredAt, arg.ExpireLimit)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetAccountsBalanceRow
+	var items []string
 	for rows.Next() {
-		var i GetAccountsBalanceRow
-		if err := rows.Scan(
-			&i.AccountID,
-			&i.ParentAccountID,
-			&i.AllowNegative,
-			&i.Balance,
-			&i.CurrencyID,
-			&i.LastLedgerID,
-			&i.LastMovementID,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-		); err != nil {
+		var pending_movement_id string
+		if err := rows.Scan(&pending_movement_id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, pending_movement_id)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -202,79 +487,628 @@ func (q *Queries) GetAccountsBalance(ctx context.Context, dollar_1 []string) ([]
 	return items, nil
 }
 
-const getAccountsBalanceWithChild = `-- name: GetAccountsBalanceWithChild :one
-WITH sum_main AS (
-    SELECT account_id,
-        allow_negative,
-        balance,
-        last_ledger_id,
-        last_movement_id,
-        currency_id,
-        created_at
-    FROM accounts_balance
-    WHERE account_id = $1
-),
-child_accounts AS (
-    SELECT parent_account_id as account_id,
-        SUM(balance) as balance
-    FROM accounts_balance
-    WHERE parent_account_id = $1
-    GROUP BY parent_account_id
-)
-SELECT
-    main_acc.account_id,
-    main_acc.allow_negative,
-    main_acc.balance + child_acc.balance total_account_balance,
-    main_acc.balance main_account_balance,
-    child_acc.balance child_account_balance,
-    main_acc.last_ledger_id,
-    main_acc.last_movement_id,
-    main_acc.currency_id,
-    main_acc.created_at
-FROM sum_main main_acc,
-    child_accounts child_acc
-WHERE main_acc.account_id = child_acc.account_id
+const getAccountBalanceBeforeTime = `-- name: GetAccountBalanceBeforeTime :one
+SELECT balance
+FROM accounts_balance_history
+WHERE account_id = $1
+	AND created_at < $2
+ORDER BY history_id DESC
+LIMIT 1
 `
 
-type GetAccountsBalanceWithChildRow struct {
-	AccountID           string
-	AllowNegative       bool
-	TotalAccountBalance decimal.Decimal
-	MainAccountBalance  decimal.Decimal
-	ChildAccountBalance decimal.Decimal
-	LastLedgerID        string
-	LastMovementID      string
-	CurrencyID          int32
-	CreatedAt           time.Time
+type GetAccountBalanceBeforeTimeParams struct {
+	AccountID string
+	CreatedAt time.Time
 }
 
-func (q *Queries) GetAccountsBalanceWithChild(ctx context.Context, dollar_1 sql.NullString) (GetAccountsBalanceWithChildRow, error) {
-	row := q.db.QueryRow(ctx, getAccountsBalanceWithChild, dollar_1)
-	var i GetAccountsBalanceWithChildRow
+func (q *Queries) GetAccountBalanceBeforeTime(ctx context.Context, arg GetAccountBalanceBeforeTimeParams) (decimal.Decimal, error) {
+	row := q.db.QueryRow(ctx, getAccountBalanceBeforeTime, arg.AccountID, arg.CreatedAt)
+	var balance decimal.Decimal
+	err := row.Scan(&balance)
+	return balance, err
+}
+
+const getAccountBalanceForHold = `-- name: GetAccountBalanceForHold :one
+SELECT ab.account_id,
+	ab.allow_negative,
+	ab.balance,
+	ab.held_amount,
+	ac.account_status,
+	COALESCE((
+		SELECT SUM(pme.amount)
+		FROM pending_movement_entries pme,
+			pending_movements pm
+		WHERE pme.from_account_id = ab.account_id
+			AND pm.pending_movement_id = pme.pending_movement_id
+			AND pm.pending_status = 1
+			AND pm.expires_at > $1::timestamptz
+	), 0)::numeric AS reserved_amount
+FROM accounts_balance ab,
+	accounts ac
+WHERE ab.account_id = $2
+	AND ac.account_id = ab.account_id
+FOR UPDATE OF ab
+`
+
+type GetAccountBalanceForHoldParams struct {
+	At        time.Time
+	AccountID string
+}
+
+type GetAccountBalanceForHoldRow struct {
+	AccountID      string
+	AllowNegative  bool
+	Balance        decimal.Decimal
+	HeldAmount     decimal.Decimal
+	AccountStatus  int32
+	ReservedAmount decimal.Decimal
+}
+
+// GetAccountBalanceForHold locks the account balance so the available balance of the account cannot be changed while the
+// hold is placed.
+func (q *Queries) GetAccountBalanceForHold(ctx context.Context, arg GetAccountBalanceForHoldParams) (GetAccountBalanceForHoldRow, error) {
+	row := q.db.QueryRow(ctx, getAccountBalanceForHold, arg.At, arg.AccountID)
+	var i GetAccountBalanceForHoldRow
 	err := row.Scan(
 		&i.AccountID,
 		&i.AllowNegative,
-		&i.TotalAccountBalance,
-		&i.MainAccountBalance,
-		&i.ChildAccountBalance,
-		&i.LastLedgerID,
-		&i.LastMovementID,
-		&i.CurrencyID,
-		&i.CreatedAt,
+		&i.Balance,
+		&i.HeldAmount,
+		&i.AccountStatus,
+		&i.ReservedAmount,
 	)
 	return i, err
 }
 
-const getAccountsBalancesWithChild = `-- name: GetAccountsBalancesWithChild :many
-WITH sum_main AS (
-    SELECT account_id,
-        allow_negative,
-        balance,
-        last_ledger_id,
-        last_movement_id,
-        currency_id,
-        created_at
-    FROM accounts_balance
+const getAccountForBalanceShards = `-- name: GetAccountForBalanceShards :one
+SELECT ac.account_id,
+	ac.name,
+	ac.description,
+	ac.parent_account_id,
+	ac.currency_id,
+	ac.balance_shards,
+	ab.allow_negative
+FROM accounts ac,
+	accounts_balance ab
+WHERE ac.account_id = $1
+	AND ab.account_id = ac.account_id
+FOR UPDATE OF ac
+`
+
+type GetAccountForBalanceShardsRow struct {
+	AccountID       string
+	Name            string
+	Description     string
+	ParentAccountID sql.NullString
+	CurrencyID      int32
+	BalanceShards   int32
+	AllowNegative   bool
+}
+
+// GetAccountForBalanceShards locks the account so the balance shards of the account cannot be created concurrently.
+func (q *Queries) GetAccountForBalanceShards(ctx context.Context, accountID string) (GetAccountForBalanceShardsRow, error) {
+	row := q.db.QueryRow(ctx, getAccountForBalanceShards, accountID)
+	var i GetAccountForBalanceShardsRow
+	err := row.Scan(
+		&i.AccountID,
+		&i.Name,
+		&i.Description,
+		&i.ParentAccountID,
+		&i.CurrencyID,
+		&i.BalanceShards,
+		&i.AllowNegative,
+	)
+	return i, err
+}
+
+const getAccountHoldByClientReference = `-- name: GetAccountHoldByClientReference :one
+SELECT hold_id, account_id, client_reference, amount, reason, hold_status, created_at, released_at, updated_at
+FROM account_holds
+WHERE account_id = $1
+	AND client_reference = $2
+`
+
+type GetAccountHoldByClientReferenceParams struct {
+	AccountID       string
+	ClientReference string
+}
+
+func (q *Queries) GetAccountHoldByClientReference(ctx context.Context, arg GetAccountHoldByClientReferenceParams) (AccountHold, error) {
+	row := q.db.QueryRow(ctx, getAccountHoldByClientReference, arg.AccountID, arg.ClientReference)
+	var i AccountHold
+	err := row.Scan(
+		&i.HoldID,
+		&i.AccountID,
+		&i.ClientReference,
+		&i.Amount,
+		&i.Reason,
+		&i.HoldStatus,
+		&i.CreatedAt,
+		&i.ReleasedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getAccountHoldByClientReferenceForUpdate = `-- name: GetAccountHoldByClientReferenceForUpdate :one
+SELECT hold_id, account_id, client_reference, amount, reason, hold_status, created_at, released_at, updated_at
+FROM account_holds
+WHERE account_id = $1
+	AND client_reference = $2
+FOR UPDATE
+`
+
+type GetAccountHoldByClientReferenceForUpdateParams struct {
+	AccountID       string
+	ClientReference string
+}
+
+func (q *Queries) GetAccountHoldByClientReferenceForUpdate(ctx context.Context, arg GetAccountHoldByClientReferenceForUpdateParams) (AccountHold, error) {
+	row := q.db.QueryRow(ctx, getAccountHoldByClientReferenceForUpdate, arg.AccountID, arg.ClientReference)
+	var i AccountHold
+	err := row.Scan(
+		&i.HoldID,
+		&i.AccountID,
+		&i.ClientReference,
+		&i.Amount,
+		&i.Reason,
+		&i.HoldStatus,
+		&i.CreatedAt,
+		&i.ReleasedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getAccountLedgerSummary = `-- name: GetAccountLedgerSummary :one
+SELECT COALESCE(SUM(amount), 0)::numeric AS total_amount,
+	COALESCE(SUM(amount) FILTER (WHERE internal_id <= $1), 0)::numeric AS cursor_amount
+FROM accounts_ledger
+WHERE account_id = $2
+	AND created_at >= $3
+	AND created_at < $4
+`
+
+type GetAccountLedgerSummaryParams struct {
+	InternalID int64
+	AccountID  string
+	FromTime   time.Time
+	ToTime     time.Time
+}
+
+type GetAccountLedgerSummaryRow struct {
+	TotalAmount  decimal.Decimal
+	CursorAmount decimal.Decimal
+}
+
+func (q *Queries) GetAccountLedgerSummary(ctx context.Context, arg GetAccountLedgerSummaryParams) (GetAccountLedgerSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getAccountLedgerSummary,
+		arg.InternalID,
+		arg.AccountID,
+		arg.FromTime,
+		arg.ToTime,
+	)
+	var i GetAccountLedgerSummaryRow
+	err := row.Scan(&i.TotalAmount, &i.CursorAmount)
+	return i, err
+}
+
+const getAccountStatusForUpdate = `-- name: GetAccountStatusForUpdate :one
+SELECT ac.account_id,
+	ac.account_status
+FROM accounts ac,
+	accounts_balance ab
+WHERE ac.account_id = $1
+	AND ab.account_id = ac.account_id
+FOR UPDATE
+`
+
+type GetAccountStatusForUpdateRow struct {
+	AccountID     string
+	AccountStatus int32
+}
+
+// GetAccountStatusForUpdate locks both accounts and accounts_balance rows of the account. The accounts_balance row is locked
+// so the status changes wait for the ongoing movements of the account. The accounts row is locked so the status changes wait
+// for the ongoing movements of the balance shards of the account, see GetBalanceShardsParentStatusForShare.
+func (q *Queries) GetAccountStatusForUpdate(ctx context.Context, accountID string) (GetAccountStatusForUpdateRow, error) {
+	row := q.db.QueryRow(ctx, getAccountStatusForUpdate, accountID)
+	var i GetAccountStatusForUpdateRow
+	err := row.Scan(&i.AccountID, &i.AccountStatus)
+	return i, err
+}
+
+const getAccountTreeBalances = `-- name: GetAccountTreeBalances :many
+SELECT account_id, parent_account_id, currency_id, allow_negative, balance, last_movement_id, last_ledger_id, created_at, updated_at, held_amount
+FROM accounts_balance
+WHERE account_id = $1
+	OR parent_account_id = $1
+ORDER BY created_at, account_id
+`
+
+func (q *Queries) GetAccountTreeBalances(ctx context.Context, accountID string) ([]AccountsBalance, error) {
+	rows, err := q.db.Query(ctx, getAccountTreeBalances, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountsBalance
+	for rows.Next() {
+		var i AccountsBalance
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.ParentAccountID,
+			&i.CurrencyID,
+			&i.AllowNegative,
+			&i.Balance,
+			&i.LastMovementID,
+			&i.LastLedgerID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.HeldAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccounts = `-- name: GetAccounts :many
+SELECT account_id, name, description, parent_account_id, currency_id, created_at, updated_at, account_status, balance_shards, is_balance_shard
+FROM accounts
+WHERE account_id = ANY($1::varchar[])
+ORDER BY created_at
+`
+
+func (q *Queries) GetAccounts(ctx context.Context, dollar_1 []string) ([]Account, error) {
+	rows, err := q.db.Query(ctx, getAccounts, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Account
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.Name,
+			&i.Description,
+			&i.ParentAccountID,
+			&i.CurrencyID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AccountStatus,
+			&i.BalanceShards,
+			&i.IsBalanceShard,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccountsBalance = `-- name: GetAccountsBalance :many
+SELECT ab.account_id,
+    ab.parent_account_id,
+	ab.allow_negative,
+	ab.balance,
+	ab.currency_id,
+	ab.last_ledger_id,
+	ab.last_movement_id,
+	ab.created_at,
+	ab.updated_at,
+	ac.account_status,
+	ab.held_amount,
+	COALESCE((
+		SELECT SUM(pme.amount)
+		FROM pending_movement_entries pme,
+			pending_movements pm
+		WHERE pme.from_account_id = ab.account_id
+			AND pm.pending_movement_id = pme.pending_movement_id
+			AND pm.pending_status = 1
+			AND pm.expires_at > now()
+	), 0)::numeric AS reserved_amount,
+	ac.balance_shards,
+	CASE WHEN ac.balance_shards > 0 THEN COALESCE((
+		SELECT SUM(sab.balance)
+		FROM accounts_balance sab,
+			accounts sac
+		WHERE sab.parent_account_id = ab.account_id
+			AND sac.account_id = sab.account_id
+			AND sac.is_balance_shard
+	), 0) ELSE 0 END::numeric AS shards_balance
+FROM accounts_balance ab,
+	accounts ac
+WHERE ab.account_id = ANY($1::varchar[])
+	AND ab.account_id = ac.account_id
+`
+
+type GetAccountsBalanceRow struct {
+	AccountID       string
+	ParentAccountID sql.NullString
+	AllowNegative   bool
+	Balance         decimal.Decimal
+	CurrencyID      int32
+	LastLedgerID    string
+	LastMovementID  string
+	CreatedAt       time.Time
+	UpdatedAt       sql.NullTime
+	AccountStatus   int32
+	HeldAmount      decimal.Decimal
+	ReservedAmount  decimal.Decimal
+	BalanceShards   int32
+	ShardsBalance   decimal.Decimal
+}
+
+func (q *Queries) GetAccountsBalance(ctx context.Context, dollar_1 []string) ([]GetAccountsBalanceRow, error) {
+	rows, err := q.db.Query(ctx, getAccountsBalance, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAccountsBalanceRow
+	for rows.Next() {
+		var i GetAccountsBalanceRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.ParentAccountID,
+			&i.AllowNegative,
+			&i.Balance,
+			&i.CurrencyID,
+			&i.LastLedgerID,
+			&i.LastMovementID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AccountStatus,
+			&i.HeldAmount,
+			&i.ReservedAmount,
+			&i.BalanceShards,
+			&i.ShardsBalance,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccountsBalanceAt = `-- name: GetAccountsBalanceAt :many
+SELECT DISTINCT ON (account_id) account_id,
+	movement_id,
+	ledger_id,
+	balance,
+	created_at
+FROM accounts_balance_history
+WHERE account_id = ANY($1::varchar[])
+	AND created_at <= $2
+ORDER BY account_id, history_id DESC
+`
+
+type GetAccountsBalanceAtParams struct {
+	AccountIds []string
+	At         time.Time
+}
+
+type GetAccountsBalanceAtRow struct {
+	AccountID  string
+	MovementID string
+	LedgerID   string
+	Balance    decimal.Decimal
+	CreatedAt  time.Time
+}
+
+func (q *Queries) GetAccountsBalanceAt(ctx context.Context, arg GetAccountsBalanceAtParams) ([]GetAccountsBalanceAtRow, error) {
+	rows, err := q.db.Query(ctx, getAccountsBalanceAt, arg.AccountIds, arg.At)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAccountsBalanceAtRow
+	for rows.Next() {
+		var i GetAccountsBalanceAtRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.MovementID,
+			&i.LedgerID,
+			&i.Balance,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccountsBalanceForPendingMovement = `-- name: GetAccountsBalanceForPendingMovement :many
+SELECT ab.account_id,
+	ab.allow_negative,
+	ab.balance,
+	ab.held_amount,
+	ac.account_status,
+	COALESCE((
+		SELECT SUM(pme.amount)
+		FROM pending_movement_entries pme,
+			pending_movements pm
+		WHERE pme.from_account_id = ab.account_id
+			AND pm.pending_movement_id = pme.pending_movement_id
+			AND pm.pending_status = 1
+			AND pm.expires_at > $1::timestamptz
+	), 0)::numeric AS reserved_amount
+FROM accounts_balance ab,
+	accounts ac
+WHERE ab.account_id = ANY($2::varchar[])
+	AND ac.account_id = ab.account_id
+FOR UPDATE OF ab
+`
+
+type GetAccountsBalanceForPendingMovementParams struct {
+	At         time.Time
+	AccountIds []string
+}
+
+type GetAccountsBalanceForPendingMovementRow struct {
+	AccountID      string
+	AllowNegative  bool
+	Balance        decimal.Decimal
+	HeldAmount     decimal.Decimal
+	AccountStatus  int32
+	ReservedAmount decimal.Decimal
+}
+
+// GetAccountsBalanceForPendingMovement locks the accounts balance and returns the amount held and reserved by the pending
+// movements of the accounts.
+func (q *Queries) GetAccountsBalanceForPendingMovement(ctx context.Context, arg GetAccountsBalanceForPendingMovementParams) ([]GetAccountsBalanceForPendingMovementRow, error) {
+	rows, err := q.db.Query(ctx, getAccountsBalanceForPendingMovement, arg.At, arg.AccountIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAccountsBalanceForPendingMovementRow
+	for rows.Next() {
+		var i GetAccountsBalanceForPendingMovementRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.AllowNegative,
+			&i.Balance,
+			&i.HeldAmount,
+			&i.AccountStatus,
+			&i.ReservedAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccountsBalanceHistoryByMovementID = `-- name: GetAccountsBalanceHistoryByMovementID :many
+SELECT history_id, movement_id, ledger_id, account_id, balance, previous_balance, previous_movement_id, previous_ledger_id, created_at
+FROM accounts_balance_history
+WHERE movement_id = $1
+ORDER BY history_id
+`
+
+func (q *Queries) GetAccountsBalanceHistoryByMovementID(ctx context.Context, movementID string) ([]AccountsBalanceHistory, error) {
+	rows, err := q.db.Query(ctx, getAccountsBalanceHistoryByMovementID, movementID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountsBalanceHistory
+	for rows.Next() {
+		var i AccountsBalanceHistory
+		if err := rows.Scan(
+			&i.HistoryID,
+			&i.MovementID,
+			&i.LedgerID,
+			&i.AccountID,
+			&i.Balance,
+			&i.PreviousBalance,
+			&i.PreviousMovementID,
+			&i.PreviousLedgerID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccountsBalanceWithChild = `-- name: GetAccountsBalanceWithChild :one
+WITH sum_main AS (
+    SELECT account_id,
+        allow_negative,
+        balance,
+        last_ledger_id,
+        last_movement_id,
+        currency_id,
+        created_at
+    FROM accounts_balance
+    WHERE account_id = $1
+),
+child_accounts AS (
+    SELECT parent_account_id as account_id,
+        SUM(balance) as balance
+    FROM accounts_balance
+    WHERE parent_account_id = $1
+    GROUP BY parent_account_id
+)
+SELECT
+    main_acc.account_id,
+    main_acc.allow_negative,
+    main_acc.balance + child_acc.balance total_account_balance,
+    main_acc.balance main_account_balance,
+    child_acc.balance child_account_balance,
+    main_acc.last_ledger_id,
+    main_acc.last_movement_id,
+    main_acc.currency_id,
+    main_acc.created_at
+FROM sum_main main_acc,
+    child_accounts child_acc
+WHERE main_acc.account_id = child_acc.account_id
+`
+
+type GetAccountsBalanceWithChildRow struct {
+	AccountID           string
+	AllowNegative       bool
+	TotalAccountBalance decimal.Decimal
+	MainAccountBalance  decimal.Decimal
+	ChildAccountBalance decimal.Decimal
+	LastLedgerID        string
+	LastMovementID      string
+	CurrencyID          int32
+	CreatedAt           time.Time
+}
+
+func (q *Queries) GetAccountsBalanceWithChild(ctx context.Context, dollar_1 sql.NullString) (GetAccountsBalanceWithChildRow, error) {
+	row := q.db.QueryRow(ctx, getAccountsBalanceWithChild, dollar_1)
+	var i GetAccountsBalanceWithChildRow
+	err := row.Scan(
+		&i.AccountID,
+		&i.AllowNegative,
+		&i.TotalAccountBalance,
+		&i.MainAccountBalance,
+		&i.ChildAccountBalance,
+		&i.LastLedgerID,
+		&i.LastMovementID,
+		&i.CurrencyID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAccountsBalancesWithChild = `-- name: GetAccountsBalancesWithChild :many
+WITH sum_main AS (
+    SELECT account_id,
+        allow_negative,
+        balance,
+        last_ledger_id,
+        last_movement_id,
+        currency_id,
+        created_at
+    FROM accounts_balance
     WHERE account_id = ANY($1::varchar[])
 ),
 child_accounts AS (
@@ -299,36 +1133,956 @@ FROM sum_main main_acc,
 WHERE main_acc.account_id = child_acc.account_id
 `
 
-type GetAccountsBalancesWithChildRow struct {
-	AccountID           string
-	AllowNegative       bool
-	TotalAccountBalance decimal.Decimal
-	MainAccountBalance  decimal.Decimal
-	ChildAccountBalance decimal.Decimal
-	LastLedgerID        string
-	LastMovementID      string
-	CurrencyID          int32
-	CreatedAt           time.Time
+type GetAccountsBalancesWithChildRow struct {
+	AccountID           string
+	AllowNegative       bool
+	TotalAccountBalance decimal.Decimal
+	MainAccountBalance  decimal.Decimal
+	ChildAccountBalance decimal.Decimal
+	LastLedgerID        string
+	LastMovementID      string
+	CurrencyID          int32
+	CreatedAt           time.Time
+}
+
+func (q *Queries) GetAccountsBalancesWithChild(ctx context.Context, dollar_1 []string) ([]GetAccountsBalancesWithChildRow, error) {
+	rows, err := q.db.Query(ctx, getAccountsBalancesWithChild, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAccountsBalancesWithChildRow
+	for rows.Next() {
+		var i GetAccountsBalancesWithChildRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.AllowNegative,
+			&i.TotalAccountBalance,
+			&i.MainAccountBalance,
+			&i.ChildAccountBalance,
+			&i.LastLedgerID,
+			&i.LastMovementID,
+			&i.CurrencyID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccountsBalancesWithChildForUpdate = `-- name: GetAccountsBalancesWithChildForUpdate :many
+WITH sum_main AS (
+    SELECT account_id,
+        allow_negative,
+        balance,
+        last_ledger_id,
+        last_movement_id,
+        currency_id,
+        created_at
+    FROM accounts_balance
+    WHERE account_id = ANY($1::varchar[])
+),
+child_accounts AS (
+    SELECT parent_account_id as account_id,
+        SUM(balance) as balance
+    FROM accounts_balance
+    WHERE parent_account_id = ANY($1::varchar[])
+    GROUP BY parent_account_id
+)
+SELECT
+    main_acc.account_id,
+    main_acc.allow_negative,
+    main_acc.balance + child_acc.balance total_account_balance,
+    main_acc.balance main_account_balance,
+    child_acc.balance child_account_balance,
+    main_acc.last_ledger_id,
+    main_acc.last_movement_id,
+    main_acc.currency_id,
+    main_acc.created_at
+FROM sum_main main_acc,
+    child_accounts child_acc
+WHERE main_acc.account_id = child_acc.account_id
+FOR UPDATE
+`
+
+type GetAccountsBalancesWithChildForUpdateRow struct {
+	AccountID           string
+	AllowNegative       bool
+	TotalAccountBalance decimal.Decimal
+	MainAccountBalance  decimal.Decimal
+	ChildAccountBalance decimal.Decimal
+	LastLedgerID        string
+	LastMovementID      string
+	CurrencyID          int32
+	CreatedAt           time.Time
+}
+
+func (q *Queries) GetAccountsBalancesWithChildForUpdate(ctx context.Context, dollar_1 []string) ([]GetAccountsBalancesWithChildForUpdateRow, error) {
+	rows, err := q.db.Query(ctx, getAccountsBalancesWithChildForUpdate, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAccountsBalancesWithChildForUpdateRow
+	for rows.Next() {
+		var i GetAccountsBalancesWithChildForUpdateRow
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.AllowNegative,
+			&i.TotalAccountBalance,
+			&i.MainAccountBalance,
+			&i.ChildAccountBalance,
+			&i.LastLedgerID,
+			&i.LastMovementID,
+			&i.CurrencyID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccountsLedgerByMovementID = `-- name: GetAccountsLedgerByMovementID :many
+SELECT ledger_id,
+	movement_id,
+	movement_sequence,
+	account_id,
+	amount,
+	previous_ledger_id,
+	client_id,
+	created_at,
+	client_id
+FROM accounts_ledger
+WHERE movement_id = $1
+ORDER BY created_at
+`
+
+type GetAccountsLedgerByMovementIDRow struct {
+	LedgerID         string
+	MovementID       string
+	MovementSequence int32
+	AccountID        string
+	Amount           decimal.Decimal
+	PreviousLedgerID string
+	ClientID         sql.NullString
+	CreatedAt        time.Time
+	ClientID_2       sql.NullString
+}
+
+func (q *Queries) GetAccountsLedgerByMovementID(ctx context.Context, movementID string) ([]GetAccountsLedgerByMovementIDRow, error) {
+	rows, err := q.db.Query(ctx, getAccountsLedgerByMovementID, movementID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAccountsLedgerByMovementIDRow
+	for rows.Next() {
+		var i GetAccountsLedgerByMovementIDRow
+		if err := rows.Scan(
+			&i.LedgerID,
+			&i.MovementID,
+			&i.MovementSequence,
+			&i.AccountID,
+			&i.Amount,
+			&i.PreviousLedgerID,
+			&i.ClientID,
+			&i.CreatedAt,
+			&i.ClientID_2,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAccountsStatus = `-- name: GetAccountsStatus :many
+SELECT account_id,
+	account_status
+FROM accounts
+WHERE account_id = ANY($1::varchar[])
+`
+
+type GetAccountsStatusRow struct {
+	AccountID     string
+	AccountStatus int32
+}
+
+func (q *Queries) GetAccountsStatus(ctx context.Context, dollar_1 []string) ([]GetAccountsStatusRow, error) {
+	rows, err := q.db.Query(ctx, getAccountsStatus, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAccountsStatusRow
+	for rows.Next() {
+		var i GetAccountsStatusRow
+		if err := rows.Scan(&i.AccountID, &i.AccountStatus); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBalanceShardsParentStatusForShare = `-- name: GetBalanceShardsParentStatusForShare :many
+SELECT shard.account_id,
+	parent.account_status
+FROM accounts shard,
+	accounts parent
+WHERE shard.account_id = ANY($1::varchar[])
+	AND shard.is_balance_shard
+	AND parent.account_id = shard.parent_account_id
+FOR SHARE OF parent
+`
+
+type GetBalanceShardsParentStatusForShareRow struct {
+	AccountID     string
+	AccountStatus int32
+}
+
+// GetBalanceShardsParentStatusForShare returns the status of the parent account of the balance shards, as the status of the
+// balance shards is never changed. The parent accounts are locked with FOR SHARE, so the status of the parent accounts cannot
+// be changed until the movements of the balance shards are committed.
+func (q *Queries) GetBalanceShardsParentStatusForShare(ctx context.Context, dollar_1 []string) ([]GetBalanceShardsParentStatusForShareRow, error) {
+	rows, err := q.db.Query(ctx, getBalanceShardsParentStatusForShare, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetBalanceShardsParentStatusForShareRow
+	for rows.Next() {
+		var i GetBalanceShardsParentStatusForShareRow
+		if err := rows.Scan(&i.AccountID, &i.AccountStatus); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMovement = `-- name: GetMovement :one
+SELECT movement_id, idempotency_key, created_at, updated_at, reversed_at, reversal_movement_id FROM movements
+WHERE movement_id = $1
+`
+
+func (q *Queries) GetMovement(ctx context.Context, movementID string) (Movement, error) {
+	row := q.db.QueryRow(ctx, getMovement, movementID)
+	var i Movement
+	err := row.Scan(
+		&i.MovementID,
+		&i.IdempotencyKey,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReversedAt,
+		&i.ReversalMovementID,
+	)
+	return i, err
+}
+
+const getMovementAccountsLedger = `-- name: GetMovementAccountsLedger :many
+SELECT internal_id, ledger_id, movement_id, account_id, movement_sequence, currency_id, amount, previous_ledger_id, created_at, client_id, reversal_of
+FROM accounts_ledger
+WHERE movement_id = $1
+ORDER BY internal_id
+`
+
+func (q *Queries) GetMovementAccountsLedger(ctx context.Context, movementID string) ([]AccountsLedger, error) {
+	rows, err := q.db.Query(ctx, getMovementAccountsLedger, movementID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountsLedger
+	for rows.Next() {
+		var i AccountsLedger
+		if err := rows.Scan(
+			&i.InternalID,
+			&i.LedgerID,
+			&i.MovementID,
+			&i.AccountID,
+			&i.MovementSequence,
+			&i.CurrencyID,
+			&i.Amount,
+			&i.PreviousLedgerID,
+			&i.CreatedAt,
+			&i.ClientID,
+			&i.ReversalOf,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMovementByIdempotencyKey = `-- name: GetMovementByIdempotencyKey :one
+SELECT movement_id,
+    idempotency_key,
+    created_at,
+    updated_at
+FROM movements
+WHERE idempotency_key = $1
+`
+
+type GetMovementByIdempotencyKeyRow struct {
+	MovementID     string
+	IdempotencyKey string
+	CreatedAt      time.Time
+	UpdatedAt      sql.NullTime
+}
+
+func (q *Queries) GetMovementByIdempotencyKey(ctx context.Context, idempotencyKey string) (GetMovementByIdempotencyKeyRow, error) {
+	row := q.db.QueryRow(ctx, getMovementByIdempotencyKey, idempotencyKey)
+	var i GetMovementByIdempotencyKeyRow
+	err := row.Scan(
+		&i.MovementID,
+		&i.IdempotencyKey,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getMovementFX = `-- name: GetMovementFX :one
+SELECT movement_id, from_account_id, to_account_id, from_currency_id, to_currency_id, from_amount, to_amount, rate, created_at
+FROM movements_fx
+WHERE movement_id = $1
+`
+
+func (q *Queries) GetMovementFX(ctx context.Context, movementID string) (MovementsFx, error) {
+	row := q.db.QueryRow(ctx, getMovementFX, movementID)
+	var i MovementsFx
+	err := row.Scan(
+		&i.MovementID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.FromCurrencyID,
+		&i.ToCurrencyID,
+		&i.FromAmount,
+		&i.ToAmount,
+		&i.Rate,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getMovementForUpdate = `-- name: GetMovementForUpdate :one
+SELECT movement_id, idempotency_key, created_at, updated_at, reversed_at, reversal_movement_id FROM movements
+WHERE movement_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetMovementForUpdate(ctx context.Context, movementID string) (Movement, error) {
+	row := q.db.QueryRow(ctx, getMovementForUpdate, movementID)
+	var i Movement
+	err := row.Scan(
+		&i.MovementID,
+		&i.IdempotencyKey,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.ReversedAt,
+		&i.ReversalMovementID,
+	)
+	return i, err
+}
+
+const getOutboxLatestPosition = `-- name: GetOutboxLatestPosition :one
+SELECT transaction_id,
+	event_id
+FROM ledger_outbox
+WHERE transaction_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+ORDER BY transaction_id DESC, event_id DESC
+LIMIT 1
+`
+
+type GetOutboxLatestPositionRow struct {
+	TransactionID int64
+	EventID       int64
+}
+
+func (q *Queries) GetOutboxLatestPosition(ctx context.Context) (GetOutboxLatestPositionRow, error) {
+	row := q.db.QueryRow(ctx, getOutboxLatestPosition)
+	var i GetOutboxLatestPositionRow
+	err := row.Scan(&i.TransactionID, &i.EventID)
+	return i, err
+}
+
+const getOutboxResumeToken = `-- name: GetOutboxResumeToken :one
+SELECT resume_token
+FROM ledger_outbox_offsets
+WHERE relay_name = $1
+`
+
+func (q *Queries) GetOutboxResumeToken(ctx context.Context, relayName string) (string, error) {
+	row := q.db.QueryRow(ctx, getOutboxResumeToken, relayName)
+	var resume_token string
+	err := row.Scan(&resume_token)
+	return resume_token, err
+}
+
+const getPendingMovement = `-- name: GetPendingMovement :one
+SELECT pending_movement_id, idempotency_key, pending_status, movement_id, expires_at, created_at, updated_at
+FROM pending_movements
+WHERE pending_movement_id = $1
+`
+
+func (q *Queries) GetPendingMovement(ctx context.Context, pendingMovementID string) (PendingMovement, error) {
+	row := q.db.QueryRow(ctx, getPendingMovement, pendingMovementID)
+	var i PendingMovement
+	err := row.Scan(
+		&i.PendingMovementID,
+		&i.IdempotencyKey,
+		&i.PendingStatus,
+		&i.MovementID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPendingMovementByIdempotencyKey = `-- name: GetPendingMovementByIdempotencyKey :one
+SELECT pending_movement_id, idempotency_key, pending_status, movement_id, expires_at, created_at, updated_at
+FROM pending_movements
+WHERE idempotency_key = $1
+`
+
+func (q *Queries) GetPendingMovementByIdempotencyKey(ctx context.Context, idempotencyKey string) (PendingMovement, error) {
+	row := q.db.QueryRow(ctx, getPendingMovementByIdempotencyKey, idempotencyKey)
+	var i PendingMovement
+	err := row.Scan(
+		&i.PendingMovementID,
+		&i.IdempotencyKey,
+		&i.PendingStatus,
+		&i.MovementID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getPendingMovementEntries = `-- name: GetPendingMovementEntries :many
+SELECT pending_movement_id, movement_sequence, from_account_id, to_account_id, currency_id, amount, client_id, created_at
+FROM pending_movement_entries
+WHERE pending_movement_id = $1
+ORDER BY movement_sequence
+`
+
+func (q *Queries) GetPendingMovementEntries(ctx context.Context, pendingMovementID string) ([]PendingMovementEntry, error) {
+	rows, err := q.db.Query(ctx, getPendingMovementEntries, pendingMovementID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PendingMovementEntry
+	for rows.Next() {
+		var i PendingMovementEntry
+		if err := rows.Scan(
+			&i.PendingMovementID,
+			&i.MovementSequence,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.CurrencyID,
+			&i.Amount,
+			&i.ClientID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPendingMovementForUpdate = `-- name: GetPendingMovementForUpdate :one
+SELECT pending_movement_id, idempotency_key, pending_status, movement_id, expires_at, created_at, updated_at
+FROM pending_movements
+WHERE pending_movement_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetPendingMovementForUpdate(ctx context.Context, pendingMovementID string) (PendingMovement, error) {
+	row := q.db.QueryRow(ctx, getPendingMovementForUpdate, pendingMovementID)
+	var i PendingMovement
+	err := row.Scan(
+		&i.PendingMovementID,
+		&i.IdempotencyKey,
+		&i.PendingStatus,
+		&i.MovementID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getRecordedIdempotencyKeys = `-- name: GetRecordedIdempotencyKeys :many
+SELECT idempotency_key
+FROM movements
+WHERE idempotency_key = ANY($1::varchar[])
+`
+
+// GetRecordedIdempotencyKeys returns the idempotency keys which movements are already recorded.
+func (q *Queries) GetRecordedIdempotencyKeys(ctx context.Context, dollar_1 []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, getRecordedIdempotencyKeys, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var idempotency_key string
+		if err := rows.Scan(&idempotency_key); err != nil {
+			return nil, err
+		}
+		items = append(items, idempotency_key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getScheduledMovement = `-- name: GetScheduledMovement :one
+SELECT schedule_id, idempotency_key, schedule_status, recurrence, occurrence_at, next_run_at, end_at, run_count, attempts, last_error, last_movement_id, created_at, updated_at
+FROM scheduled_movements
+WHERE schedule_id = $1
+`
+
+func (q *Queries) GetScheduledMovement(ctx context.Context, scheduleID string) (ScheduledMovement, error) {
+	row := q.db.QueryRow(ctx, getScheduledMovement, scheduleID)
+	var i ScheduledMovement
+	err := row.Scan(
+		&i.ScheduleID,
+		&i.IdempotencyKey,
+		&i.ScheduleStatus,
+		&i.Recurrence,
+		&i.OccurrenceAt,
+		&i.NextRunAt,
+		&i.EndAt,
+		&i.RunCount,
+		&i.Attempts,
+		&i.LastError,
+		&i.LastMovementID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getScheduledMovementByIdempotencyKey = `-- name: GetScheduledMovementByIdempotencyKey :one
+SELECT schedule_id, idempotency_key, schedule_status, recurrence, occurrence_at, next_run_at, end_at, run_count, attempts, last_error, last_movement_id, created_at, updated_at
+FROM scheduled_movements
+WHERE idempotency_key = $1
+`
+
+func (q *Queries) GetScheduledMovementByIdempotencyKey(ctx context.Context, idempotencyKey string) (ScheduledMovement, error) {
+	row := q.db.QueryRow(ctx, getScheduledMovementByIdempotencyKey, idempotencyKey)
+	var i ScheduledMovement
+	err := row.Scan(
+		&i.ScheduleID,
+		&i.IdempotencyKey,
+		&i.ScheduleStatus,
+		&i.Recurrence,
+		&i.OccurrenceAt,
+		&i.NextRunAt,
+		&i.EndAt,
+		&i.RunCount,
+		&i.Attempts,
+		&i.LastError,
+		&i.LastMovementID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getScheduledMovementEntries = `-- name: GetScheduledMovementEntries :many
+SELECT schedule_id, movement_sequence, from_account_id, to_account_id, amount, client_id, created_at
+FROM scheduled_movement_entries
+WHERE schedule_id = $1
+ORDER BY movement_sequence
+`
+
+func (q *Queries) GetScheduledMovementEntries(ctx context.Context, scheduleID string) ([]ScheduledMovementEntry, error) {
+	rows, err := q.db.Query(ctx, getScheduledMovementEntries, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ScheduledMovementEntry
+	for rows.Next() {
+		var i ScheduledMovementEntry
+		if err := rows.Scan(
+			&i.ScheduleID,
+			&i.MovementSequence,
+			&i.FromAccountID,
+			&i.ToAccountID,
+			&i.Amount,
+			&i.ClientID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getScheduledMovementForUpdate = `-- name: GetScheduledMovementForUpdate :one
+SELECT schedule_id, idempotency_key, schedule_status, recurrence, occurrence_at, next_run_at, end_at, run_count, attempts, last_error, last_movement_id, created_at, updated_at
+FROM scheduled_movements
+WHERE schedule_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetScheduledMovementForUpdate(ctx context.Context, scheduleID string) (ScheduledMovement, error) {
+	row := q.db.QueryRow(ctx, getScheduledMovementForUpdate, scheduleID)
+	var i ScheduledMovement
+	err := row.Scan(
+		&i.ScheduleID,
+		&i.IdempotencyKey,
+		&i.ScheduleStatus,
+		&i.Recurrence,
+		&i.OccurrenceAt,
+		&i.NextRunAt,
+		&i.EndAt,
+		&i.RunCount,
+		&i.Attempts,
+		&i.LastError,
+		&i.LastMovementID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const listAccountBalanceHistoryByAccountID = `-- name: ListAccountBalanceHistoryByAccountID :many
+SELECT history_id, movement_id, ledger_id, account_id, balance, previous_balance, previous_movement_id, previous_ledger_id, created_at
+FROM accounts_balance_history
+WHERE account_id = $1
+ORDER BY history_id
+`
+
+func (q *Queries) ListAccountBalanceHistoryByAccountID(ctx context.Context, accountID string) ([]AccountsBalanceHistory, error) {
+	rows, err := q.db.Query(ctx, listAccountBalanceHistoryByAccountID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountsBalanceHistory
+	for rows.Next() {
+		var i AccountsBalanceHistory
+		if err := rows.Scan(
+			&i.HistoryID,
+			&i.MovementID,
+			&i.LedgerID,
+			&i.AccountID,
+			&i.Balance,
+			&i.PreviousBalance,
+			&i.PreviousMovementID,
+			&i.PreviousLedgerID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountBalanceHistoryFromLedger = `-- name: ListAccountBalanceHistoryFromLedger :many
+SELECT history_id, movement_id, ledger_id, account_id, balance, previous_balance, previous_movement_id, previous_ledger_id, created_at
+FROM accounts_balance_history
+WHERE account_id = $1
+	AND history_id >= (
+		SELECT history_id
+		FROM accounts_balance_history
+		WHERE account_id = $1
+			AND ledger_id = $2
+	)
+ORDER BY history_id
+`
+
+type ListAccountBalanceHistoryFromLedgerParams struct {
+	AccountID string
+	LedgerID  string
+}
+
+func (q *Queries) ListAccountBalanceHistoryFromLedger(ctx context.Context, arg ListAccountBalanceHistoryFromLedgerParams) ([]AccountsBalanceHistory, error) {
+	rows, err := q.db.Query(ctx, listAccountBalanceHistoryFromLedger, arg.AccountID, arg.LedgerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountsBalanceHistory
+	for rows.Next() {
+		var i AccountsBalanceHistory
+		if err := rows.Scan(
+			&i.HistoryID,
+			&i.MovementID,
+			&i.LedgerID,
+			&i.AccountID,
+			&i.Balance,
+			&i.PreviousBalance,
+			&i.PreviousMovementID,
+			&i.PreviousLedgerID,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountLedger = `-- name: ListAccountLedger :many
+SELECT internal_id, ledger_id, movement_id, account_id, movement_sequence, currency_id, amount, previous_ledger_id, created_at, client_id, reversal_of
+FROM accounts_ledger
+WHERE account_id = $1
+	AND created_at >= $2
+	AND created_at < $3
+	AND internal_id > $4
+ORDER BY internal_id
+LIMIT $5
+`
+
+type ListAccountLedgerParams struct {
+	AccountID  string
+	FromTime   time.Time
+	ToTime     time.Time
+	InternalID int64
+	PageLimit  int32
+}
+
+func (q *Queries) ListAccountLedger(ctx context.Context, arg ListAccountLedgerParams) ([]AccountsLedger, error) {
+	rows, err := q.db.Query(ctx, listAccountLedger,
+		arg.AccountID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.InternalID,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountsLedger
+	for rows.Next() {
+		var i AccountsLedger
+		if err := rows.Scan(
+			&i.InternalID,
+			&i.LedgerID,
+			&i.MovementID,
+			&i.AccountID,
+			&i.MovementSequence,
+			&i.CurrencyID,
+			&i.Amount,
+			&i.PreviousLedgerID,
+			&i.CreatedAt,
+			&i.ClientID,
+			&i.ReversalOf,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountLedgerByAccountID = `-- name: ListAccountLedgerByAccountID :many
+SELECT internal_id, ledger_id, movement_id, account_id, movement_sequence, currency_id, amount, previous_ledger_id, created_at, client_id, reversal_of
+FROM accounts_ledger
+WHERE account_id = $1
+ORDER BY internal_id
+`
+
+func (q *Queries) ListAccountLedgerByAccountID(ctx context.Context, accountID string) ([]AccountsLedger, error) {
+	rows, err := q.db.Query(ctx, listAccountLedgerByAccountID, accountID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountsLedger
+	for rows.Next() {
+		var i AccountsLedger
+		if err := rows.Scan(
+			&i.InternalID,
+			&i.LedgerID,
+			&i.MovementID,
+			&i.AccountID,
+			&i.MovementSequence,
+			&i.CurrencyID,
+			&i.Amount,
+			&i.PreviousLedgerID,
+			&i.CreatedAt,
+			&i.ClientID,
+			&i.ReversalOf,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsBalance = `-- name: ListAccountsBalance :many
+SELECT account_id, parent_account_id, currency_id, allow_negative, balance, last_movement_id, last_ledger_id, created_at, updated_at, held_amount
+FROM accounts_balance
+WHERE account_id > $1
+ORDER BY account_id
+LIMIT $2
+`
+
+type ListAccountsBalanceParams struct {
+	AccountID string
+	Limit     int32
+}
+
+func (q *Queries) ListAccountsBalance(ctx context.Context, arg ListAccountsBalanceParams) ([]AccountsBalance, error) {
+	rows, err := q.db.Query(ctx, listAccountsBalance, arg.AccountID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AccountsBalance
+	for rows.Next() {
+		var i AccountsBalance
+		if err := rows.Scan(
+			&i.AccountID,
+			&i.ParentAccountID,
+			&i.CurrencyID,
+			&i.AllowNegative,
+			&i.Balance,
+			&i.LastMovementID,
+			&i.LastLedgerID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.HeldAmount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listCurrencies = `-- name: ListCurrencies :many
+SELECT currency_id, currency_code, exponent, rounding_mode, is_active, created_at, updated_at
+FROM currencies
+ORDER BY currency_id
+`
+
+func (q *Queries) ListCurrencies(ctx context.Context) ([]Currency, error) {
+	rows, err := q.db.Query(ctx, listCurrencies)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Currency
+	for rows.Next() {
+		var i Currency
+		if err := rows.Scan(
+			&i.CurrencyID,
+			&i.CurrencyCode,
+			&i.Exponent,
+			&i.RoundingMode,
+			&i.IsActive,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listOutboxEvents = `-- name: ListOutboxEvents :many
+SELECT event_id,
+	transaction_id,
+	event_type,
+	aggregate_id,
+	payload,
+	created_at
+FROM ledger_outbox
+WHERE (transaction_id, event_id) > ($1::bigint, $2::bigint)
+	AND transaction_id < pg_snapshot_xmin(pg_current_snapshot())::text::bigint
+ORDER BY transaction_id, event_id
+LIMIT $3
+`
+
+type ListOutboxEventsParams struct {
+	TransactionID int64
+	EventID       int64
+	LimitSize     int32
 }
 
-func (q *Queries) GetAccountsBalancesWithChild(ctx context.Context, dollar_1 []string) ([]GetAccountsBalancesWithChildRow, error) {
-	rows, err := q.db.Query(ctx, getAccountsBalancesWithChild, dollar_1)
+// ListOutboxEvents lists the events after the position of (transaction_id, event_id). Only the events of the transactions older
+// than the oldest running transaction are listed, so the events of the running transactions are never skipped by the relay.
+func (q *Queries) ListOutboxEvents(ctx context.Context, arg ListOutboxEventsParams) ([]LedgerOutbox, error) {
+	rows, err := q.db.Query(ctx, listOutboxEvents, arg.TransactionID, arg.EventID, arg.LimitSize)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetAccountsBalancesWithChildRow
+	var items []LedgerOutbox
 	for rows.Next() {
-		var i GetAccountsBalancesWithChildRow
+		var i LedgerOutbox
 		if err := rows.Scan(
-			&i.AccountID,
-			&i.AllowNegative,
-			&i.TotalAccountBalance,
-			&i.MainAccountBalance,
-			&i.ChildAccountBalance,
-			&i.LastLedgerID,
-			&i.LastMovementID,
-			&i.CurrencyID,
+			&i.EventID,
+			&i.TransactionID,
+			&i.EventType,
+			&i.AggregateID,
+			&i.Payload,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -341,72 +2095,71 @@ func (q *Queries) GetAccountsBalancesWithChild(ctx context.Context, dollar_1 []s
 	return items, nil
 }
 
-const getAccountsBalancesWithChildForUpdate = `-- name: GetAccountsBalancesWithChildForUpdate :many
-WITH sum_main AS (
-    SELECT account_id,
-        allow_negative,
-        balance,
-        last_ledger_id,
-        last_movement_id,
-        currency_id,
-        created_at
-    FROM accounts_balance
-    WHERE account_id = ANY($1::varchar[])
-),
-child_accounts AS (
-    SELECT parent_account_id as account_id,
-        SUM(balance) as balance
-    FROM accounts_balance
-    WHERE parent_account_id = ANY($1::varchar[])
-    GROUP BY parent_account_id
-)
-SELECT
-    main_acc.account_id,
-    main_acc.allow_negative,
-    main_acc.balance + child_acc.balance total_account_balance,
-    main_acc.balance main_account_balance,
-    child_acc.balance child_account_balance,
-    main_acc.last_ledger_id,
-    main_acc.last_movement_id,
-    main_acc.currency_id,
-    main_acc.created_at
-FROM sum_main main_acc,
-    child_accounts child_acc
-WHERE main_acc.account_id = child_acc.account_id
-FOR UPDATE
+const listOutboxResumeTokens = `-- name: ListOutboxResumeTokens :many
+SELECT resume_token
+FROM ledger_outbox_offsets
 `
 
-type GetAccountsBalancesWithChildForUpdateRow struct {
-	AccountID           string
-	AllowNegative       bool
-	TotalAccountBalance decimal.Decimal
-	MainAccountBalance  decimal.Decimal
-	ChildAccountBalance decimal.Decimal
-	LastLedgerID        string
-	LastMovementID      string
-	CurrencyID          int32
-	CreatedAt           time.Time
+func (q *Queries) ListOutboxResumeTokens(ctx context.Context) ([]string, error) {
+	rows, err := q.db.Query(ctx, listOutboxResumeTokens)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var resume_token string
+		if err := rows.Scan(&resume_token); err != nil {
+			return nil, err
+		}
+		items = append(items, resume_token)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) GetAccountsBalancesWithChildForUpdate(ctx context.Context, dollar_1 []string) ([]GetAccountsBalancesWithChildForUpdateRow, error) {
-	rows, err := q.db.Query(ctx, getAccountsBalancesWithChildForUpdate, dollar_1)
+const listScheduledMovements = `-- name: ListScheduledMovements :many
+SELECT schedule_id, idempotency_key, schedule_status, recurrence, occurrence_at, next_run_at, end_at, run_count, attempts, last_error, last_movement_id, created_at, updated_at
+FROM scheduled_movements
+WHERE ($1::int = 0 OR schedule_status = $1::int)
+	AND schedule_id > $2::varchar
+ORDER BY schedule_id
+LIMIT $3::int
+`
+
+type ListScheduledMovementsParams struct {
+	ScheduleStatus  int32
+	AfterScheduleID string
+	LimitSize       int32
+}
+
+// ListScheduledMovements lists the scheduled movements ordered by the schedule_id. The schedule_id is an UUIDv7, so the scheduled
+// movements are ordered by their creation time. The zero schedule_status lists the scheduled movements of all statuses.
+func (q *Queries) ListScheduledMovements(ctx context.Context, arg ListScheduledMovementsParams) ([]ScheduledMovement, error) {
+	rows, err := q.db.Query(ctx, listScheduledMovements, arg.ScheduleStatus, arg.AfterScheduleID, arg.LimitSize)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetAccountsBalancesWithChildForUpdateRow
+	var items []ScheduledMovement
 	for rows.Next() {
-		var i GetAccountsBalancesWithChildForUpdateRow
+		var i ScheduledMovement
 		if err := rows.Scan(
-			&i.AccountID,
-			&i.AllowNegative,
-			&i.TotalAccountBalance,
-			&i.MainAccountBalance,
-			&i.ChildAccountBalance,
-			&i.LastLedgerID,
+			&i.ScheduleID,
+			&i.IdempotencyKey,
+			&i.ScheduleStatus,
+			&i.Recurrence,
+			&i.OccurrenceAt,
+			&i.NextRunAt,
+			&i.EndAt,
+			&i.RunCount,
+			&i.Attempts,
+			&i.LastError,
 			&i.LastMovementID,
-			&i.CurrencyID,
 			&i.CreatedAt,
+			&i.UpdatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -418,53 +2171,32 @@ func (q *Queries) GetAccountsBalancesWithChildForUpdate(ctx context.Context, dol
 	return items, nil
 }
 
-const getAccountsLedgerByMovementID = `-- name: GetAccountsLedgerByMovementID :many
-SELECT ledger_id,
-	movement_id,
-	movement_sequence,
-	account_id,
-	amount,
-	previous_ledger_id,
-	client_id,
-	created_at,
-	client_id
+const listUnbalancedMovements = `-- name: ListUnbalancedMovements :many
+SELECT movement_id,
+	currency_id,
+	SUM(amount)::numeric AS total_amount
 FROM accounts_ledger
-WHERE movement_id = $1
-ORDER BY created_at
+GROUP BY movement_id, currency_id
+HAVING SUM(amount) <> 0
+ORDER BY movement_id
 `
 
-type GetAccountsLedgerByMovementIDRow struct {
-	LedgerID         string
-	MovementID       string
-	MovementSequence int32
-	AccountID        string
-	Amount           decimal.Decimal
-	PreviousLedgerID string
-	ClientID         sql.NullString
-	CreatedAt        time.Time
-	ClientID_2       sql.NullString
+type ListUnbalancedMovementsRow struct {
+	MovementID  string
+	CurrencyID  int32
+	TotalAmount decimal.Decimal
 }
 
-func (q *Queries) GetAccountsLedgerByMovementID(ctx context.Context, movementID string) ([]GetAccountsLedgerByMovementIDRow, error) {
-	rows, err := q.db.Query(ctx, getAccountsLedgerByMovementID, movementID)
+func (q *Queries) ListUnbalancedMovements(ctx context.Context) ([]ListUnbalancedMovementsRow, error) {
+	rows, err := q.db.Query(ctx, listUnbalancedMovements)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetAccountsLedgerByMovementIDRow
+	var items []ListUnbalancedMovementsRow
 	for rows.Next() {
-		var i GetAccountsLedgerByMovementIDRow
-		if err := rows.Scan(
-			&i.LedgerID,
-			&i.MovementID,
-			&i.MovementSequence,
-			&i.AccountID,
-			&i.Amount,
-			&i.PreviousLedgerID,
-			&i.ClientID,
-			&i.CreatedAt,
-			&i.ClientID_2,
-		); err != nil {
+		var i ListUnbalancedMovementsRow
+		if err := rows.Scan(&i.MovementID, &i.CurrencyID, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -475,49 +2207,255 @@ func (q *Queries) GetAccountsLedgerByMovementID(ctx context.Context, movementID
 	return items, nil
 }
 
-const getMovement = `-- name: GetMovement :one
-SELECT movement_id, idempotency_key, created_at, updated_at, reversed_at, reversal_movement_id FROM movements
+const lockAccountsBalance = `-- name: LockAccountsBalance :many
+SELECT account_id
+FROM accounts_balance
+WHERE account_id = ANY($1::varchar[])
+ORDER BY account_id
+FOR UPDATE
+`
+
+// LockAccountsBalance locks the accounts balance in the order of the account_id, so the locks are always acquired in the same
+// order by the concurrent batch movements.
+func (q *Queries) LockAccountsBalance(ctx context.Context, dollar_1 []string) ([]string, error) {
+	rows, err := q.db.Query(ctx, lockAccountsBalance, dollar_1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var account_id string
+		if err := rows.Scan(&account_id); err != nil {
+			return nil, err
+		}
+		items = append(items, account_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markAccountsBalanceShard = `-- name: MarkAccountsBalanceShard :exec
+UPDATE accounts
+SET is_balance_shard = true
+WHERE account_id = ANY($1::varchar[])
+`
+
+func (q *Queries) MarkAccountsBalanceShard(ctx context.Context, dollar_1 []string) error {
+	_, err := q.db.Exec(ctx, markAccountsBalanceShard, dollar_1)
+	return err
+}
+
+const releaseAccountHold = `-- name: ReleaseAccountHold :exec
+UPDATE account_holds
+SET hold_status = 2,
+	released_at = $1,
+	updated_at = $1
+WHERE hold_id = $2
+`
+
+type ReleaseAccountHoldParams struct {
+	ReleasedAt sql.NullTime
+	HoldID     string
+}
+
+func (q *Queries) ReleaseAccountHold(ctx context.Context, arg ReleaseAccountHoldParams) error {
+	_, err := q.db.Exec(ctx, releaseAccountHold, arg.ReleasedAt, arg.HoldID)
+	return err
+}
+
+const setMovementReversed = `-- name: SetMovementReversed :exec
+UPDATE movements
+SET reversed_at = $2,
+	reversal_movement_id = $3,
+	updated_at = $4
 WHERE movement_id = $1
 `
 
-func (q *Queries) GetMovement(ctx context.Context, movementID string) (Movement, error) {
-	row := q.db.QueryRow(ctx, getMovement, movementID)
-	var i Movement
-	err := row.Scan(
-		&i.MovementID,
-		&i.IdempotencyKey,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.ReversedAt,
-		&i.ReversalMovementID,
+type SetMovementReversedParams struct {
+	MovementID         string
+	ReversedAt         sql.NullTime
+	ReversalMovementID sql.NullString
+	UpdatedAt          sql.NullTime
+}
+
+func (q *Queries) SetMovementReversed(ctx context.Context, arg SetMovementReversedParams) error {
+	_, err := q.db.Exec(ctx, setMovementReversed,
+		arg.MovementID,
+		arg.ReversedAt,
+		arg.ReversalMovementID,
+		arg.UpdatedAt,
 	)
-	return i, err
+	return err
 }
 
-const getMovementByIdempotencyKey = `-- name: GetMovementByIdempotencyKey :one
-SELECT movement_id,
-    idempotency_key,
-    created_at,
-    updated_at
-FROM movements
-WHERE idempotency_key = $1
+const setOutboxResumeToken = `-- name: SetOutboxResumeToken :exec
+INSERT INTO ledger_outbox_offsets(
+	relay_name,
+	resume_token,
+	updated_at
+) VALUES($1,$2,$3)
+ON CONFLICT (relay_name) DO UPDATE
+SET resume_token = EXCLUDED.resume_token,
+	updated_at = EXCLUDED.updated_at
 `
 
-type GetMovementByIdempotencyKeyRow struct {
-	MovementID     string
-	IdempotencyKey string
-	CreatedAt      time.Time
-	UpdatedAt      sql.NullTime
+type SetOutboxResumeTokenParams struct {
+	RelayName   string
+	ResumeToken string
+	UpdatedAt   time.Time
 }
 
-func (q *Queries) GetMovementByIdempotencyKey(ctx context.Context, idempotencyKey string) (GetMovementByIdempotencyKeyRow, error) {
-	row := q.db.QueryRow(ctx, getMovementByIdempotencyKey, idempotencyKey)
-	var i GetMovementByIdempotencyKeyRow
-	err := row.Scan(
-		&i.MovementID,
-		&i.IdempotencyKey,
-		&i.CreatedAt,
-		&i.UpdatedAt,
+func (q *Queries) SetOutboxResumeToken(ctx context.Context, arg SetOutboxResumeTokenParams) error {
+	_, err := q.db.Exec(ctx, setOutboxResumeToken, arg.RelayName, arg.ResumeToken, arg.UpdatedAt)
+	return err
+}
+
+const updateAccountBalanceShards = `-- name: UpdateAccountBalanceShards :exec
+UPDATE accounts
+SET balance_shards = $1,
+	updated_at = $2
+WHERE account_id = $3
+`
+
+type UpdateAccountBalanceShardsParams struct {
+	BalanceShards int32
+	UpdatedAt     sql.NullTime
+	AccountID     string
+}
+
+func (q *Queries) UpdateAccountBalanceShards(ctx context.Context, arg UpdateAccountBalanceShardsParams) error {
+	_, err := q.db.Exec(ctx, updateAccountBalanceShards, arg.BalanceShards, arg.UpdatedAt, arg.AccountID)
+	return err
+}
+
+const updateAccountHeldAmount = `-- name: UpdateAccountHeldAmount :exec
+UPDATE accounts_balance
+SET held_amount = held_amount + $1::numeric
+WHERE account_id = $2
+`
+
+type UpdateAccountHeldAmountParams struct {
+	Amount    decimal.Decimal
+	AccountID string
+}
+
+func (q *Queries) UpdateAccountHeldAmount(ctx context.Context, arg UpdateAccountHeldAmountParams) error {
+	_, err := q.db.Exec(ctx, updateAccountHeldAmount, arg.Amount, arg.AccountID)
+	return err
+}
+
+const updateAccountStatus = `-- name: UpdateAccountStatus :exec
+UPDATE accounts
+SET account_status = $1,
+	updated_at = $2
+WHERE account_id = $3
+`
+
+type UpdateAccountStatusParams struct {
+	AccountStatus int32
+	UpdatedAt     sql.NullTime
+	AccountID     string
+}
+
+func (q *Queries) UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) error {
+	_, err := q.db.Exec(ctx, updateAccountStatus, arg.AccountStatus, arg.UpdatedAt, arg.AccountID)
+	return err
+}
+
+const updatePendingMovementStatus = `-- name: UpdatePendingMovementStatus :exec
+UPDATE pending_movements
+SET pending_status = $1,
+	movement_id = $2,
+	updated_at = $3
+WHERE pending_movement_id = $4
+`
+
+type UpdatePendingMovementStatusParams struct {
+	PendingStatus     int32
+	MovementID        sql.NullString
+	UpdatedAt         sql.NullTime
+	PendingMovementID string
+}
+
+func (q *Queries) UpdatePendingMovementStatus(ctx context.Context, arg UpdatePendingMovementStatusParams) error {
+	_, err := q.db.Exec(ctx, updatePendingMovementStatus,
+		arg.PendingStatus,
+		arg.MovementID,
+		arg.UpdatedAt,
+		arg.PendingMovementID,
 	)
-	return i, err
+	return err
+}
+
+const updateScheduledMovementRun = `-- name: UpdateScheduledMovementRun :one
+UPDATE scheduled_movements
+SET schedule_status = $1,
+	occurrence_at = $2,
+	next_run_at = $3,
+	run_count = $4,
+	attempts = $5,
+	last_error = $6,
+	last_movement_id = $7,
+	updated_at = $8
+WHERE schedule_id = $9
+	AND schedule_status = 1
+	AND run_count = $10::int
+	AND attempts = $11::int
+RETURNING schedule_id
+`
+
+type UpdateScheduledMovementRunParams struct {
+	ScheduleStatus  int32
+	OccurrenceAt    time.Time
+	NextRunAt       time.Time
+	RunCount        int32
+	Attempts        int32
+	LastError       sql.NullString
+	LastMovementID  sql.NullString
+	UpdatedAt       sql.NullTime
+	ScheduleID      string
+	ClaimedRunCount int32
+	ClaimedAttempts int32
+}
+
+// UpdateScheduledMovementRun records the result of the claimed occurrence. The update is skipped when the scheduled movement is changed
+// after it is claimed, for example when it is cancelled or claimed by another scheduler after the claim expires.
+func (q *Queries) UpdateScheduledMovementRun(ctx context.Context, arg UpdateScheduledMovementRunParams) (string, error) {
+	row := q.db.QueryRow(ctx, updateScheduledMovementRun,
+		arg.ScheduleStatus,
+		arg.OccurrenceAt,
+		arg.NextRunAt,
+		arg.RunCount,
+		arg.Attempts,
+		arg.LastError,
+		arg.LastMovementID,
+		arg.UpdatedAt,
+		arg.ScheduleID,
+		arg.ClaimedRunCount,
+		arg.ClaimedAttempts,
+	)
+	var schedule_id string
+	err := row.Scan(&schedule_id)
+	return schedule_id, err
+}
+
+const updateScheduledMovementStatus = `-- name: UpdateScheduledMovementStatus :exec
+UPDATE scheduled_movements
+SET schedule_status = $1,
+	updated_at = $2
+WHERE schedule_id = $3
+`
+
+type UpdateScheduledMovementStatusParams struct {
+	ScheduleStatus int32
+	UpdatedAt      sql.NullTime
+	ScheduleID     string
+}
+
+func (q *Queries) UpdateScheduledMovementStatus(ctx context.Context, arg UpdateScheduledMovementStatusParams) error {
+	_, err := q.db.Exec(ctx, updateScheduledMovementStatus, arg.ScheduleStatus, arg.UpdatedAt, arg.ScheduleID)
+	return err
 }
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/studio-asd/pkg/postgres"
+
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+// ScheduleMovementParams is the parameters to create a scheduled movement. The entries are posted as is on each occurrence, so
+// the amount of the entries is normalized when the occurrence is posted.
+type ScheduleMovementParams struct {
+	ScheduleID     string
+	IdempotencyKey string
+	Recurrence     sql.NullString
+	OccurrenceAt   time.Time
+	EndAt          sql.NullTime
+	CreatedAt      time.Time
+	Entries        []ScheduledMovementEntry
+}
+
+// ScheduleMovement creates the scheduled movement and its entries.
+func (q *Queries) ScheduleMovement(ctx context.Context, params ScheduleMovementParams) error {
+	scheduledMovementEntriesColumns := []string{
+		"schedule_id",
+		"movement_sequence",
+		"from_account_id",
+		"to_account_id",
+		"amount",
+		"client_id",
+		"created_at",
+	}
+
+	fn := func(ctx context.Context, q *Queries) error {
+		if err := q.CreateScheduledMovement(ctx, CreateScheduledMovementParams{
+			ScheduleID:     params.ScheduleID,
+			IdempotencyKey: params.IdempotencyKey,
+			ScheduleStatus: ledger.ScheduledMovementStatusActive,
+			Recurrence:     params.Recurrence,
+			OccurrenceAt:   params.OccurrenceAt,
+			NextRunAt:      params.OccurrenceAt,
+			EndAt:          params.EndAt,
+			CreatedAt:      params.CreatedAt,
+		}); err != nil {
+			return fmt.Errorf("failed to create scheduled movement: %w", err)
+		}
+		bulkInsertEntriesParams := make([]any, len(scheduledMovementEntriesColumns)*len(params.Entries))
+		for idx, entry := range params.Entries {
+			offset := idx * len(scheduledMovementEntriesColumns)
+			bulkInsertEntriesParams[offset] = params.ScheduleID
+			bulkInsertEntriesParams[offset+1] = entry.MovementSequence
+			bulkInsertEntriesParams[offset+2] = entry.FromAccountID
+			bulkInsertEntriesParams[offset+3] = entry.ToAccountID
+			bulkInsertEntriesParams[offset+4] = entry.Amount.String()
+			bulkInsertEntriesParams[offset+5] = entry.ClientID
+			bulkInsertEntriesParams[offset+6] = params.CreatedAt
+		}
+		if err := q.db.BulkInsert(
+			ctx,
+			"scheduled_movement_entries",
+			scheduledMovementEntriesColumns,
+			bulkInsertEntriesParams,
+			"",
+		); err != nil {
+			return fmt.Errorf("failed to insert scheduled movement entries: %w", err)
+		}
+		return nil
+	}
+	return q.WithMetrics(ctx, "scheduleMovement", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelReadCommitted, fn)
+	})
+}
+
+// CancelScheduledMovement cancels the remaining occurrences of the active scheduled movement. The occurrence that is already being
+// posted by the scheduler is not cancelled, but the scheduler won't post the next occurrences.
+func (q *Queries) CancelScheduledMovement(ctx context.Context, scheduleID string, cancelledAt time.Time) (ScheduledMovement, error) {
+	var schedule ScheduledMovement
+	fn := func(ctx context.Context, q *Queries) error {
+		var err error
+		schedule, err = q.GetScheduledMovementForUpdate(ctx, scheduleID)
+		if err != nil {
+			if errors.Is(err, postgres.ErrNoRows) {
+				return ledger.ErrScheduledMovementNotFound
+			}
+			return err
+		}
+		if schedule.ScheduleStatus != ledger.ScheduledMovementStatusActive {
+			return ledger.ErrScheduledMovementNotActive
+		}
+		schedule.ScheduleStatus = ledger.ScheduledMovementStatusCancelled
+		schedule.UpdatedAt = sql.NullTime{Time: cancelledAt, Valid: true}
+		return q.UpdateScheduledMovementStatus(ctx, UpdateScheduledMovementStatusParams{
+			ScheduleStatus: schedule.ScheduleStatus,
+			UpdatedAt:      schedule.UpdatedAt,
+			ScheduleID:     scheduleID,
+		})
+	}
+	err := q.WithMetrics(ctx, "cancelScheduledMovement", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelReadCommitted, fn)
+	})
+	return schedule, err
+}
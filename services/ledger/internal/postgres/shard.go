@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/studio-asd/pkg/postgres"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+type CreateBalanceShardsParams struct {
+	AccountID string
+	// Shards is the total number of the balance shards of the account after the shards are created.
+	Shards    int32
+	CreatedAt time.Time
+}
+
+// CreateBalanceShards creates the balance shards of the account until the account have the number of shards in the params. The
+// balance shards are created as the sub-accounts of the account, so each shard have its own balance and ledger chain. The number
+// of the shards can only be increased, as the existing shards might still have balance recorded in them. The shards of the account
+// that cannot have negative balance are credit-only, see selectAccountsBalanceForMovement.
+func (q *Queries) CreateBalanceShards(ctx context.Context, params CreateBalanceShardsParams) ([]string, error) {
+	var shardAccountIDs []string
+	fn := func(ctx context.Context, q *Queries) error {
+		account, err := q.GetAccountForBalanceShards(ctx, params.AccountID)
+		if err != nil {
+			if errors.Is(err, postgres.ErrNoRows) {
+				return fmt.Errorf("%w: %s", ledger.ErrAccountNotFound, params.AccountID)
+			}
+			return err
+		}
+		// The balance shards are the sub-accounts of the account, and we only allow one level of nesting.
+		if account.ParentAccountID.Valid {
+			return ledger.ErrBalanceShardsNotAllowed
+		}
+		if params.Shards <= account.BalanceShards {
+			return fmt.Errorf("%w: account already have %d shards", ledger.ErrInvalidBalanceShards, account.BalanceShards)
+		}
+		curr, err := currency.Currencies.GetByID(account.CurrencyID)
+		if err != nil {
+			return err
+		}
+
+		for index := account.BalanceShards; index < params.Shards; index++ {
+			shardAccountID := ledger.BalanceShardAccountID(account.AccountID, index)
+			if err := q.CreateLedgerAccount(ctx, CreateLedgerAccount{
+				AccountID:       shardAccountID,
+				Name:            account.Name + " shard " + strconv.Itoa(int(index)),
+				Description:     account.Description,
+				ParentAccountID: account.AccountID,
+				// The balance of the shard can be negative when the account can have negative balance, as the balance of the account is the
+				// total of all shards. Otherwise the shard is credit-only and its balance never goes below zero.
+				AllowNegative: account.AllowNegative,
+				Currency:      curr,
+				CreatedAt:     params.CreatedAt,
+			}); err != nil {
+				return err
+			}
+			shardAccountIDs = append(shardAccountIDs, shardAccountID)
+		}
+		if err := q.MarkAccountsBalanceShard(ctx, shardAccountIDs); err != nil {
+			return err
+		}
+		return q.UpdateAccountBalanceShards(ctx, UpdateAccountBalanceShardsParams{
+			BalanceShards: params.Shards,
+			UpdatedAt:     sql.NullTime{Time: params.CreatedAt, Valid: true},
+			AccountID:     account.AccountID,
+		})
+	}
+	err := q.WithMetrics(ctx, "createBalanceShards", func(ctx context.Context, q *Queries) error {
+		return q.ensureInTransact(ctx, sql.LevelReadCommitted, fn)
+	})
+	return shardAccountIDs, err
+}
@@ -0,0 +1,55 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+)
+
+// The SQLSTATE codes of the errors caused by the concurrent transactions, the transaction can be retried for these errors.
+const (
+	sqlStateLockNotAvailable     = "55P03"
+	sqlStateSerializationFailure = "40001"
+	sqlStateDeadlockDetected     = "40P01"
+)
+
+// SetLocalTimeouts sets the lock_timeout and the statement_timeout of the current transaction, the timeouts are reset when the transaction
+// ends. The zero timeout keeps the timeout of the session.
+func (q *Queries) SetLocalTimeouts(ctx context.Context, lockTimeout, statementTimeout time.Duration) error {
+	if lockTimeout > 0 {
+		if _, err := q.db.Exec(ctx, "SELECT set_config('lock_timeout', $1, true)", strconv.FormatInt(lockTimeout.Milliseconds(), 10)); err != nil {
+			return err
+		}
+	}
+	if statementTimeout > 0 {
+		if _, err := q.db.Exec(ctx, "SELECT set_config('statement_timeout', $1, true)", strconv.FormatInt(statementTimeout.Milliseconds(), 10)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsLockTimeout returns true if the error is caused by the lock_timeout of the transaction.
+func IsLockTimeout(err error) bool {
+	return sqlState(err) == sqlStateLockNotAvailable
+}
+
+// IsRetryable returns true if the transaction is rolled back because of the lock timeout, serialization failure or deadlock. The
+// transaction can be safely retried for these errors.
+func IsRetryable(err error) bool {
+	switch sqlState(err) {
+	case sqlStateLockNotAvailable, sqlStateSerializationFailure, sqlStateDeadlockDetected:
+		return true
+	}
+	return false
+}
+
+// sqlState returns the SQLSTATE code of the error. Both pgx and lib/pq errors expose the code via the SQLState method.
+func sqlState(err error) string {
+	var stateErr interface{ SQLState() string }
+	if errors.As(err, &stateErr) {
+		return stateErr.SQLState()
+	}
+	return ""
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type sqlStateError string
+
+func (s sqlStateError) Error() string    { return "sql error " + string(s) }
+func (s sqlStateError) SQLState() string { return string(s) }
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		err         error
+		retryable   bool
+		lockTimeout bool
+	}{
+		{
+			name:        "lock timeout",
+			err:         fmt.Errorf("wrapped: %w", sqlStateError(sqlStateLockNotAvailable)),
+			retryable:   true,
+			lockTimeout: true,
+		},
+		{
+			name:      "serialization failure",
+			err:       sqlStateError(sqlStateSerializationFailure),
+			retryable: true,
+		},
+		{
+			name:      "deadlock",
+			err:       sqlStateError(sqlStateDeadlockDetected),
+			retryable: true,
+		},
+		{
+			name: "unique violation",
+			err:  sqlStateError("23505"),
+		},
+		{
+			name: "other error",
+			err:  errors.New("other"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if IsRetryable(test.err) != test.retryable {
+				t.Fatalf("expecting retryable %t", test.retryable)
+			}
+			if IsLockTimeout(test.err) != test.lockTimeout {
+				t.Fatalf("expecting lock timeout %t", test.lockTimeout)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/shopspring/decimal"
+
+	"github.com/studio-asd/go-example/internal/currency"
 )
 
 type MovementInfo struct {
@@ -15,12 +17,14 @@ type LedgerEntry struct {
 	MovementID       string
 	AccountID        string
 	MovementSequence int
-	CurrencyID       int32
-	Amount           decimal.Decimal
+	// Amount is the amount of the entry in the currency of the account. The amount is negative for the DEBIT entry.
+	Amount           currency.Money
 	PreviousLedgerID string
 	ClientID         string
-	CreatedAt        time.Time
-	Timestamp        int64
+	// ReversalOf is the ledger_id being reversed by this entry. The field is empty if the entry is not a reversal.
+	ReversalOf string
+	CreatedAt  time.Time
+	Timestamp  int64
 }
 
 type MovementSummary struct {
@@ -40,7 +44,7 @@ type MovementSummary struct {
 type AccountMovementSummary struct {
 	// BalanceChanges is the total of balance change of an account. The amount of changes can be either positive or negative depends
 	// on the sum of the changes.
-	BalanceChanges decimal.Decimal
+	BalanceChanges currency.Money
 	// NextLedgerID is the next ledger id for the account balance to be recorded. The next ledger id will be set
 	// as the last ledger id inside the database.
 	NextLedgerID string
@@ -50,7 +54,7 @@ type AccountMovementSummary struct {
 	LastLedgerID string
 	// EndingBalance is the ending balance of an account, calculated from the latest balance of 'latest_ledger_id'. This balance
 	// should not be used when the 'last_ledger_id' != last ledger id when retrieving the balance in a lock.
-	EndingBalance decimal.Decimal
+	EndingBalance currency.Money
 }
 
 // MovementLedgerEntries is the entries of ledger and balances for a single movement. In a single movement, it is possible to
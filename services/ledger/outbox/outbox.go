@@ -0,0 +1,57 @@
+// Package outbox publishes the events of the ledger written to the ledger_outbox table. The events are written in the same database
+// transaction with the movements and the accounts, and published by the relay to a sink with at-least-once delivery.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+)
+
+var ErrInvalidResumeToken = errors.New("invalid resume token")
+
+// Sink receives the events published by the relay. The events are published in the order of the outbox, and the same events are
+// published again when Publish returns an error, so the sink must be able to receive the same events more than once.
+type Sink interface {
+	Publish(ctx context.Context, events []*ledgerv1.Event) error
+}
+
+// ResumeToken is the position of an event in the outbox. The position is ordered by the database transaction id first, so an
+// event committed later is always positioned after the events already published.
+type ResumeToken struct {
+	TransactionID int64
+	EventID       int64
+}
+
+// String returns the resume token in the format of transaction_id:event_id.
+func (r ResumeToken) String() string {
+	return strconv.FormatInt(r.TransactionID, 10) + ":" + strconv.FormatInt(r.EventID, 10)
+}
+
+// ParseResumeToken parses the resume token returned by ResumeToken.String. An empty token is the beginning of the outbox.
+func ParseResumeToken(token string) (ResumeToken, error) {
+	if token == "" {
+		return ResumeToken{}, nil
+	}
+	transactionID, eventID, ok := strings.Cut(token, ":")
+	if !ok {
+		return ResumeToken{}, fmt.Errorf("%w: %s", ErrInvalidResumeToken, token)
+	}
+	var (
+		r   ResumeToken
+		err error
+	)
+	r.TransactionID, err = strconv.ParseInt(transactionID, 10, 64)
+	if err != nil {
+		return ResumeToken{}, fmt.Errorf("%w: %s", ErrInvalidResumeToken, token)
+	}
+	r.EventID, err = strconv.ParseInt(eventID, 10, 64)
+	if err != nil {
+		return ResumeToken{}, fmt.Errorf("%w: %s", ErrInvalidResumeToken, token)
+	}
+	return r, nil
+}
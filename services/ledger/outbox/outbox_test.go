@@ -0,0 +1,163 @@
+package outbox
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+)
+
+func TestParseResumeToken(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		token  string
+		expect ResumeToken
+		err    error
+	}{
+		{
+			name: "empty token",
+		},
+		{
+			name:   "valid token",
+			token:  "1024:20",
+			expect: ResumeToken{TransactionID: 1024, EventID: 20},
+		},
+		{
+			name:  "without separator",
+			token: "1024",
+			err:   ErrInvalidResumeToken,
+		},
+		{
+			name:  "invalid event id",
+			token: "1024:abc",
+			err:   ErrInvalidResumeToken,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := ParseResumeToken(test.token)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("expecting error %v but got %v", test.err, err)
+			}
+			if token != test.expect {
+				t.Fatalf("expecting token %v but got %v", test.expect, token)
+			}
+			if test.err == nil && test.token != "" && token.String() != test.token {
+				t.Fatalf("expecting token string %s but got %s", test.token, token.String())
+			}
+		})
+	}
+}
+
+type testPublisher struct {
+	subjects []string
+	data     [][]byte
+}
+
+func (p *testPublisher) Publish(subject string, data []byte) error {
+	p.subjects = append(p.subjects, subject)
+	p.data = append(p.data, data)
+	return nil
+}
+
+func testEvents() []*ledgerv1.Event {
+	return []*ledgerv1.Event{
+		{
+			EventId:     "1",
+			ResumeToken: "10:1",
+			Payload: &ledgerv1.Event_AccountCreated{
+				AccountCreated: &ledgerv1.AccountCreated{AccountId: "account"},
+			},
+		},
+		{
+			EventId:     "2",
+			ResumeToken: "11:2",
+			Payload: &ledgerv1.Event_MovementCreated{
+				MovementCreated: &ledgerv1.MovementCreated{MovementId: "movement"},
+			},
+		},
+	}
+}
+
+func TestSinks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("memory", func(t *testing.T) {
+		t.Parallel()
+
+		sink := NewMemorySink()
+		if err := sink.Publish(context.Background(), testEvents()); err != nil {
+			t.Fatal(err)
+		}
+		if len(sink.Events()) != 2 {
+			t.Fatalf("expecting 2 events but got %d", len(sink.Events()))
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "events.jsonl")
+		sink, err := NewFileSink(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer sink.Close()
+		if err := sink.Publish(context.Background(), testEvents()); err != nil {
+			t.Fatal(err)
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		var events []*ledgerv1.Event
+		scanner := bufio.NewScanner(f)
+		for scanner.Scan() {
+			event := &ledgerv1.Event{}
+			if err := protojson.Unmarshal(scanner.Bytes(), event); err != nil {
+				t.Fatal(err)
+			}
+			events = append(events, event)
+		}
+		if len(events) != 2 || !proto.Equal(events[1], testEvents()[1]) {
+			t.Fatalf("unexpected events in the file: %v", events)
+		}
+	})
+
+	t.Run("publisher", func(t *testing.T) {
+		t.Parallel()
+
+		publisher := &testPublisher{}
+		sink := NewPublisherSink(publisher, "ledger")
+		if err := sink.Publish(context.Background(), testEvents()); err != nil {
+			t.Fatal(err)
+		}
+		expectSubjects := []string{"ledger.account_created", "ledger.movement_created"}
+		for idx, subject := range publisher.subjects {
+			if subject != expectSubjects[idx] {
+				t.Fatalf("expecting subject %s but got %s", expectSubjects[idx], subject)
+			}
+		}
+		event := &ledgerv1.Event{}
+		if err := proto.Unmarshal(publisher.data[0], event); err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(event, testEvents()[0]) {
+			t.Fatalf("unexpected event %v", event)
+		}
+	})
+}
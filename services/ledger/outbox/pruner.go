@@ -0,0 +1,161 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/studio-asd/pkg/postgres"
+	"github.com/studio-asd/pkg/srun"
+
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+var _ srun.ServiceRunnerAware = (*Pruner)(nil)
+
+const (
+	// defaultPruneBatchSize is the number of events deleted at once, so the prune doesn't hold the locks of a big delete.
+	defaultPruneBatchSize = 1000
+	// defaultRetention is the minimum duration of the events kept in the outbox.
+	defaultRetention = 24 * time.Hour
+)
+
+type PrunerConfig struct {
+	// Interval is the interval to prune the outbox.
+	Interval time.Duration
+	// Retention is the minimum duration of the events kept in the outbox, so the consumers reading the outbox directly like the
+	// account balance watcher still have the recent events. The default retention is 24 hours.
+	Retention time.Duration
+	// BatchSize is the maximum number of events deleted at once. The default batch size is 1000.
+	BatchSize int32
+}
+
+// Pruner deletes the events in the outbox which are already published by all relays and older than the retention.
+//
+// The events are only deleted up to the minimum resume token stored by the relays, so a relay that is stopped for a while
+// still publishes all of its events. The resume tokens of the relays which are no longer used must be removed from the
+// ledger_outbox_offsets table, otherwise the events are kept forever. When there are no relays, the events are deleted once
+// they are older than the retention. A new relay without stored resume token starts from the oldest event still in the outbox.
+type Pruner struct {
+	queries *ledgerpg.Queries
+	config  PrunerConfig
+	logger  *slog.Logger
+	stopC   chan struct{}
+}
+
+func NewPruner(pg *postgres.Postgres, config PrunerConfig) *Pruner {
+	if config.Retention <= 0 {
+		config.Retention = defaultRetention
+	}
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultPruneBatchSize
+	}
+	return &Pruner{
+		queries: ledgerpg.New(pg),
+		config:  config,
+		stopC:   make(chan struct{}),
+	}
+}
+
+func (p *Pruner) Name() string {
+	return "ledger_outbox_pruner"
+}
+
+func (p *Pruner) Init(ctx srun.Context) error {
+	p.logger = ctx.Logger
+	return nil
+}
+
+func (p *Pruner) Run(ctx context.Context) error {
+	ticker := time.NewTicker(p.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-p.stopC:
+			return nil
+		case <-ticker.C:
+			pruned, err := p.Prune(ctx)
+			if err != nil {
+				p.logger.ErrorContext(ctx, "Failed to prune outbox events", "error", err)
+				continue
+			}
+			if pruned > 0 {
+				p.logger.InfoContext(ctx, "Outbox events pruned", "pruned", pruned)
+			}
+		}
+	}
+}
+
+func (p *Pruner) Ready(ctx context.Context) error {
+	return nil
+}
+
+func (p *Pruner) Stop(ctx context.Context) error {
+	close(p.stopC)
+	return nil
+}
+
+// Prune deletes the published events older than the retention and returns the number of the deleted events.
+func (p *Pruner) Prune(ctx context.Context) (int, error) {
+	token, ok, err := p.pruneToken(ctx)
+	if err != nil || !ok {
+		return 0, err
+	}
+
+	createdBefore := time.Now().Add(-p.config.Retention)
+	var pruned int
+	for {
+		deleted, err := p.queries.DeleteOutboxEvents(ctx, ledgerpg.DeleteOutboxEventsParams{
+			TransactionID: token.TransactionID,
+			EventID:       token.EventID,
+			CreatedBefore: createdBefore,
+			LimitSize:     p.config.BatchSize,
+		})
+		if err != nil {
+			return pruned, err
+		}
+		pruned += len(deleted)
+		if len(deleted) < int(p.config.BatchSize) {
+			return pruned, nil
+		}
+	}
+}
+
+// pruneToken returns the position of the last event that can be deleted, which is the minimum resume token stored by the relays.
+// The latest position of the outbox is used when there are no relays. It returns false when there are no events to delete.
+func (p *Pruner) pruneToken(ctx context.Context) (ResumeToken, bool, error) {
+	stored, err := p.queries.ListOutboxResumeTokens(ctx)
+	if err != nil {
+		return ResumeToken{}, false, err
+	}
+	if len(stored) == 0 {
+		latest, err := p.queries.GetOutboxLatestPosition(ctx)
+		if err != nil {
+			if errors.Is(err, postgres.ErrNoRows) {
+				return ResumeToken{}, false, nil
+			}
+			return ResumeToken{}, false, err
+		}
+		return ResumeToken{TransactionID: latest.TransactionID, EventID: latest.EventID}, true, nil
+	}
+
+	var minToken ResumeToken
+	for idx, s := range stored {
+		token, err := ParseResumeToken(s)
+		if err != nil {
+			return ResumeToken{}, false, err
+		}
+		if idx == 0 || token.TransactionID < minToken.TransactionID || (token.TransactionID == minToken.TransactionID && token.EventID < minToken.EventID) {
+			minToken = token
+		}
+	}
+	// The empty token is the beginning of the outbox, so none of the events are published yet by the relay.
+	if minToken == (ResumeToken{}) {
+		return ResumeToken{}, false, nil
+	}
+	return minToken, true, nil
+}
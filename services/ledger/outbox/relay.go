@@ -0,0 +1,170 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/studio-asd/pkg/postgres"
+	"github.com/studio-asd/pkg/srun"
+	"google.golang.org/protobuf/proto"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+var _ srun.ServiceRunnerAware = (*Relay)(nil)
+
+// defaultBatchSize is the number of events published to the sink at once.
+const defaultBatchSize = 100
+
+type Config struct {
+	// Name is the name of the relay. Each relay stores its own resume token, so multiple relays can publish the same events to
+	// different sinks.
+	Name string
+	Sink Sink
+	// Interval is the interval to check the new events in the outbox.
+	Interval time.Duration
+	// BatchSize is the maximum number of events published to the sink at once. The default batch size is 100.
+	BatchSize int32
+	// ResumeToken is the position to start when the relay doesn't have any resume token stored yet. The relay starts from the
+	// beginning of the outbox if the token is empty.
+	ResumeToken string
+}
+
+// Relay publishes the events of the outbox to the sink. The resume token of the last published event is stored after the events are
+// published, so the events are published again if the relay is stopped before the token is stored.
+type Relay struct {
+	queries *ledgerpg.Queries
+	config  Config
+	logger  *slog.Logger
+	stopC   chan struct{}
+
+	token       ResumeToken
+	tokenLoaded bool
+}
+
+func NewRelay(pg *postgres.Postgres, config Config) *Relay {
+	if config.BatchSize <= 0 {
+		config.BatchSize = defaultBatchSize
+	}
+	return &Relay{
+		queries: ledgerpg.New(pg),
+		config:  config,
+		stopC:   make(chan struct{}),
+	}
+}
+
+func (r *Relay) Name() string {
+	return "ledger_outbox_relay_" + r.config.Name
+}
+
+func (r *Relay) Init(ctx srun.Context) error {
+	r.logger = ctx.Logger
+	return nil
+}
+
+func (r *Relay) Run(ctx context.Context) error {
+	ticker := time.NewTicker(r.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.stopC:
+			return nil
+		case <-ticker.C:
+			published, err := r.Publish(ctx)
+			if err != nil {
+				r.logger.ErrorContext(ctx, "Failed to publish outbox events", "relay", r.config.Name, "error", err)
+				continue
+			}
+			if published > 0 {
+				r.logger.InfoContext(ctx, "Outbox events published", "relay", r.config.Name, "published", published)
+			}
+		}
+	}
+}
+
+func (r *Relay) Ready(ctx context.Context) error {
+	return nil
+}
+
+func (r *Relay) Stop(ctx context.Context) error {
+	close(r.stopC)
+	return nil
+}
+
+// Publish publishes all available events in the outbox to the sink and returns the number of the published events.
+func (r *Relay) Publish(ctx context.Context) (int, error) {
+	if !r.tokenLoaded {
+		if err := r.loadResumeToken(ctx); err != nil {
+			return 0, err
+		}
+	}
+
+	var published int
+	for {
+		rows, err := r.queries.ListOutboxEvents(ctx, ledgerpg.ListOutboxEventsParams{
+			TransactionID: r.token.TransactionID,
+			EventID:       r.token.EventID,
+			LimitSize:     r.config.BatchSize,
+		})
+		if err != nil {
+			return published, err
+		}
+		if len(rows) == 0 {
+			return published, nil
+		}
+
+		events := make([]*ledgerv1.Event, len(rows))
+		for idx, row := range rows {
+			event := &ledgerv1.Event{}
+			if err := proto.Unmarshal(row.Payload, event); err != nil {
+				return published, err
+			}
+			event.EventId = strconv.FormatInt(row.EventID, 10)
+			event.ResumeToken = ResumeToken{TransactionID: row.TransactionID, EventID: row.EventID}.String()
+			events[idx] = event
+		}
+		if err := r.config.Sink.Publish(ctx, events); err != nil {
+			return published, err
+		}
+		last := rows[len(rows)-1]
+		token := ResumeToken{TransactionID: last.TransactionID, EventID: last.EventID}
+		if err := r.queries.SetOutboxResumeToken(ctx, ledgerpg.SetOutboxResumeTokenParams{
+			RelayName:   r.config.Name,
+			ResumeToken: token.String(),
+			UpdatedAt:   time.Now(),
+		}); err != nil {
+			return published, err
+		}
+		r.token = token
+		published += len(rows)
+		if len(rows) < int(r.config.BatchSize) {
+			return published, nil
+		}
+	}
+}
+
+// loadResumeToken loads the stored resume token of the relay, the resume token in the config is used if the relay doesn't have
+// any resume token stored yet.
+func (r *Relay) loadResumeToken(ctx context.Context) error {
+	stored, err := r.queries.GetOutboxResumeToken(ctx, r.config.Name)
+	if err != nil {
+		if !errors.Is(err, postgres.ErrNoRows) {
+			return err
+		}
+		stored = r.config.ResumeToken
+	}
+	token, err := ParseResumeToken(stored)
+	if err != nil {
+		return err
+	}
+	r.token = token
+	r.tokenLoaded = true
+	return nil
+}
@@ -0,0 +1,127 @@
+package outbox
+
+import (
+	"context"
+	"os"
+	"slices"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+)
+
+var (
+	_ Sink = (*MemorySink)(nil)
+	_ Sink = (*FileSink)(nil)
+	_ Sink = (*PublisherSink)(nil)
+)
+
+// MemorySink stores the published events in memory. The sink is useful for testing and for consumers inside the same process.
+type MemorySink struct {
+	mu     sync.Mutex
+	events []*ledgerv1.Event
+}
+
+func NewMemorySink() *MemorySink {
+	return &MemorySink{}
+}
+
+func (m *MemorySink) Publish(ctx context.Context, events []*ledgerv1.Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.events = append(m.events, events...)
+	return nil
+}
+
+// Events returns all events published to the sink.
+func (m *MemorySink) Events() []*ledgerv1.Event {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return slices.Clone(m.events)
+}
+
+// FileSink appends the published events to a file as JSON lines. The file is synced after every publish, so the events are
+// durable once Publish returns.
+type FileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{file: file}, nil
+}
+
+func (f *FileSink) Publish(ctx context.Context, events []*ledgerv1.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var buf []byte
+	for _, event := range events {
+		out, err := protojson.Marshal(event)
+		if err != nil {
+			return err
+		}
+		buf = append(buf, out...)
+		buf = append(buf, '\n')
+	}
+	if _, err := f.file.Write(buf); err != nil {
+		return err
+	}
+	return f.file.Sync()
+}
+
+func (f *FileSink) Close() error {
+	return f.file.Close()
+}
+
+// Publisher publishes a message to a subject. The interface is compatible with the Publish method of the NATS connection, so the
+// connection can be used as the publisher directly.
+type Publisher interface {
+	Publish(subject string, data []byte) error
+}
+
+// PublisherSink publishes each event as a protobuf encoded message to the subject of prefix.event_type, for example
+// ledger.movement_created.
+type PublisherSink struct {
+	publisher Publisher
+	prefix    string
+}
+
+func NewPublisherSink(publisher Publisher, prefix string) *PublisherSink {
+	return &PublisherSink{
+		publisher: publisher,
+		prefix:    prefix,
+	}
+}
+
+func (p *PublisherSink) Publish(ctx context.Context, events []*ledgerv1.Event) error {
+	for _, event := range events {
+		data, err := proto.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if err := p.publisher.Publish(p.prefix+"."+eventType(event), data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eventType returns the type of the event based on the payload of the event.
+func eventType(event *ledgerv1.Event) string {
+	switch event.GetPayload().(type) {
+	case *ledgerv1.Event_MovementCreated:
+		return ledger.EventTypeMovementCreated
+	case *ledgerv1.Event_AccountCreated:
+		return ledger.EventTypeAccountCreated
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,15 @@
+package ledger
+
+// Pending movement statuses, the status is persisted in the pending_movements.pending_status column and shares the same
+// value with the ledgerv1.PendingMovementStatus enum.
+const (
+	// PendingMovementStatusPending reserves the funds of the source accounts until the pending movement is captured, voided
+	// or expired.
+	PendingMovementStatusPending int32 = 1
+	// PendingMovementStatusCaptured means the pending movement is posted as a movement.
+	PendingMovementStatusCaptured int32 = 2
+	// PendingMovementStatusVoided means the reservation is released without posting the movement.
+	PendingMovementStatusVoided int32 = 3
+	// PendingMovementStatusExpired means the reservation is released because the pending movement is not captured in time.
+	PendingMovementStatusExpired int32 = 4
+)
@@ -0,0 +1,14 @@
+package ledger
+
+// Scheduled movement statuses, the status is persisted in the scheduled_movements.schedule_status column and shares the same
+// value with the ledgerv1.ScheduledMovementStatus enum.
+const (
+	// ScheduledMovementStatusActive means the next occurrence of the scheduled movement is posted at its next run time.
+	ScheduledMovementStatusActive int32 = 1
+	// ScheduledMovementStatusCompleted means all occurrences of the scheduled movement are posted.
+	ScheduledMovementStatusCompleted int32 = 2
+	// ScheduledMovementStatusCancelled means the remaining occurrences of the scheduled movement are cancelled.
+	ScheduledMovementStatusCancelled int32 = 3
+	// ScheduledMovementStatusFailed means the one-off scheduled movement still fails to be posted after all attempts.
+	ScheduledMovementStatusFailed int32 = 4
+)
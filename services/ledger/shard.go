@@ -0,0 +1,17 @@
+package ledger
+
+import (
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// MaxBalanceShards is the maximum number of the balance shards of an account.
+const MaxBalanceShards = 64
+
+// BalanceShardAccountID returns the account id of the balance shard of the account. The balance shard is a sub-account of the
+// sharded account, and the movements of the sharded account are recorded to one of its balance shards. The account id is a
+// UUIDV5 with namespace_oid and format of: account_id:balance_shard:index.
+func BalanceShardAccountID(accountID string, index int32) string {
+	return uuid.NewSHA1(uuid.NameSpaceOID, []byte(accountID+":balance_shard:"+strconv.Itoa(int(index)))).String()
+}
@@ -0,0 +1,157 @@
+package verifier
+
+import (
+	"github.com/shopspring/decimal"
+
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+// verifyAccountChain walks the previous_ledger_id chain of an account from the first ledger entry(with empty previous_ledger_id)
+// and checks whether all ledger entries are reachable from the chain. The end of the chain must be the last_ledger_id of the account
+// and the sum of the ledger amount must be the same with the account balance.
+func verifyAccountChain(account accountState, ledgers []ledgerpg.AccountsLedger) []Discrepancy {
+	var discrepancies []Discrepancy
+
+	// nextLedgers maps the previous_ledger_id to the ledger entries pointing to it. In a healthy chain, there is only
+	// one ledger entry for each previous_ledger_id.
+	nextLedgers := make(map[string][]ledgerpg.AccountsLedger, len(ledgers))
+	sum := decimal.Zero
+	for _, l := range ledgers {
+		nextLedgers[l.PreviousLedgerID] = append(nextLedgers[l.PreviousLedgerID], l)
+		sum = sum.Add(l.Amount)
+	}
+
+	visited := make(map[string]struct{}, len(ledgers))
+	lastLedgerID := ""
+	for {
+		next, ok := nextLedgers[lastLedgerID]
+		if !ok {
+			break
+		}
+		// Only the first ledger entry is followed when the chain is forked, the rest of the entries are recorded
+		// as the discrepancies.
+		for _, forked := range next[1:] {
+			visited[forked.LedgerID] = struct{}{}
+			discrepancies = append(discrepancies, Discrepancy{
+				Type:       DiscrepancyForkedChain,
+				AccountID:  account.AccountID,
+				MovementID: forked.MovementID,
+				LedgerID:   forked.LedgerID,
+				Expected:   next[0].LedgerID,
+				Actual:     forked.LedgerID,
+				Message:    "more than one ledger entries point to previous ledger id " + lastLedgerID,
+			})
+		}
+		visited[next[0].LedgerID] = struct{}{}
+		lastLedgerID = next[0].LedgerID
+	}
+
+	for _, l := range ledgers {
+		if _, ok := visited[l.LedgerID]; ok {
+			continue
+		}
+		discrepancies = append(discrepancies, Discrepancy{
+			Type:       DiscrepancyBrokenChain,
+			AccountID:  account.AccountID,
+			MovementID: l.MovementID,
+			LedgerID:   l.LedgerID,
+			Actual:     l.PreviousLedgerID,
+			Message:    "ledger entry is not reachable from the account ledger chain",
+		})
+	}
+	if lastLedgerID != account.LastLedgerID {
+		discrepancies = append(discrepancies, Discrepancy{
+			Type:      DiscrepancyLastLedgerMismatch,
+			AccountID: account.AccountID,
+			Expected:  lastLedgerID,
+			Actual:    account.LastLedgerID,
+			Message:   "the end of the ledger chain is not the same with the account last ledger id",
+		})
+	}
+	if !sum.Equal(account.Balance) {
+		discrepancies = append(discrepancies, Discrepancy{
+			Type:      DiscrepancyBalanceMismatch,
+			AccountID: account.AccountID,
+			Expected:  sum.String(),
+			Actual:    account.Balance.String(),
+			Message:   "sum of the ledger amount is not the same with the account balance",
+		})
+	}
+	return discrepancies
+}
+
+// verifyAccountHistories checks whether the accounts_balance_history rows of an account are consistent with the ledger entries. Each
+// history row must record the balance changes of the account in the movement, and must continue from the previous history row.
+func verifyAccountHistories(account accountState, ledgers []ledgerpg.AccountsLedger, histories []ledgerpg.AccountsBalanceHistory) []Discrepancy {
+	var discrepancies []Discrepancy
+
+	// Summarize the ledger entries per movement, the ledger entries are ordered by internal_id so the last ledger_id
+	// of the movement is the last entry of the movement.
+	var movements []string
+	movementChanges := make(map[string]decimal.Decimal)
+	movementLastLedgerIDs := make(map[string]string)
+	for _, l := range ledgers {
+		if _, ok := movementChanges[l.MovementID]; !ok {
+			movements = append(movements, l.MovementID)
+		}
+		movementChanges[l.MovementID] = movementChanges[l.MovementID].Add(l.Amount)
+		movementLastLedgerIDs[l.MovementID] = l.LedgerID
+	}
+
+	recorded := make(map[string]struct{}, len(histories))
+	for idx, history := range histories {
+		recorded[history.MovementID] = struct{}{}
+
+		changes := movementChanges[history.MovementID]
+		if actual := history.Balance.Sub(history.PreviousBalance); !actual.Equal(changes) {
+			discrepancies = append(discrepancies, Discrepancy{
+				Type:       DiscrepancyHistoryMismatch,
+				AccountID:  account.AccountID,
+				MovementID: history.MovementID,
+				LedgerID:   history.LedgerID,
+				Expected:   changes.String(),
+				Actual:     actual.String(),
+				Message:    "balance changes in the history is not the same with the ledger entries of the movement",
+			})
+		}
+		if history.LedgerID != movementLastLedgerIDs[history.MovementID] {
+			discrepancies = append(discrepancies, Discrepancy{
+				Type:       DiscrepancyHistoryMismatch,
+				AccountID:  account.AccountID,
+				MovementID: history.MovementID,
+				LedgerID:   history.LedgerID,
+				Expected:   movementLastLedgerIDs[history.MovementID],
+				Actual:     history.LedgerID,
+				Message:    "ledger id in the history is not the last ledger id of the movement",
+			})
+		}
+		if idx == 0 {
+			continue
+		}
+		previous := histories[idx-1]
+		if !history.PreviousBalance.Equal(previous.Balance) || history.PreviousLedgerID != previous.LedgerID || history.PreviousMovementID != previous.MovementID {
+			discrepancies = append(discrepancies, Discrepancy{
+				Type:       DiscrepancyHistoryMismatch,
+				AccountID:  account.AccountID,
+				MovementID: history.MovementID,
+				LedgerID:   history.LedgerID,
+				Expected:   previous.MovementID + ":" + previous.LedgerID + ":" + previous.Balance.String(),
+				Actual:     history.PreviousMovementID + ":" + history.PreviousLedgerID + ":" + history.PreviousBalance.String(),
+				Message:    "history does not continue from the previous history of the account",
+			})
+		}
+	}
+
+	for _, movementID := range movements {
+		if _, ok := recorded[movementID]; ok {
+			continue
+		}
+		discrepancies = append(discrepancies, Discrepancy{
+			Type:       DiscrepancyMissingHistory,
+			AccountID:  account.AccountID,
+			MovementID: movementID,
+			Message:    "movement does not have balance history for the account",
+		})
+	}
+	return discrepancies
+}
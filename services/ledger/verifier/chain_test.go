@@ -0,0 +1,203 @@
+package verifier
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/shopspring/decimal"
+
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+func TestVerifyAccountChain(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		account accountState
+		ledgers []ledgerpg.AccountsLedger
+		expect  []Discrepancy
+	}{
+		{
+			name: "no ledgers",
+			account: accountState{
+				AccountID: "one",
+				Balance:   decimal.Zero,
+			},
+		},
+		{
+			name: "healthy chain",
+			account: accountState{
+				AccountID:    "one",
+				Balance:      decimal.NewFromInt(50),
+				LastLedgerID: "l2",
+			},
+			ledgers: []ledgerpg.AccountsLedger{
+				{LedgerID: "l1", MovementID: "m1", Amount: decimal.NewFromInt(100)},
+				{LedgerID: "l2", MovementID: "m2", Amount: decimal.NewFromInt(-50), PreviousLedgerID: "l1"},
+			},
+		},
+		{
+			name: "broken chain",
+			account: accountState{
+				AccountID:    "one",
+				Balance:      decimal.NewFromInt(50),
+				LastLedgerID: "l2",
+			},
+			ledgers: []ledgerpg.AccountsLedger{
+				{LedgerID: "l1", MovementID: "m1", Amount: decimal.NewFromInt(100)},
+				{LedgerID: "l2", MovementID: "m2", Amount: decimal.NewFromInt(-50), PreviousLedgerID: "unknown"},
+			},
+			expect: []Discrepancy{
+				{
+					Type:       DiscrepancyBrokenChain,
+					AccountID:  "one",
+					MovementID: "m2",
+					LedgerID:   "l2",
+					Actual:     "unknown",
+					Message:    "ledger entry is not reachable from the account ledger chain",
+				},
+				{
+					Type:      DiscrepancyLastLedgerMismatch,
+					AccountID: "one",
+					Expected:  "l1",
+					Actual:    "l2",
+					Message:   "the end of the ledger chain is not the same with the account last ledger id",
+				},
+			},
+		},
+		{
+			name: "forked chain and balance mismatch",
+			account: accountState{
+				AccountID:    "one",
+				Balance:      decimal.NewFromInt(100),
+				LastLedgerID: "l2",
+			},
+			ledgers: []ledgerpg.AccountsLedger{
+				{LedgerID: "l1", MovementID: "m1", Amount: decimal.NewFromInt(100)},
+				{LedgerID: "l2", MovementID: "m2", Amount: decimal.NewFromInt(-50), PreviousLedgerID: "l1"},
+				{LedgerID: "l3", MovementID: "m3", Amount: decimal.NewFromInt(-50), PreviousLedgerID: "l1"},
+			},
+			expect: []Discrepancy{
+				{
+					Type:       DiscrepancyForkedChain,
+					AccountID:  "one",
+					MovementID: "m3",
+					LedgerID:   "l3",
+					Expected:   "l2",
+					Actual:     "l3",
+					Message:    "more than one ledger entries point to previous ledger id l1",
+				},
+				{
+					Type:      DiscrepancyBalanceMismatch,
+					AccountID: "one",
+					Expected:  "0",
+					Actual:    "100",
+					Message:   "sum of the ledger amount is not the same with the account balance",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := verifyAccountChain(test.account, test.ledgers)
+			if diff := cmp.Diff(test.expect, got); diff != "" {
+				t.Fatalf("(-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestVerifyAccountHistories(t *testing.T) {
+	t.Parallel()
+
+	account := accountState{AccountID: "one"}
+	ledgers := []ledgerpg.AccountsLedger{
+		{LedgerID: "l1", MovementID: "m1", Amount: decimal.NewFromInt(100)},
+		{LedgerID: "l2", MovementID: "m2", Amount: decimal.NewFromInt(-30), PreviousLedgerID: "l1"},
+		{LedgerID: "l3", MovementID: "m2", Amount: decimal.NewFromInt(-20), PreviousLedgerID: "l2"},
+	}
+
+	tests := []struct {
+		name      string
+		histories []ledgerpg.AccountsBalanceHistory
+		expect    []Discrepancy
+	}{
+		{
+			name: "consistent histories",
+			histories: []ledgerpg.AccountsBalanceHistory{
+				{MovementID: "m1", LedgerID: "l1", Balance: decimal.NewFromInt(100), PreviousBalance: decimal.Zero},
+				{
+					MovementID:         "m2",
+					LedgerID:           "l3",
+					Balance:            decimal.NewFromInt(50),
+					PreviousBalance:    decimal.NewFromInt(100),
+					PreviousMovementID: "m1",
+					PreviousLedgerID:   "l1",
+				},
+			},
+		},
+		{
+			name: "missing history and wrong ledger id",
+			histories: []ledgerpg.AccountsBalanceHistory{
+				{MovementID: "m1", LedgerID: "wrong", Balance: decimal.NewFromInt(100), PreviousBalance: decimal.Zero},
+			},
+			expect: []Discrepancy{
+				{
+					Type:       DiscrepancyHistoryMismatch,
+					AccountID:  "one",
+					MovementID: "m1",
+					LedgerID:   "wrong",
+					Expected:   "l1",
+					Actual:     "wrong",
+					Message:    "ledger id in the history is not the last ledger id of the movement",
+				},
+				{
+					Type:       DiscrepancyMissingHistory,
+					AccountID:  "one",
+					MovementID: "m2",
+					Message:    "movement does not have balance history for the account",
+				},
+			},
+		},
+		{
+			name: "wrong balance changes",
+			histories: []ledgerpg.AccountsBalanceHistory{
+				{MovementID: "m1", LedgerID: "l1", Balance: decimal.NewFromInt(100), PreviousBalance: decimal.Zero},
+				{
+					MovementID:         "m2",
+					LedgerID:           "l3",
+					Balance:            decimal.NewFromInt(70),
+					PreviousBalance:    decimal.NewFromInt(100),
+					PreviousMovementID: "m1",
+					PreviousLedgerID:   "l1",
+				},
+			},
+			expect: []Discrepancy{
+				{
+					Type:       DiscrepancyHistoryMismatch,
+					AccountID:  "one",
+					MovementID: "m2",
+					LedgerID:   "l3",
+					Expected:   "-50",
+					Actual:     "-30",
+					Message:    "balance changes in the history is not the same with the ledger entries of the movement",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := verifyAccountHistories(account, ledgers, test.histories)
+			if diff := cmp.Diff(test.expect, got); diff != "" {
+				t.Fatalf("(-want/+got)\n%s", diff)
+			}
+		})
+	}
+}
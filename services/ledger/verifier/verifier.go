@@ -0,0 +1,197 @@
+// Package verifier verifies the integrity of the ledger data. The verifier walks the previous_ledger_id chain of each account
+// and checks whether the ledger entries, the balance and the balance histories are consistent with each other.
+package verifier
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/studio-asd/pkg/postgres"
+
+	ledgerpg "github.com/studio-asd/go-example/services/ledger/internal/postgres"
+)
+
+// DiscrepancyType is the type of discrepancy found by the verifier.
+type DiscrepancyType string
+
+const (
+	// DiscrepancyBrokenChain means the ledger entry is not reachable from the beginning of the account's previous_ledger_id chain.
+	DiscrepancyBrokenChain DiscrepancyType = "broken_chain"
+	// DiscrepancyForkedChain means there are more than one ledger entries pointing to the same previous_ledger_id.
+	DiscrepancyForkedChain DiscrepancyType = "forked_chain"
+	// DiscrepancyLastLedgerMismatch means the end of the chain is not the same with accounts_balance.last_ledger_id.
+	DiscrepancyLastLedgerMismatch DiscrepancyType = "last_ledger_mismatch"
+	// DiscrepancyBalanceMismatch means the sum of the ledger amount is not the same with accounts_balance.balance.
+	DiscrepancyBalanceMismatch DiscrepancyType = "balance_mismatch"
+	// DiscrepancyUnbalancedMovement means the sum of the ledger amount in a movement is not zero.
+	DiscrepancyUnbalancedMovement DiscrepancyType = "unbalanced_movement"
+	// DiscrepancyHistoryMismatch means the accounts_balance_history row doesn't match with the ledger entries.
+	DiscrepancyHistoryMismatch DiscrepancyType = "history_mismatch"
+	// DiscrepancyMissingHistory means there is a movement for the account without the accounts_balance_history row.
+	DiscrepancyMissingHistory DiscrepancyType = "missing_history"
+)
+
+// accountsPageSize is the number of accounts retrieved per page when verifying all accounts.
+const accountsPageSize = 500
+
+// Discrepancy is a single inconsistency found by the verifier.
+type Discrepancy struct {
+	Type       DiscrepancyType `json:"type"`
+	AccountID  string          `json:"account_id,omitempty"`
+	MovementID string          `json:"movement_id,omitempty"`
+	LedgerID   string          `json:"ledger_id,omitempty"`
+	Expected   string          `json:"expected,omitempty"`
+	Actual     string          `json:"actual,omitempty"`
+	Message    string          `json:"message"`
+}
+
+// Report is the machine-readable result of the verification. The report reflects the snapshot of the database when the verification
+// starts.
+type Report struct {
+	StartedAt        time.Time     `json:"started_at"`
+	FinishedAt       time.Time     `json:"finished_at"`
+	AccountsChecked  int           `json:"accounts_checked"`
+	LedgersChecked   int           `json:"ledgers_checked"`
+	HistoriesChecked int           `json:"histories_checked"`
+	Discrepancies    []Discrepancy `json:"discrepancies"`
+}
+
+// OK returns true if there are no discrepancies in the report.
+func (r Report) OK() bool {
+	return len(r.Discrepancies) == 0
+}
+
+// WriteJSON writes the report as an indented JSON to the writer.
+func (r Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+type Verifier struct {
+	queries *ledgerpg.Queries
+}
+
+func New(pg *postgres.Postgres) *Verifier {
+	return &Verifier{
+		queries: ledgerpg.New(pg),
+	}
+}
+
+type Params struct {
+	// AccountIDs is the list of accounts to be verified. All accounts will be verified if the list is empty.
+	AccountIDs []string
+}
+
+// accountState is the state of the account in the accounts_balance table.
+type accountState struct {
+	AccountID    string
+	Balance      decimal.Decimal
+	LastLedgerID string
+}
+
+// Verify verifies the ledger integrity and returns the report of the discrepancies. The error is only returned if the verifier
+// is failed to retrieve the data, any inconsistency in the data is recorded in the report.
+//
+// All data is read inside a single read-only REPEATABLE READ transaction, so the report reflects the snapshot of the database
+// when the verification starts. The movements committed while the verifier is running are not part of the report, and won't show
+// up as discrepancies because the balance, the ledger entries and the histories are read at different times.
+func (v *Verifier) Verify(ctx context.Context, params Params) (Report, error) {
+	report := Report{
+		StartedAt:     time.Now(),
+		Discrepancies: []Discrepancy{},
+	}
+	err := v.queries.WithTransact(ctx, sql.LevelRepeatableRead, func(ctx context.Context, q *ledgerpg.Queries) error {
+		// The verifier never writes, so mark the transaction as read-only. This must be the first statement of the transaction.
+		if err := q.Do(ctx, func(ctx context.Context, pg *postgres.Postgres) error {
+			_, err := pg.Exec(ctx, "SET TRANSACTION READ ONLY")
+			return err
+		}); err != nil {
+			return err
+		}
+		return verify(ctx, q, params, &report)
+	})
+	if err != nil {
+		return Report{}, err
+	}
+	report.FinishedAt = time.Now()
+	return report, nil
+}
+
+func verify(ctx context.Context, q *ledgerpg.Queries, params Params, report *Report) error {
+	if len(params.AccountIDs) > 0 {
+		balances, err := q.GetAccountsBalance(ctx, params.AccountIDs)
+		if err != nil {
+			return err
+		}
+		for _, balance := range balances {
+			if err := verifyAccount(ctx, q, report, accountState{
+				AccountID:    balance.AccountID,
+				Balance:      balance.Balance,
+				LastLedgerID: balance.LastLedgerID,
+			}); err != nil {
+				return err
+			}
+		}
+	} else {
+		var lastAccountID string
+		for {
+			balances, err := q.ListAccountsBalance(ctx, ledgerpg.ListAccountsBalanceParams{
+				AccountID: lastAccountID,
+				Limit:     accountsPageSize,
+			})
+			if err != nil {
+				return err
+			}
+			for _, balance := range balances {
+				if err := verifyAccount(ctx, q, report, accountState{
+					AccountID:    balance.AccountID,
+					Balance:      balance.Balance,
+					LastLedgerID: balance.LastLedgerID,
+				}); err != nil {
+					return err
+				}
+			}
+			if len(balances) < accountsPageSize {
+				break
+			}
+			lastAccountID = balances[len(balances)-1].AccountID
+		}
+	}
+
+	movements, err := q.ListUnbalancedMovements(ctx)
+	if err != nil {
+		return err
+	}
+	for _, movement := range movements {
+		report.Discrepancies = append(report.Discrepancies, Discrepancy{
+			Type:       DiscrepancyUnbalancedMovement,
+			MovementID: movement.MovementID,
+			Expected:   decimal.Zero.String(),
+			Actual:     movement.TotalAmount.String(),
+			Message:    "sum of the movement amount is not zero",
+		})
+	}
+	return nil
+}
+
+func verifyAccount(ctx context.Context, q *ledgerpg.Queries, report *Report, account accountState) error {
+	ledgers, err := q.ListAccountLedgerByAccountID(ctx, account.AccountID)
+	if err != nil {
+		return err
+	}
+	histories, err := q.ListAccountBalanceHistoryByAccountID(ctx, account.AccountID)
+	if err != nil {
+		return err
+	}
+	report.AccountsChecked++
+	report.LedgersChecked += len(ledgers)
+	report.HistoriesChecked += len(histories)
+	report.Discrepancies = append(report.Discrepancies, verifyAccountChain(account, ledgers)...)
+	report.Discrepancies = append(report.Discrepancies, verifyAccountHistories(account, ledgers, histories)...)
+	return nil
+}
@@ -56,7 +56,11 @@ func (ret *GRPCMetadata) Authorization() string {
 	return values[0]
 }
 
+// UserID returns the id of the authenticated user, or empty when the request is not authenticated.
 func (ret *GRPCMetadata) UserID() string {
-	values := ret.md.Get(MetadataAuthorization)
+	values := ret.md.Get(MetadataUserID)
+	if len(values) == 0 {
+		return ""
+	}
 	return values[0]
 }
@@ -1,4 +1,77 @@
 package api
 
-func New() {
+import (
+	"log/slog"
+
+	"github.com/studio-asd/pkg/postgres"
+	"github.com/studio-asd/pkg/srun"
+
+	"github.com/studio-asd/go-example/internal/protovalidate"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+	ledgerapi "github.com/studio-asd/go-example/services/ledger/api"
+	"github.com/studio-asd/go-example/services/wallet"
+	walletpg "github.com/studio-asd/go-example/services/wallet/internal/postgres"
+)
+
+var (
+	validator *protovalidate.Validator
+	_         srun.ServiceInitAware = (*API)(nil)
+)
+
+func init() {
+	var err error
+	validator, err = protovalidate.New(
+		protovalidate.WithFailFast(),
+		protovalidate.WithMessages(
+			&walletv1.CreateWalletAccountRequest{},
+			&walletv1.GetWalletBalanceRequest{},
+			&walletv1.DepositRequest{},
+			&walletv1.CreateWithdrawalRequest{},
+			&walletv1.ConfirmWithdrawalRequest{},
+			&walletv1.FailWithdrawalRequest{},
+			&walletv1.CancelWithdrawalRequest{},
+			&walletv1.TransferRequest{},
+		),
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// API is the wallet api. Every wallet is backed by a ledger account, so the money movements between the wallets are recorded
+// via the ledger api.
+type API struct {
+	queries  *walletpg.Queries
+	ledger   *ledgerapi.API
+	logger   *slog.Logger
+	options  Options
+	gateways map[string]wallet.PaymentGateway
+}
+
+func New(pg *postgres.Postgres, ledger *ledgerapi.API, options Options) *API {
+	gateways := make(map[string]wallet.PaymentGateway, len(options.PaymentGateways))
+	for _, gateway := range options.PaymentGateways {
+		gateways[gateway.Vendor()] = gateway
+	}
+	return &API{
+		queries:  walletpg.New(pg),
+		ledger:   ledger,
+		logger:   slog.Default(),
+		options:  options,
+		gateways: gateways,
+	}
+}
+
+func (a *API) Name() string {
+	return "wallet_api"
+}
+
+func (a *API) Init(ctx srun.Context) error {
+	a.logger = ctx.Logger
+	return nil
+}
+
+// GRPC returns the grpc api implementation of the wallet api.
+func (a *API) GRPC() *GRPC {
+	return newGRPC(a)
 }
@@ -0,0 +1,235 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/studio-asd/pkg/postgres"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	"github.com/studio-asd/go-example/services/wallet"
+	walletpg "github.com/studio-asd/go-example/services/wallet/internal/postgres"
+)
+
+// Deposit moves the money from the system deposit wallet to the main wallet of the user. The deposit is recorded inside the
+// transaction of the ledger movement, and the same channel reference is only credited once. A retried request with the same
+// channel reference returns the recorded deposit.
+//
+// Each channel has its own rules:
+//   - The payment gateway deposit is only accepted from the registered payment gateways, as the payment is settled by them.
+//   - The bank deposit is only accepted from the Options.DepositBanks when the banks are set.
+//   - The loan product deposit moves the money from the system loan wallet instead of the deposit wallet, so the disbursed loans
+//     are tracked apart from the money that comes from outside of the system.
+func (a *API) Deposit(ctx context.Context, req *walletv1.DepositRequest) (*walletv1.DepositResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	curr, err := currency.Currencies.GetByName(req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+	amount, err := currency.ParseMoney(req.GetAmount(), curr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", wallet.ErrInvalidAmount, err)
+	}
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: deposit amount must be positive", wallet.ErrInvalidAmount)
+	}
+
+	resp, err := a.deposit(ctx, req, amount)
+	// The unique violation happens when the deposit with the same channel reference is recorded concurrently. Retry once, so the
+	// recorded deposit is returned.
+	if errors.Is(err, postgres.ErrUniqueViolation) {
+		return a.deposit(ctx, req, amount)
+	}
+	return resp, err
+}
+
+func (a *API) deposit(ctx context.Context, req *walletv1.DepositRequest, amount currency.Money) (*walletv1.DepositResponse, error) {
+	channel, channelName := depositChannel(req)
+	recorded, err := a.queries.GetWalletDepositByChannelReference(ctx, walletpg.GetWalletDepositByChannelReferenceParams{
+		DepositChannel:   int32(channel),
+		ChannelReference: req.GetChannelReference(),
+	})
+	if err == nil {
+		return a.replayDeposit(ctx, req, amount, recorded)
+	}
+	if !errors.Is(err, postgres.ErrNoRows) {
+		return nil, err
+	}
+
+	userWallet, err := a.queries.GetUserWalletByType(ctx, walletpg.GetUserWalletByTypeParams{
+		UserID:     req.GetUserId(),
+		WalletType: int32(walletv1.WalletType_WALLET_TYPE_MAIN),
+	})
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, fmt.Errorf("%w: user %s doesn't have a main wallet", wallet.ErrWalletNotFound, req.GetUserId())
+		}
+		return nil, err
+	}
+	if userWallet.WalletStatus != int32(walletv1.WalletStatus_WALLET_STATUS_ACTIVE) {
+		return nil, fmt.Errorf("%w: wallet %s is %s", wallet.ErrWalletNotActive, userWallet.WalletID, walletv1.WalletStatus(userWallet.WalletStatus))
+	}
+	if userWallet.CurrencyID != amount.CurrencyID() {
+		return nil, fmt.Errorf("%w: wallet %s doesn't accept %s", wallet.ErrWalletCurrencyMismatch, userWallet.WalletID, amount.Currency().Name)
+	}
+	sourceWalletType, err := a.depositSourceWalletType(channel, channelName)
+	if err != nil {
+		return nil, err
+	}
+	depositWallet, err := a.systemWallet(ctx, sourceWalletType, amount.Currency())
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := walletpg.WalletDeposit{
+		TransactionID:    uuid.NewString(),
+		DepositWalletID:  depositWallet.WalletID,
+		UserWalletID:     userWallet.WalletID,
+		Amount:           amount.Amount(),
+		DepositStatus:    int32(walletv1.DepositStatus_DEPOSIT_STATUS_SCUCESS),
+		DepositChannel:   int32(channel),
+		ChannelReference: req.GetChannelReference(),
+		ChannelName:      channelName,
+		CreatedAt:        time.Now(),
+	}
+	// The deposit is finished immediately because the money is already received by the channel.
+	deposit.FinishedAt = sql.NullTime{Time: deposit.CreatedAt, Valid: true}
+	idempotencyKey := depositIdempotencyKey(channel, req.GetChannelReference())
+
+	var depositRecorded bool
+	_, err = a.ledger.Transact(ctx, &ledgerv1.TransactRequest{
+		IdempotencyKey: idempotencyKey,
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{
+				FromAccountId: depositWallet.LedgerAccountID,
+				ToAccountId:   userWallet.LedgerAccountID,
+				Amount:        amount.Amount().String(),
+				ClientId:      deposit.TransactionID,
+			},
+		},
+	}, func(ctx context.Context, pg *postgres.Postgres, movement ledger.MovementInfo) error {
+		q := walletpg.New(pg)
+		if err := q.CreateWalletTransaction(ctx, walletpg.CreateWalletTransactionParams{
+			TransactionID:     deposit.TransactionID,
+			TransactionType:   int32(walletv1.TransactionType_TX_TYPE_DEPOSIT),
+			TransactionStatus: int32(walletv1.TransactionStatus_TRANSACTION_STATUS_SUCCESS),
+			IdempotencyKey:    idempotencyKey,
+			CreatedAt:         deposit.CreatedAt,
+			FinishedAt:        deposit.FinishedAt,
+		}); err != nil {
+			return err
+		}
+		deposit.MovementID = sql.NullString{String: movement.MovementID, Valid: true}
+		err := q.CreateWalletDeposit(ctx, walletpg.CreateWalletDepositParams{
+			TransactionID:    deposit.TransactionID,
+			DepositWalletID:  deposit.DepositWalletID,
+			UserWalletID:     deposit.UserWalletID,
+			Amount:           deposit.Amount,
+			DepositStatus:    deposit.DepositStatus,
+			DepositChannel:   deposit.DepositChannel,
+			ChannelReference: deposit.ChannelReference,
+			ChannelName:      deposit.ChannelName,
+			MovementID:       deposit.MovementID,
+			CreatedAt:        deposit.CreatedAt,
+			FinishedAt:       deposit.FinishedAt,
+		})
+		depositRecorded = err == nil
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The ledger replays the movement without invoking the function when the movement with the same idempotency key is recorded
+	// concurrently. In this case the deposit is recorded by the other request, so we return the recorded deposit.
+	if !depositRecorded {
+		recorded, err := a.queries.GetWalletDepositByChannelReference(ctx, walletpg.GetWalletDepositByChannelReferenceParams{
+			DepositChannel:   int32(channel),
+			ChannelReference: req.GetChannelReference(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return a.replayDeposit(ctx, req, amount, recorded)
+	}
+	return newDepositResponse(deposit, amount.Currency()), nil
+}
+
+// replayDeposit returns the recorded deposit of the channel reference. The deposit must be recorded for the same user and amount,
+// otherwise the channel reference is re-used for a different deposit.
+func (a *API) replayDeposit(ctx context.Context, req *walletv1.DepositRequest, amount currency.Money, recorded walletpg.WalletDeposit) (*walletv1.DepositResponse, error) {
+	userWallet, err := a.queries.GetWallet(ctx, recorded.UserWalletID)
+	if err != nil {
+		return nil, err
+	}
+	if userWallet.UserID != req.GetUserId() || userWallet.CurrencyID != amount.CurrencyID() || !recorded.Amount.Equal(amount.Amount()) {
+		return nil, fmt.Errorf("%w: %s", wallet.ErrDepositConflict, req.GetChannelReference())
+	}
+	return newDepositResponse(recorded, amount.Currency()), nil
+}
+
+func newDepositResponse(deposit walletpg.WalletDeposit, curr *currency.Currency) *walletv1.DepositResponse {
+	return &walletv1.DepositResponse{
+		TransactionId:    deposit.TransactionID,
+		WalletId:         deposit.UserWalletID,
+		Amount:           deposit.Amount.String(),
+		Currency:         curr.Name,
+		DepositStatus:    walletv1.DepositStatus(deposit.DepositStatus),
+		DepositChannel:   walletv1.DepositChannel(deposit.DepositChannel),
+		ChannelReference: deposit.ChannelReference,
+		MovementId:       deposit.MovementID.String,
+		CreatedAt:        timestamppb.New(deposit.CreatedAt),
+	}
+}
+
+// depositChannel returns the channel of the deposit along with the name of the payment gateway vendor, bank or loan product that
+// sends the money.
+func depositChannel(req *walletv1.DepositRequest) (walletv1.DepositChannel, string) {
+	switch channel := req.GetChannel().(type) {
+	case *walletv1.DepositRequest_PaymentGateway_:
+		return walletv1.DepositChannel_DEPOSIT_CHANNEL_PAYMENT_GATEWAY, channel.PaymentGateway.GetVendor()
+	case *walletv1.DepositRequest_Bank_:
+		return walletv1.DepositChannel_DEPOSIT_CHANNEL_BANKS, channel.Bank.GetBankName()
+	case *walletv1.DepositRequest_LoanProduct_:
+		return walletv1.DepositChannel_DEPOSIT_CHANNEL_LOAN_PRODUCT, channel.LoanProduct.GetProductName()
+	default:
+		return walletv1.DepositChannel_DEPOSIT_CHANNEL_UNSPECIFIED, ""
+	}
+}
+
+// depositSourceWalletType checks the rules of the deposit channel and returns the type of the system wallet that sends the money of
+// the deposit.
+func (a *API) depositSourceWalletType(channel walletv1.DepositChannel, channelName string) (walletv1.WalletType, error) {
+	switch channel {
+	case walletv1.DepositChannel_DEPOSIT_CHANNEL_PAYMENT_GATEWAY:
+		if _, err := a.paymentGateway(channelName); err != nil {
+			return walletv1.WalletType_WALLET_TYPE_UNSPECIFIED, err
+		}
+		return walletv1.WalletType_WALLET_TYPE_DEPOSIT, nil
+	case walletv1.DepositChannel_DEPOSIT_CHANNEL_BANKS:
+		if len(a.options.DepositBanks) > 0 && !slices.Contains(a.options.DepositBanks, channelName) {
+			return walletv1.WalletType_WALLET_TYPE_UNSPECIFIED, fmt.Errorf("%w: bank %s doesn't accept deposits", wallet.ErrDepositChannelNotAllowed, channelName)
+		}
+		return walletv1.WalletType_WALLET_TYPE_DEPOSIT, nil
+	case walletv1.DepositChannel_DEPOSIT_CHANNEL_LOAN_PRODUCT:
+		return walletv1.WalletType_WALLET_TYPE_LOAN, nil
+	default:
+		return walletv1.WalletType_WALLET_TYPE_UNSPECIFIED, fmt.Errorf("%w: %s", wallet.ErrDepositChannelNotAllowed, channel)
+	}
+}
+
+// depositIdempotencyKey returns the idempotency key of the deposit transaction and its ledger movement. The channel is part of
+// the key as the channel reference is only unique inside the channel.
+func depositIdempotencyKey(channel walletv1.DepositChannel, channelReference string) string {
+	return fmt.Sprintf("wallet_deposit:%d:%s", channel, channelReference)
+}
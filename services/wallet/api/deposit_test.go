@@ -0,0 +1,352 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+	ledgerapi "github.com/studio-asd/go-example/services/ledger/api"
+	"github.com/studio-asd/go-example/services/wallet"
+	"github.com/studio-asd/go-example/services/wallet/gateway"
+	walletpg "github.com/studio-asd/go-example/services/wallet/internal/postgres"
+)
+
+func TestDeposit(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "public")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), ledgerapi.New(th.Postgres(), ledgerapi.Options{}), Options{
+		PaymentGateways: []wallet.PaymentGateway{gateway.NewSimulator(gateway.SimulatorConfig{Vendor: "xendit", Secret: "secret"})},
+		DepositBanks:    []string{"bca"},
+	})
+	if _, err := api.CreateWalletAccount(context.Background(), &walletv1.CreateWalletAccountRequest{
+		UserId:     "user_1",
+		WalletType: walletv1.WalletType_WALLET_TYPE_MAIN,
+		Currency:   "IDR",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	depositReq := &walletv1.DepositRequest{
+		UserId:           "user_1",
+		Amount:           "10000",
+		Currency:         "IDR",
+		ChannelReference: "payment_1",
+		Channel: &walletv1.DepositRequest_PaymentGateway_{
+			PaymentGateway: &walletv1.DepositRequest_PaymentGateway{Vendor: "xendit"},
+		},
+	}
+	deposited, err := api.Deposit(context.Background(), depositReq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deposited.GetDepositStatus() != walletv1.DepositStatus_DEPOSIT_STATUS_SCUCESS {
+		t.Fatalf("expecting status %s but got %s", walletv1.DepositStatus_DEPOSIT_STATUS_SCUCESS, deposited.GetDepositStatus())
+	}
+
+	t.Run("balance", func(t *testing.T) {
+		balance, err := api.GetWalletBalance(context.Background(), &walletv1.GetWalletBalanceRequest{
+			FilterParams: &walletv1.GetWalletBalanceRequest_UserId{UserId: "user_1"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balance.GetWalletBalance() != "10000" {
+			t.Fatalf("expecting balance 10000 but got %s", balance.GetWalletBalance())
+		}
+		// The money comes from the system deposit wallet, so its balance goes below zero.
+		depositWallet, err := api.queries.GetSystemWallet(context.Background(), walletpg.GetSystemWalletParams{
+			WalletType: int32(walletv1.WalletType_WALLET_TYPE_DEPOSIT),
+			CurrencyID: currency.IDR.ID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		depositBalance, err := api.walletBalance(context.Background(), depositWallet.WalletID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if depositBalance.GetWalletBalance() != "-10000" {
+			t.Fatalf("expecting deposit wallet balance -10000 but got %s", depositBalance.GetWalletBalance())
+		}
+	})
+
+	t.Run("replay deposit", func(t *testing.T) {
+		replayed, err := api.Deposit(context.Background(), depositReq)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if replayed.GetTransactionId() != deposited.GetTransactionId() {
+			t.Fatalf("expecting transaction %s but got %s", deposited.GetTransactionId(), replayed.GetTransactionId())
+		}
+		balance, err := api.GetWalletBalance(context.Background(), &walletv1.GetWalletBalanceRequest{
+			FilterParams: &walletv1.GetWalletBalanceRequest_WalletId{WalletId: deposited.GetWalletId()},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balance.GetWalletBalance() != "10000" {
+			t.Fatalf("expecting balance 10000 but got %s", balance.GetWalletBalance())
+		}
+	})
+
+	// The loan product uses the same reference on other channel, and the money of the loan comes from the loan wallet.
+	t.Run("loan product", func(t *testing.T) {
+		if _, err := api.Deposit(context.Background(), &walletv1.DepositRequest{
+			UserId:           "user_1",
+			Amount:           "5000",
+			Currency:         "IDR",
+			ChannelReference: "payment_1",
+			Channel: &walletv1.DepositRequest_LoanProduct_{
+				LoanProduct: &walletv1.DepositRequest_LoanProduct{ProductName: "paylater"},
+			},
+		}); err != nil {
+			t.Fatal(err)
+		}
+		expectSystemBalance := func(t *testing.T, walletType walletv1.WalletType, expect string) {
+			t.Helper()
+			systemWallet, err := api.queries.GetSystemWallet(context.Background(), walletpg.GetSystemWalletParams{
+				WalletType: int32(walletType),
+				CurrencyID: currency.IDR.ID,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			balance, err := api.walletBalance(context.Background(), systemWallet.WalletID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if balance.GetWalletBalance() != expect {
+				t.Fatalf("expecting %s wallet balance %s but got %s", walletType, expect, balance.GetWalletBalance())
+			}
+		}
+		expectSystemBalance(t, walletv1.WalletType_WALLET_TYPE_LOAN, "-5000")
+		expectSystemBalance(t, walletv1.WalletType_WALLET_TYPE_DEPOSIT, "-10000")
+	})
+
+	tests := []struct {
+		name   string
+		req    *walletv1.DepositRequest
+		expect error
+	}{
+		{
+			name: "conflicting amount",
+			req: &walletv1.DepositRequest{
+				UserId:           "user_1",
+				Amount:           "20000",
+				Currency:         "IDR",
+				ChannelReference: "payment_1",
+				Channel: &walletv1.DepositRequest_PaymentGateway_{
+					PaymentGateway: &walletv1.DepositRequest_PaymentGateway{Vendor: "xendit"},
+				},
+			},
+			expect: wallet.ErrDepositConflict,
+		},
+		{
+			name: "negative amount",
+			req: &walletv1.DepositRequest{
+				UserId:           "user_1",
+				Amount:           "-100",
+				Currency:         "IDR",
+				ChannelReference: "transfer_1",
+				Channel: &walletv1.DepositRequest_Bank_{
+					Bank: &walletv1.DepositRequest_Bank{BankName: "bca"},
+				},
+			},
+			expect: wallet.ErrInvalidAmount,
+		},
+		{
+			name: "currency mismatch",
+			req: &walletv1.DepositRequest{
+				UserId:           "user_1",
+				Amount:           "100",
+				Currency:         "USD",
+				ChannelReference: "transfer_2",
+				Channel: &walletv1.DepositRequest_Bank_{
+					Bank: &walletv1.DepositRequest_Bank{BankName: "bca"},
+				},
+			},
+			expect: wallet.ErrWalletCurrencyMismatch,
+		},
+		{
+			name: "payment gateway not registered",
+			req: &walletv1.DepositRequest{
+				UserId:           "user_1",
+				Amount:           "100",
+				Currency:         "IDR",
+				ChannelReference: "payment_2",
+				Channel: &walletv1.DepositRequest_PaymentGateway_{
+					PaymentGateway: &walletv1.DepositRequest_PaymentGateway{Vendor: "unknown"},
+				},
+			},
+			expect: wallet.ErrPaymentGatewayNotFound,
+		},
+		{
+			name: "bank not allowed",
+			req: &walletv1.DepositRequest{
+				UserId:           "user_1",
+				Amount:           "100",
+				Currency:         "IDR",
+				ChannelReference: "transfer_4",
+				Channel: &walletv1.DepositRequest_Bank_{
+					Bank: &walletv1.DepositRequest_Bank{BankName: "unknown"},
+				},
+			},
+			expect: wallet.ErrDepositChannelNotAllowed,
+		},
+		{
+			name: "wallet not found",
+			req: &walletv1.DepositRequest{
+				UserId:           "not_found",
+				Amount:           "100",
+				Currency:         "IDR",
+				ChannelReference: "transfer_3",
+				Channel: &walletv1.DepositRequest_Bank_{
+					Bank: &walletv1.DepositRequest_Bank{BankName: "bca"},
+				},
+			},
+			expect: wallet.ErrWalletNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := api.Deposit(context.Background(), test.req)
+			if !errors.Is(err, test.expect) {
+				t.Fatalf("expecting error %v but got %v", test.expect, err)
+			}
+		})
+	}
+}
+
+func TestDepositChannel(t *testing.T) {
+	tests := []struct {
+		name          string
+		req           *walletv1.DepositRequest
+		expectChannel walletv1.DepositChannel
+		expectName    string
+	}{
+		{
+			name: "payment gateway",
+			req: &walletv1.DepositRequest{
+				Channel: &walletv1.DepositRequest_PaymentGateway_{
+					PaymentGateway: &walletv1.DepositRequest_PaymentGateway{Vendor: "xendit"},
+				},
+			},
+			expectChannel: walletv1.DepositChannel_DEPOSIT_CHANNEL_PAYMENT_GATEWAY,
+			expectName:    "xendit",
+		},
+		{
+			name: "bank",
+			req: &walletv1.DepositRequest{
+				Channel: &walletv1.DepositRequest_Bank_{
+					Bank: &walletv1.DepositRequest_Bank{BankName: "bca"},
+				},
+			},
+			expectChannel: walletv1.DepositChannel_DEPOSIT_CHANNEL_BANKS,
+			expectName:    "bca",
+		},
+		{
+			name: "loan product",
+			req: &walletv1.DepositRequest{
+				Channel: &walletv1.DepositRequest_LoanProduct_{
+					LoanProduct: &walletv1.DepositRequest_LoanProduct{ProductName: "paylater"},
+				},
+			},
+			expectChannel: walletv1.DepositChannel_DEPOSIT_CHANNEL_LOAN_PRODUCT,
+			expectName:    "paylater",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			channel, name := depositChannel(test.req)
+			if channel != test.expectChannel || name != test.expectName {
+				t.Fatalf("expecting channel %s %s but got %s %s", test.expectChannel, test.expectName, channel, name)
+			}
+		})
+	}
+}
+
+func TestDepositSourceWalletType(t *testing.T) {
+	api := New(nil, nil, Options{
+		PaymentGateways: []wallet.PaymentGateway{gateway.NewSimulator(gateway.SimulatorConfig{Vendor: "xendit"})},
+		DepositBanks:    []string{"bca"},
+	})
+	anyBankAPI := New(nil, nil, Options{})
+
+	tests := []struct {
+		name        string
+		api         *API
+		channel     walletv1.DepositChannel
+		channelName string
+		expect      walletv1.WalletType
+		expectErr   error
+	}{
+		{
+			name:        "registered payment gateway",
+			api:         api,
+			channel:     walletv1.DepositChannel_DEPOSIT_CHANNEL_PAYMENT_GATEWAY,
+			channelName: "xendit",
+			expect:      walletv1.WalletType_WALLET_TYPE_DEPOSIT,
+		},
+		{
+			name:        "unregistered payment gateway",
+			api:         api,
+			channel:     walletv1.DepositChannel_DEPOSIT_CHANNEL_PAYMENT_GATEWAY,
+			channelName: "doku",
+			expectErr:   wallet.ErrPaymentGatewayNotFound,
+		},
+		{
+			name:        "deposit bank",
+			api:         api,
+			channel:     walletv1.DepositChannel_DEPOSIT_CHANNEL_BANKS,
+			channelName: "bca",
+			expect:      walletv1.WalletType_WALLET_TYPE_DEPOSIT,
+		},
+		{
+			name:        "not a deposit bank",
+			api:         api,
+			channel:     walletv1.DepositChannel_DEPOSIT_CHANNEL_BANKS,
+			channelName: "mandiri",
+			expectErr:   wallet.ErrDepositChannelNotAllowed,
+		},
+		{
+			name:        "any bank without deposit banks",
+			api:         anyBankAPI,
+			channel:     walletv1.DepositChannel_DEPOSIT_CHANNEL_BANKS,
+			channelName: "mandiri",
+			expect:      walletv1.WalletType_WALLET_TYPE_DEPOSIT,
+		},
+		{
+			name:        "loan product",
+			api:         api,
+			channel:     walletv1.DepositChannel_DEPOSIT_CHANNEL_LOAN_PRODUCT,
+			channelName: "paylater",
+			expect:      walletv1.WalletType_WALLET_TYPE_LOAN,
+		},
+		{
+			name:      "unspecified channel",
+			api:       api,
+			channel:   walletv1.DepositChannel_DEPOSIT_CHANNEL_UNSPECIFIED,
+			expectErr: wallet.ErrDepositChannelNotAllowed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			walletType, err := test.api.depositSourceWalletType(test.channel, test.channelName)
+			if !errors.Is(err, test.expectErr) {
+				t.Fatalf("expecting error %v but got %v", test.expectErr, err)
+			}
+			if walletType != test.expect {
+				t.Fatalf("expecting wallet type %s but got %s", test.expect, walletType)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/studio-asd/pkg/postgres"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+	"github.com/studio-asd/go-example/services/wallet"
+)
+
+// HandlePaymentGatewayWebhook verifies the webhook of the payment gateway and drives the state of the withdrawal or deposit inside the
+// event. The payment gateway retries the webhook that fails, and the retried webhook is safe to handle because the state transitions
+// and the deposits are idempotent.
+func (a *API) HandlePaymentGatewayWebhook(ctx context.Context, vendor string, payload []byte, signature string) error {
+	gateway, err := a.paymentGateway(vendor)
+	if err != nil {
+		return err
+	}
+	event, err := gateway.VerifyWebhook(ctx, payload, signature)
+	if err != nil {
+		return err
+	}
+
+	switch event.Type {
+	case wallet.WebhookEventDisbursement:
+		withdrawal, err := a.queries.GetWalletWithdrawal(ctx, event.ReferenceID)
+		if err != nil {
+			if errors.Is(err, postgres.ErrNoRows) {
+				return fmt.Errorf("%w: %s", wallet.ErrWithdrawalNotFound, event.ReferenceID)
+			}
+			return err
+		}
+		// The payment gateway can only finish the withdrawals disbursed through it.
+		if withdrawal.WithdrawalPgVendor.String != vendor {
+			return fmt.Errorf("%w: withdrawal %s is not disbursed by %s", wallet.ErrWithdrawalNotFound, event.ReferenceID, vendor)
+		}
+		_, err = a.applyDisbursementStatus(ctx, event.ReferenceID, event.DisbursementStatus)
+		return err
+	case wallet.WebhookEventPayment:
+		_, err := a.Deposit(ctx, &walletv1.DepositRequest{
+			UserId:           event.UserID,
+			Amount:           event.Amount,
+			Currency:         event.Currency,
+			ChannelReference: event.ReferenceID,
+			Channel: &walletv1.DepositRequest_PaymentGateway_{
+				PaymentGateway: &walletv1.DepositRequest_PaymentGateway{Vendor: vendor},
+			},
+		})
+		return err
+	default:
+		return fmt.Errorf("%w: unknown event type %d", wallet.ErrInvalidWebhookEvent, event.Type)
+	}
+}
+
+// SyncWithdrawal queries the status of the disbursement of the pending withdrawal to the payment gateway, and transitions the
+// withdrawal when the disbursement is finished. The sync is useful when the webhook of the payment gateway is never received.
+func (a *API) SyncWithdrawal(ctx context.Context, transactionID string) (*walletv1.Withdrawal, error) {
+	withdrawal, err := a.queries.GetWalletWithdrawal(ctx, transactionID)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", wallet.ErrWithdrawalNotFound, transactionID)
+		}
+		return nil, err
+	}
+	if !withdrawal.WithdrawalViaPg {
+		return nil, fmt.Errorf("%w: withdrawal %s is not disbursed through a payment gateway", wallet.ErrPaymentGatewayNotFound, transactionID)
+	}
+	gateway, err := a.paymentGateway(withdrawal.WithdrawalPgVendor.String)
+	if err != nil {
+		return nil, err
+	}
+	status, err := gateway.DisbursementStatus(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	return a.applyDisbursementStatus(ctx, transactionID, status)
+}
+
+// submitDisbursement submits the final amount of the pending withdrawal to the payment gateway and applies the status of the
+// disbursement to the withdrawal. The withdrawal stays pending when the submission fails, so it can be synced later on.
+func (a *API) submitDisbursement(ctx context.Context, gateway wallet.PaymentGateway, withdrawal *walletv1.Withdrawal, destination string) *walletv1.Withdrawal {
+	if withdrawal.GetWithdrawalStatus() != walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING {
+		return withdrawal
+	}
+	curr, err := currency.Currencies.GetByName(withdrawal.GetCurrency())
+	if err != nil {
+		a.logger.ErrorContext(ctx, "Failed to submit the disbursement", "transaction_id", withdrawal.GetTransactionId(), "error", err)
+		return withdrawal
+	}
+	amount, err := currency.ParseMoney(withdrawal.GetFinalAmount(), curr)
+	if err != nil {
+		a.logger.ErrorContext(ctx, "Failed to submit the disbursement", "transaction_id", withdrawal.GetTransactionId(), "error", err)
+		return withdrawal
+	}
+	status, err := gateway.SubmitDisbursement(ctx, wallet.Disbursement{
+		ReferenceID: withdrawal.GetTransactionId(),
+		Amount:      amount,
+		Channel:     withdrawal.GetWithdrawalChannel(),
+		Destination: destination,
+	})
+	if err != nil {
+		a.logger.ErrorContext(ctx, "Failed to submit the disbursement", "transaction_id", withdrawal.GetTransactionId(), "vendor", gateway.Vendor(), "error", err)
+		return withdrawal
+	}
+	updated, err := a.applyDisbursementStatus(ctx, withdrawal.GetTransactionId(), status)
+	if err != nil {
+		a.logger.ErrorContext(ctx, "Failed to apply the disbursement status", "transaction_id", withdrawal.GetTransactionId(), "status", status, "error", err)
+		return withdrawal
+	}
+	return updated
+}
+
+// applyDisbursementStatus transitions the withdrawal based on the status of its disbursement. The pending disbursement doesn't change
+// the withdrawal.
+func (a *API) applyDisbursementStatus(ctx context.Context, transactionID string, status wallet.DisbursementStatus) (*walletv1.Withdrawal, error) {
+	switch status {
+	case wallet.DisbursementStatusSuccess:
+		return a.transitionWithdrawal(ctx, transactionID, walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS)
+	case wallet.DisbursementStatusFailed:
+		return a.transitionWithdrawal(ctx, transactionID, walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_FAILED)
+	case wallet.DisbursementStatusPending:
+		withdrawal, err := a.queries.GetWalletWithdrawal(ctx, transactionID)
+		if err != nil {
+			return nil, err
+		}
+		userWallet, err := a.queries.GetWallet(ctx, withdrawal.UserWalletID)
+		if err != nil {
+			return nil, err
+		}
+		curr, err := currency.Currencies.GetByID(userWallet.CurrencyID)
+		if err != nil {
+			return nil, err
+		}
+		return newWithdrawal(withdrawal, curr), nil
+	default:
+		return nil, fmt.Errorf("%w: unknown disbursement status %d", wallet.ErrInvalidWebhookEvent, status)
+	}
+}
+
+func (a *API) paymentGateway(vendor string) (wallet.PaymentGateway, error) {
+	gateway, ok := a.gateways[vendor]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", wallet.ErrPaymentGatewayNotFound, vendor)
+	}
+	return gateway, nil
+}
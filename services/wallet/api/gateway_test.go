@@ -0,0 +1,168 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+	ledgerapi "github.com/studio-asd/go-example/services/ledger/api"
+	"github.com/studio-asd/go-example/services/wallet"
+	"github.com/studio-asd/go-example/services/wallet/gateway"
+	walletpg "github.com/studio-asd/go-example/services/wallet/internal/postgres"
+)
+
+func TestPaymentGateway(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "public")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var api *API
+	webhook := func(ctx context.Context, vendor string, payload []byte, signature string) error {
+		return api.HandlePaymentGatewayWebhook(ctx, vendor, payload, signature)
+	}
+	simulator := gateway.NewSimulator(gateway.SimulatorConfig{Secret: "secret", Webhook: webhook})
+	delayedSimulator := gateway.NewSimulator(gateway.SimulatorConfig{
+		Vendor:  "delayed_simulator",
+		Secret:  "secret",
+		Delay:   time.Millisecond * 100,
+		Webhook: webhook,
+	})
+	defer delayedSimulator.Close()
+
+	api = New(th.Postgres(), ledgerapi.New(th.Postgres(), ledgerapi.Options{}), Options{
+		WithdrawalFee:   flatWithdrawalFee,
+		PaymentGateways: []wallet.PaymentGateway{simulator, delayedSimulator},
+	})
+	if _, err := api.CreateWalletAccount(context.Background(), &walletv1.CreateWalletAccountRequest{
+		UserId:     "user_1",
+		WalletType: walletv1.WalletType_WALLET_TYPE_MAIN,
+		Currency:   "IDR",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	walletUser, err := api.queries.GetWalletUser(context.Background(), "user_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mainWallet, err := api.queries.GetUserWalletByType(context.Background(), walletpg.GetUserWalletByTypeParams{
+		UserID:     "user_1",
+		WalletType: int32(walletv1.WalletType_WALLET_TYPE_MAIN),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectBalance := func(t *testing.T, walletID, expect string) {
+		t.Helper()
+		balance, err := api.walletBalance(context.Background(), walletID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balance.GetWalletBalance() != expect {
+			t.Fatalf("expecting balance of wallet %s to be %s but got %s", walletID, expect, balance.GetWalletBalance())
+		}
+	}
+	createReq := func(vendor, idempotencyKey string) *walletv1.CreateWithdrawalRequest {
+		return &walletv1.CreateWithdrawalRequest{
+			UserId:               "user_1",
+			Amount:               "2000",
+			Currency:             "IDR",
+			IdempotencyKey:       idempotencyKey,
+			PaymentGatewayVendor: vendor,
+			Destination: &walletv1.CreateWithdrawalRequest_Bank_{
+				Bank: &walletv1.CreateWithdrawalRequest_Bank{BankName: "bca"},
+			},
+		}
+	}
+
+	t.Run("payment webhook", func(t *testing.T) {
+		if err := simulator.Pay(context.Background(), "user_1", "payment_1", "10000", "IDR"); err != nil {
+			t.Fatal(err)
+		}
+		// The retried webhook doesn't credit the user twice.
+		if err := simulator.Pay(context.Background(), "user_1", "payment_1", "10000", "IDR"); err != nil {
+			t.Fatal(err)
+		}
+		expectBalance(t, mainWallet.WalletID, "10000")
+	})
+
+	t.Run("disbursement success", func(t *testing.T) {
+		created, err := api.CreateWithdrawal(context.Background(), createReq(simulator.Vendor(), "withdrawal_1"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created.GetWithdrawal().GetWithdrawalStatus() != walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS {
+			t.Fatalf("expecting status %s but got %s", walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS, created.GetWithdrawal().GetWithdrawalStatus())
+		}
+		expectBalance(t, mainWallet.WalletID, "8000")
+		expectBalance(t, walletUser.IntermediaryWalletID, "0")
+	})
+
+	t.Run("disbursement failed", func(t *testing.T) {
+		simulator.SetOutcome(gateway.OutcomeFail)
+		defer simulator.SetOutcome(gateway.OutcomeSuccess)
+
+		created, err := api.CreateWithdrawal(context.Background(), createReq(simulator.Vendor(), "withdrawal_2"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created.GetWithdrawal().GetWithdrawalStatus() != walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_FAILED {
+			t.Fatalf("expecting status %s but got %s", walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_FAILED, created.GetWithdrawal().GetWithdrawalStatus())
+		}
+		expectBalance(t, mainWallet.WalletID, "8000")
+	})
+
+	t.Run("delayed disbursement", func(t *testing.T) {
+		created, err := api.CreateWithdrawal(context.Background(), createReq(delayedSimulator.Vendor(), "withdrawal_3"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		transactionID := created.GetWithdrawal().GetTransactionId()
+		if created.GetWithdrawal().GetWithdrawalStatus() != walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING {
+			t.Fatalf("expecting status %s but got %s", walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING, created.GetWithdrawal().GetWithdrawalStatus())
+		}
+		expectBalance(t, walletUser.IntermediaryWalletID, "2000")
+
+		// Wait for the webhook to finish the withdrawal.
+		deadline := time.Now().Add(time.Second * 5)
+		for {
+			withdrawal, err := api.SyncWithdrawal(context.Background(), transactionID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if withdrawal.GetWithdrawalStatus() == walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("withdrawal %s is still %s", transactionID, withdrawal.GetWithdrawalStatus())
+			}
+			time.Sleep(time.Millisecond * 50)
+		}
+		expectBalance(t, mainWallet.WalletID, "6000")
+		expectBalance(t, walletUser.IntermediaryWalletID, "0")
+	})
+
+	t.Run("invalid webhook", func(t *testing.T) {
+		payload, _, err := simulator.PaymentWebhook("user_1", "payment_2", "10000", "IDR")
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = api.HandlePaymentGatewayWebhook(context.Background(), simulator.Vendor(), payload, "invalid")
+		if !errors.Is(err, wallet.ErrInvalidWebhookSignature) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrInvalidWebhookSignature, err)
+		}
+		err = api.HandlePaymentGatewayWebhook(context.Background(), "unknown", payload, "invalid")
+		if !errors.Is(err, wallet.ErrPaymentGatewayNotFound) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrPaymentGatewayNotFound, err)
+		}
+		_, err = api.CreateWithdrawal(context.Background(), createReq("unknown", "withdrawal_4"))
+		if !errors.Is(err, wallet.ErrPaymentGatewayNotFound) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrPaymentGatewayNotFound, err)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+)
+
+var _ walletv1.WalletServiceServer = (*GRPC)(nil)
+
+// GRPC is the grpc server implementation of the API. The methods in the struct should only be invoked from the
+// rpc framework as interceptor and other parts of the gRPC stacks won't be available via direct method call.
+type GRPC struct {
+	walletv1.UnimplementedWalletServiceServer
+	api *API
+}
+
+func newGRPC(api *API) *GRPC {
+	return &GRPC{
+		api: api,
+	}
+}
+
+func (g *GRPC) CreateWalletAccount(ctx context.Context, req *walletv1.CreateWalletAccountRequest) (*walletv1.CreateWalletAccountResponse, error) {
+	return g.api.CreateWalletAccount(ctx, req)
+}
+
+func (g *GRPC) GetWalletBalance(ctx context.Context, req *walletv1.GetWalletBalanceRequest) (*walletv1.GetWalletBalanceResponse, error) {
+	return g.api.GetWalletBalance(ctx, req)
+}
+
+func (g *GRPC) Deposit(ctx context.Context, req *walletv1.DepositRequest) (*walletv1.DepositResponse, error) {
+	return g.api.Deposit(ctx, req)
+}
+
+func (g *GRPC) CreateWithdrawal(ctx context.Context, req *walletv1.CreateWithdrawalRequest) (*walletv1.CreateWithdrawalResponse, error) {
+	return g.api.CreateWithdrawal(ctx, req)
+}
+
+func (g *GRPC) ConfirmWithdrawal(ctx context.Context, req *walletv1.ConfirmWithdrawalRequest) (*walletv1.ConfirmWithdrawalResponse, error) {
+	return g.api.ConfirmWithdrawal(ctx, req)
+}
+
+func (g *GRPC) FailWithdrawal(ctx context.Context, req *walletv1.FailWithdrawalRequest) (*walletv1.FailWithdrawalResponse, error) {
+	return g.api.FailWithdrawal(ctx, req)
+}
+
+func (g *GRPC) CancelWithdrawal(ctx context.Context, req *walletv1.CancelWithdrawalRequest) (*walletv1.CancelWithdrawalResponse, error) {
+	return g.api.CancelWithdrawal(ctx, req)
+}
+
+func (g *GRPC) Transfer(ctx context.Context, req *walletv1.TransferRequest) (*walletv1.TransferResponse, error) {
+	return g.api.Transfer(ctx, req)
+}
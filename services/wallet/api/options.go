@@ -0,0 +1,15 @@
+package api
+
+import "github.com/studio-asd/go-example/services/wallet"
+
+// Options is the options of the wallet api.
+type Options struct {
+	// WithdrawalFee calculates the fee of the withdrawals. The withdrawals are free of charge when the calculator is not set.
+	WithdrawalFee wallet.WithdrawalFeeCalculator `yaml:"-"`
+	// PaymentGateways are the payment gateways used to disburse the withdrawals and to receive the deposits. The payment gateway is
+	// chosen by its vendor name.
+	PaymentGateways []wallet.PaymentGateway `yaml:"-"`
+	// DepositBanks are the banks that receive the deposits transferred by the users. The deposits from any bank are accepted when
+	// the banks are not set.
+	DepositBanks []string `yaml:"deposit_banks"`
+}
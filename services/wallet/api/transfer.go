@@ -0,0 +1,223 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/studio-asd/pkg/postgres"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+	"github.com/studio-asd/go-example/services"
+	"github.com/studio-asd/go-example/services/ledger"
+	"github.com/studio-asd/go-example/services/wallet"
+	walletpg "github.com/studio-asd/go-example/services/wallet/internal/postgres"
+)
+
+// Transfer moves the money between the wallets of the users. The transfer is recorded inside the transaction of the ledger movement,
+// so the wallet transaction is never recorded without the money being moved. A retried request with the same idempotency key returns
+// the recorded transfer.
+//
+// Only the owner of the source wallet can transfer the money, the owner is the authenticated user in the metadata of the context.
+func (a *API) Transfer(ctx context.Context, req *walletv1.TransferRequest) (*walletv1.TransferResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	curr, err := currency.Currencies.GetByName(req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+	amount, err := currency.ParseMoney(req.GetAmount(), curr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", wallet.ErrInvalidAmount, err)
+	}
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: transfer amount must be positive", wallet.ErrInvalidAmount)
+	}
+	if req.GetFromWalletId() == req.GetToWalletId() {
+		return nil, fmt.Errorf("%w: cannot transfer to the same wallet %s", wallet.ErrInvalidTransfer, req.GetFromWalletId())
+	}
+	var userID string
+	if md, err := services.NewGRPCMetadataRetriever(ctx); err == nil {
+		userID = md.UserID()
+	}
+	if userID == "" {
+		return nil, fmt.Errorf("%w: the user is not authenticated", wallet.ErrWalletNotOwned)
+	}
+
+	resp, err := a.transfer(ctx, userID, req, amount)
+	// The unique violation happens when the transfer with the same idempotency key is recorded concurrently. Retry once, so the
+	// recorded transfer is returned.
+	if errors.Is(err, postgres.ErrUniqueViolation) {
+		return a.transfer(ctx, userID, req, amount)
+	}
+	return resp, err
+}
+
+func (a *API) transfer(ctx context.Context, userID string, req *walletv1.TransferRequest, amount currency.Money) (*walletv1.TransferResponse, error) {
+	transactionParams := walletpg.GetWalletTransactionByIdempotencyKeyParams{
+		TransactionType: int32(walletv1.TransactionType_TX_TYPE_TRANSFER),
+		IdempotencyKey:  req.GetIdempotencyKey(),
+	}
+	recorded, err := a.queries.GetWalletTransactionByIdempotencyKey(ctx, transactionParams)
+	if err == nil {
+		return a.replayTransfer(ctx, userID, req, amount, recorded)
+	}
+	if !errors.Is(err, postgres.ErrNoRows) {
+		return nil, err
+	}
+
+	fromWallet, err := a.transferWallet(ctx, req.GetFromWalletId(), amount)
+	if err != nil {
+		return nil, err
+	}
+	if fromWallet.UserID != userID {
+		return nil, fmt.Errorf("%w: wallet %s", wallet.ErrWalletNotOwned, fromWallet.WalletID)
+	}
+	toWallet, err := a.transferWallet(ctx, req.GetToWalletId(), amount)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := walletpg.WalletTransfer{
+		TransactionID: uuid.NewString(),
+		FromWalletID:  fromWallet.WalletID,
+		ToWalletID:    toWallet.WalletID,
+		Amount:        amount.Amount(),
+		CreatedAt:     time.Now(),
+	}
+	transaction := walletpg.WalletTransaction{
+		TransactionID:     transfer.TransactionID,
+		TransactionType:   transactionParams.TransactionType,
+		TransactionStatus: int32(walletv1.TransactionStatus_TRANSACTION_STATUS_SUCCESS),
+		IdempotencyKey:    req.GetIdempotencyKey(),
+		CreatedAt:         transfer.CreatedAt,
+		// The transfer is finished immediately as the money never leaves the wallet system.
+		FinishedAt: sql.NullTime{Time: transfer.CreatedAt, Valid: true},
+	}
+
+	var transferRecorded bool
+	_, err = a.ledger.Transact(ctx, &ledgerv1.TransactRequest{
+		IdempotencyKey: "wallet_transfer:" + req.GetIdempotencyKey(),
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{
+				FromAccountId: fromWallet.LedgerAccountID,
+				ToAccountId:   toWallet.LedgerAccountID,
+				Amount:        amount.Amount().String(),
+				ClientId:      transfer.TransactionID,
+			},
+		},
+	}, func(ctx context.Context, pg *postgres.Postgres, _ ledger.MovementInfo) error {
+		q := walletpg.New(pg)
+		// The wallets are checked again under lock as the status of the wallets can be changed after they are checked, the lock
+		// prevents the status from being changed until the transfer is recorded.
+		for _, walletID := range []string{fromWallet.WalletID, toWallet.WalletID} {
+			account, err := q.GetWalletForShare(ctx, walletID)
+			if err != nil {
+				return err
+			}
+			if account.WalletStatus != int32(walletv1.WalletStatus_WALLET_STATUS_ACTIVE) {
+				return fmt.Errorf("%w: wallet %s is %s", wallet.ErrWalletNotActive, walletID, walletv1.WalletStatus(account.WalletStatus))
+			}
+		}
+		if err := q.CreateWalletTransaction(ctx, walletpg.CreateWalletTransactionParams{
+			TransactionID:     transaction.TransactionID,
+			TransactionType:   transaction.TransactionType,
+			TransactionStatus: transaction.TransactionStatus,
+			IdempotencyKey:    transaction.IdempotencyKey,
+			CreatedAt:         transaction.CreatedAt,
+			FinishedAt:        transaction.FinishedAt,
+		}); err != nil {
+			return err
+		}
+		err := q.CreateWalletTransfer(ctx, walletpg.CreateWalletTransferParams{
+			TransactionID: transfer.TransactionID,
+			FromWalletID:  transfer.FromWalletID,
+			ToWalletID:    transfer.ToWalletID,
+			Amount:        transfer.Amount,
+			CreatedAt:     transfer.CreatedAt,
+		})
+		transferRecorded = err == nil
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The ledger replays the movement without invoking the function when the movement with the same idempotency key is recorded
+	// concurrently. In this case the transfer is recorded by the other request, so we return the recorded transfer.
+	if !transferRecorded {
+		recorded, err := a.queries.GetWalletTransactionByIdempotencyKey(ctx, transactionParams)
+		if err != nil {
+			return nil, err
+		}
+		return a.replayTransfer(ctx, userID, req, amount, recorded)
+	}
+	return newTransferResponse(transfer, transaction, amount.Currency()), nil
+}
+
+// transferWallet returns the wallet of the transfer. Only the active main and savings wallets of the users can send and receive the
+// transfer, as the other wallets are managed by the wallet system.
+func (a *API) transferWallet(ctx context.Context, walletID string, amount currency.Money) (walletpg.WalletAccount, error) {
+	account, err := a.queries.GetWallet(ctx, walletID)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return walletpg.WalletAccount{}, fmt.Errorf("%w: %s", wallet.ErrWalletNotFound, walletID)
+		}
+		return walletpg.WalletAccount{}, err
+	}
+	if account.WalletOwner != int32(walletv1.WalletOwner_WALLET_OWNER_USER) {
+		return walletpg.WalletAccount{}, fmt.Errorf("%w: wallet %s is not owned by a user", wallet.ErrInvalidTransfer, walletID)
+	}
+	switch walletv1.WalletType(account.WalletType) {
+	case walletv1.WalletType_WALLET_TYPE_MAIN, walletv1.WalletType_WALLET_TYPE_SAVINGS:
+	default:
+		return walletpg.WalletAccount{}, fmt.Errorf("%w: cannot transfer with %s wallet %s", wallet.ErrInvalidTransfer, walletv1.WalletType(account.WalletType), walletID)
+	}
+	if account.WalletStatus != int32(walletv1.WalletStatus_WALLET_STATUS_ACTIVE) {
+		return walletpg.WalletAccount{}, fmt.Errorf("%w: wallet %s is %s", wallet.ErrWalletNotActive, walletID, walletv1.WalletStatus(account.WalletStatus))
+	}
+	if account.CurrencyID != amount.CurrencyID() {
+		return walletpg.WalletAccount{}, fmt.Errorf("%w: wallet %s doesn't accept %s", wallet.ErrWalletCurrencyMismatch, walletID, amount.Currency().Name)
+	}
+	return account, nil
+}
+
+// replayTransfer returns the recorded transfer of the idempotency key. The transfer must be recorded for the same wallets and amount,
+// otherwise the idempotency key is re-used for a different transfer. The recorded transfer is only returned to the owner of the source
+// wallet.
+func (a *API) replayTransfer(ctx context.Context, userID string, req *walletv1.TransferRequest, amount currency.Money, transaction walletpg.WalletTransaction) (*walletv1.TransferResponse, error) {
+	transfer, err := a.queries.GetWalletTransfer(ctx, transaction.TransactionID)
+	if err != nil {
+		return nil, err
+	}
+	fromWallet, err := a.queries.GetWallet(ctx, transfer.FromWalletID)
+	if err != nil {
+		return nil, err
+	}
+	if fromWallet.UserID != userID {
+		return nil, fmt.Errorf("%w: wallet %s", wallet.ErrWalletNotOwned, fromWallet.WalletID)
+	}
+	if transfer.FromWalletID != req.GetFromWalletId() || transfer.ToWalletID != req.GetToWalletId() ||
+		fromWallet.CurrencyID != amount.CurrencyID() || !transfer.Amount.Equal(amount.Amount()) {
+		return nil, fmt.Errorf("%w: %s", wallet.ErrTransferConflict, req.GetIdempotencyKey())
+	}
+	return newTransferResponse(transfer, transaction, amount.Currency()), nil
+}
+
+func newTransferResponse(transfer walletpg.WalletTransfer, transaction walletpg.WalletTransaction, curr *currency.Currency) *walletv1.TransferResponse {
+	return &walletv1.TransferResponse{
+		TransactionId:     transfer.TransactionID,
+		FromWalletId:      transfer.FromWalletID,
+		ToWalletId:        transfer.ToWalletID,
+		Amount:            transfer.Amount.String(),
+		Currency:          curr.Name,
+		TransactionStatus: walletv1.TransactionStatus(transaction.TransactionStatus),
+		CreatedAt:         timestamppb.New(transfer.CreatedAt),
+	}
+}
@@ -0,0 +1,240 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/studio-asd/pkg/postgres"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+	"github.com/studio-asd/go-example/services"
+	"github.com/studio-asd/go-example/services/ledger"
+	ledgerapi "github.com/studio-asd/go-example/services/ledger/api"
+	"github.com/studio-asd/go-example/services/wallet"
+	walletpg "github.com/studio-asd/go-example/services/wallet/internal/postgres"
+)
+
+func TestTransfer(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "public")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), ledgerapi.New(th.Postgres(), ledgerapi.Options{}), Options{})
+
+	createWallet := func(t *testing.T, userID string, walletType walletv1.WalletType) string {
+		t.Helper()
+		resp, err := api.CreateWalletAccount(context.Background(), &walletv1.CreateWalletAccountRequest{
+			UserId:     userID,
+			WalletType: walletType,
+			Currency:   "IDR",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.GetWalletId()
+	}
+	user1Main := createWallet(t, "user_1", walletv1.WalletType_WALLET_TYPE_MAIN)
+	user1Savings := createWallet(t, "user_1", walletv1.WalletType_WALLET_TYPE_SAVINGS)
+	user2Main := createWallet(t, "user_2", walletv1.WalletType_WALLET_TYPE_MAIN)
+	if _, err := api.Deposit(context.Background(), &walletv1.DepositRequest{
+		UserId:           "user_1",
+		Amount:           "10000",
+		Currency:         "IDR",
+		ChannelReference: "payment_1",
+		Channel: &walletv1.DepositRequest_Bank_{
+			Bank: &walletv1.DepositRequest_Bank{BankName: "bca"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	expectBalance := func(t *testing.T, walletID, expect string) {
+		t.Helper()
+		balance, err := api.walletBalance(context.Background(), walletID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balance.GetWalletBalance() != expect {
+			t.Fatalf("expecting balance of wallet %s to be %s but got %s", walletID, expect, balance.GetWalletBalance())
+		}
+	}
+	transferReq := func(idempotencyKey, from, to, amount string) *walletv1.TransferRequest {
+		return &walletv1.TransferRequest{
+			FromWalletId:   from,
+			ToWalletId:     to,
+			Amount:         amount,
+			Currency:       "IDR",
+			IdempotencyKey: idempotencyKey,
+		}
+	}
+
+	// userCtx returns the context of the authenticated user, the same as the context set by the server after the authorization.
+	userCtx := func(userID string) context.Context {
+		return services.SetGRPCMetadataToContext(context.Background(), map[string]string{services.MetadataUserID: userID})
+	}
+
+	t.Run("transfer", func(t *testing.T) {
+		transferred, err := api.Transfer(userCtx("user_1"), transferReq("transfer_1", user1Main, user2Main, "3000"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if transferred.GetTransactionStatus() != walletv1.TransactionStatus_TRANSACTION_STATUS_SUCCESS {
+			t.Fatalf("expecting status %s but got %s", walletv1.TransactionStatus_TRANSACTION_STATUS_SUCCESS, transferred.GetTransactionStatus())
+		}
+		expectBalance(t, user1Main, "7000")
+		expectBalance(t, user2Main, "3000")
+
+		if _, err := api.Transfer(userCtx("user_1"), transferReq("transfer_2", user1Main, user1Savings, "2000")); err != nil {
+			t.Fatal(err)
+		}
+		expectBalance(t, user1Main, "5000")
+		expectBalance(t, user1Savings, "2000")
+	})
+
+	t.Run("idempotent", func(t *testing.T) {
+		first, err := api.Transfer(userCtx("user_2"), transferReq("transfer_3", user2Main, user1Main, "1000"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		replayed, err := api.Transfer(userCtx("user_2"), transferReq("transfer_3", user2Main, user1Main, "1000"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if replayed.GetTransactionId() != first.GetTransactionId() {
+			t.Fatalf("expecting transaction %s but got %s", first.GetTransactionId(), replayed.GetTransactionId())
+		}
+		expectBalance(t, user2Main, "2000")
+
+		_, err = api.Transfer(userCtx("user_2"), transferReq("transfer_3", user2Main, user1Main, "500"))
+		if !errors.Is(err, wallet.ErrTransferConflict) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrTransferConflict, err)
+		}
+		_, err = api.Transfer(userCtx("user_2"), transferReq("transfer_3", user2Main, user1Savings, "1000"))
+		if !errors.Is(err, wallet.ErrTransferConflict) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrTransferConflict, err)
+		}
+		// The recorded transfer is only replayed for the owner of the source wallet.
+		_, err = api.Transfer(userCtx("user_1"), transferReq("transfer_3", user2Main, user1Main, "1000"))
+		if !errors.Is(err, wallet.ErrWalletNotOwned) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrWalletNotOwned, err)
+		}
+	})
+
+	t.Run("invalid transfer", func(t *testing.T) {
+		walletUser, err := api.queries.GetWalletUser(context.Background(), "user_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		depositWallet, err := api.queries.GetSystemWallet(context.Background(), walletpg.GetSystemWalletParams{
+			WalletType: int32(walletv1.WalletType_WALLET_TYPE_DEPOSIT),
+			CurrencyID: currency.IDR.ID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		tests := []struct {
+			name   string
+			req    *walletv1.TransferRequest
+			expect error
+		}{
+			{
+				name:   "same wallet",
+				req:    transferReq("invalid_1", user1Main, user1Main, "1000"),
+				expect: wallet.ErrInvalidTransfer,
+			},
+			{
+				name:   "intermediary wallet",
+				req:    transferReq("invalid_2", user1Main, walletUser.IntermediaryWalletID, "1000"),
+				expect: wallet.ErrInvalidTransfer,
+			},
+			{
+				name:   "system wallet",
+				req:    transferReq("invalid_3", depositWallet.WalletID, user1Main, "1000"),
+				expect: wallet.ErrInvalidTransfer,
+			},
+			{
+				name:   "wallet not found",
+				req:    transferReq("invalid_4", user1Main, "not_found", "1000"),
+				expect: wallet.ErrWalletNotFound,
+			},
+			{
+				name:   "negative amount",
+				req:    transferReq("invalid_5", user1Main, user2Main, "-1000"),
+				expect: wallet.ErrInvalidAmount,
+			},
+			{
+				name:   "insufficient balance",
+				req:    transferReq("invalid_6", user1Main, user2Main, "100000"),
+				expect: ledger.ErrInsufficientBalance,
+			},
+			{
+				name:   "wallet of other user",
+				req:    transferReq("invalid_7", user2Main, user1Main, "1000"),
+				expect: wallet.ErrWalletNotOwned,
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := api.Transfer(userCtx("user_1"), test.req)
+				if !errors.Is(err, test.expect) {
+					t.Fatalf("expecting error %v but got %v", test.expect, err)
+				}
+			})
+		}
+
+		_, err = api.Transfer(context.Background(), transferReq("invalid_8", user1Main, user2Main, "1000"))
+		if !errors.Is(err, wallet.ErrWalletNotOwned) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrWalletNotOwned, err)
+		}
+	})
+
+	t.Run("suspended wallet", func(t *testing.T) {
+		if _, err := th.Postgres().Exec(
+			context.Background(),
+			"UPDATE wallet_accounts SET wallet_status = $1 WHERE wallet_id = $2",
+			int32(walletv1.WalletStatus_WALLET_STATUS_SUSPENDED),
+			user2Main,
+		); err != nil {
+			t.Fatal(err)
+		}
+		_, err := api.Transfer(userCtx("user_1"), transferReq("suspended_1", user1Main, user2Main, "1000"))
+		if !errors.Is(err, wallet.ErrWalletNotActive) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrWalletNotActive, err)
+		}
+		_, err = api.Transfer(userCtx("user_2"), transferReq("suspended_2", user2Main, user1Main, "1000"))
+		if !errors.Is(err, wallet.ErrWalletNotActive) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrWalletNotActive, err)
+		}
+		expectBalance(t, user1Main, "6000")
+		expectBalance(t, user2Main, "2000")
+	})
+
+	// The wallet is suspended after it is checked but before the transfer is recorded, the transfer must be rejected when the
+	// wallet is checked again under lock.
+	t.Run("suspended during transfer", func(t *testing.T) {
+		api.ledger.AddMovementPreCommitHook(ledgerapi.PreCommitHook[ledger.MovementInfo]{
+			Name: "suspend_wallet",
+			Fn: func(ctx context.Context, tx *postgres.Postgres, info ledger.MovementInfo) error {
+				_, err := tx.Exec(
+					ctx,
+					"UPDATE wallet_accounts SET wallet_status = $1 WHERE wallet_id = $2",
+					int32(walletv1.WalletStatus_WALLET_STATUS_SUSPENDED),
+					user1Savings,
+				)
+				return err
+			},
+		})
+		_, err := api.Transfer(userCtx("user_1"), transferReq("suspended_3", user1Main, user1Savings, "1000"))
+		if !errors.Is(err, wallet.ErrWalletNotActive) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrWalletNotActive, err)
+		}
+		expectBalance(t, user1Main, "6000")
+		expectBalance(t, user1Savings, "2000")
+	})
+}
@@ -0,0 +1,256 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/studio-asd/pkg/postgres"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	"github.com/studio-asd/go-example/services/wallet"
+	walletpg "github.com/studio-asd/go-example/services/wallet/internal/postgres"
+)
+
+// systemUserID is the user id of the wallets owned by the system.
+const systemUserID = "system"
+
+// newWallet is the wallet to be created along with its ledger account.
+type newWallet struct {
+	walletID      string
+	walletType    walletv1.WalletType
+	allowNegative bool
+}
+
+// CreateWalletAccount creates a wallet of the user backed by a new ledger account. The wallet user along with its intermediary and
+// chargeback wallets are created with the first wallet of the user, and all wallets of the user share the same currency. The wallets
+// are created inside the transaction of the ledger accounts, so a wallet never exists without its ledger account.
+func (a *API) CreateWalletAccount(ctx context.Context, req *walletv1.CreateWalletAccountRequest) (*walletv1.CreateWalletAccountResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	switch req.GetWalletType() {
+	case walletv1.WalletType_WALLET_TYPE_MAIN, walletv1.WalletType_WALLET_TYPE_SAVINGS:
+	default:
+		return nil, fmt.Errorf("%w: %s cannot be created for the user", wallet.ErrInvalidWalletType, req.GetWalletType())
+	}
+	curr, err := currency.Currencies.GetByName(req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := a.createWalletAccount(ctx, req, curr)
+	// The unique violation happens when the wallet user or the main wallet is created concurrently. Retry once, so the request is
+	// checked against the wallets that are already created.
+	if errors.Is(err, postgres.ErrUniqueViolation) {
+		return a.createWalletAccount(ctx, req, curr)
+	}
+	return resp, err
+}
+
+func (a *API) createWalletAccount(ctx context.Context, req *walletv1.CreateWalletAccountRequest, curr *currency.Currency) (*walletv1.CreateWalletAccountResponse, error) {
+	walletUser, err := a.queries.GetWalletUser(ctx, req.GetUserId())
+	if err != nil && !errors.Is(err, postgres.ErrNoRows) {
+		return nil, err
+	}
+	userExists := err == nil
+	if userExists {
+		// The intermediary wallet is used by all wallets of the user, so the currency of the new wallet must be the same with it.
+		intermediaryWallet, err := a.queries.GetWallet(ctx, walletUser.IntermediaryWalletID)
+		if err != nil {
+			return nil, err
+		}
+		if intermediaryWallet.CurrencyID != curr.ID {
+			return nil, fmt.Errorf("%w: expecting currency id %d but got %s", wallet.ErrWalletCurrencyMismatch, intermediaryWallet.CurrencyID, curr.Name)
+		}
+		if req.GetWalletType() == walletv1.WalletType_WALLET_TYPE_MAIN {
+			_, err := a.queries.GetUserWalletByType(ctx, walletpg.GetUserWalletByTypeParams{
+				UserID:     req.GetUserId(),
+				WalletType: int32(walletv1.WalletType_WALLET_TYPE_MAIN),
+			})
+			if err == nil {
+				return nil, fmt.Errorf("%w: user %s already has a main wallet", wallet.ErrWalletAlreadyExists, req.GetUserId())
+			}
+			if !errors.Is(err, postgres.ErrNoRows) {
+				return nil, err
+			}
+		}
+	}
+
+	wallets := []newWallet{{walletID: uuid.NewString(), walletType: req.GetWalletType()}}
+	if !userExists {
+		wallets = append(wallets,
+			newWallet{walletID: uuid.NewString(), walletType: walletv1.WalletType_WALLET_TYPE_INTERMEDIARY},
+			// The chargeback wallet records the amount owed by the user, so its balance goes below zero when the user is charged.
+			newWallet{walletID: uuid.NewString(), walletType: walletv1.WalletType_WALLET_TYE_CHARGEBACK, allowNegative: true},
+		)
+	}
+	ledgerReq := &ledgerv1.CreateLedgerAccountsRequest{
+		Accounts: make([]*ledgerv1.CreateLedgerAccountsRequest_Account, len(wallets)),
+	}
+	for idx, w := range wallets {
+		ledgerReq.Accounts[idx] = &ledgerv1.CreateLedgerAccountsRequest_Account{
+			Name:          walletLedgerAccountName(w.walletType, req.GetUserId()),
+			AllowNegative: w.allowNegative,
+			CurrencyId:    curr.ID,
+			Description:   fmt.Sprintf("%s wallet %s of user %s", w.walletType, w.walletID, req.GetUserId()),
+		}
+	}
+
+	createdAt := time.Now()
+	_, err = a.ledger.CreateAccounts(ctx, ledgerReq, func(ctx context.Context, pg *postgres.Postgres, accounts []ledger.AccountInfo) error {
+		q := walletpg.New(pg)
+		if !userExists {
+			if err := q.CreateWalletUser(ctx, walletpg.CreateWalletUserParams{
+				UserID:               req.GetUserId(),
+				UserType:             walletv1.WalletUser_WALLET_USER_USER.String(),
+				UserStatus:           int32(walletv1.WalletStatus_WALLET_STATUS_ACTIVE),
+				IntermediaryWalletID: wallets[1].walletID,
+				ChargebackWalletID:   wallets[2].walletID,
+				CreatedAt:            createdAt,
+			}); err != nil {
+				return err
+			}
+		}
+		// The accounts info is ordered the same with the accounts inside the request.
+		for idx, account := range accounts {
+			if err := q.CreateWallet(ctx, walletpg.CreateWalletParams{
+				WalletID:        wallets[idx].walletID,
+				LedgerAccountID: account.AccountID,
+				UserID:          req.GetUserId(),
+				WalletStatus:    int32(walletv1.WalletStatus_WALLET_STATUS_ACTIVE),
+				WalletOwner:     int32(walletv1.WalletOwner_WALLET_OWNER_USER),
+				WalletType:      int32(wallets[idx].walletType),
+				CurrencyID:      curr.ID,
+				CreatedAt:       createdAt,
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &walletv1.CreateWalletAccountResponse{
+		WalletId:     wallets[0].walletID,
+		WalletStatus: walletv1.WalletStatus_WALLET_STATUS_ACTIVE,
+		CreatedAt:    timestamppb.New(createdAt),
+	}, nil
+}
+
+// GetWalletBalance returns the balance of the wallet, or the balance of the main wallet of the user when the user_id is used.
+func (a *API) GetWalletBalance(ctx context.Context, req *walletv1.GetWalletBalanceRequest) (*walletv1.GetWalletBalanceResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+
+	walletID := req.GetWalletId()
+	if req.GetUserId() != "" {
+		mainWallet, err := a.queries.GetUserWalletByType(ctx, walletpg.GetUserWalletByTypeParams{
+			UserID:     req.GetUserId(),
+			WalletType: int32(walletv1.WalletType_WALLET_TYPE_MAIN),
+		})
+		if err != nil {
+			if errors.Is(err, postgres.ErrNoRows) {
+				return nil, fmt.Errorf("%w: user %s doesn't have a main wallet", wallet.ErrWalletNotFound, req.GetUserId())
+			}
+			return nil, err
+		}
+		walletID = mainWallet.WalletID
+	}
+	return a.walletBalance(ctx, walletID)
+}
+
+// walletBalance returns the balance of the wallet from the balance of its ledger account.
+func (a *API) walletBalance(ctx context.Context, walletID string) (*walletv1.GetWalletBalanceResponse, error) {
+	walletAccount, err := a.queries.GetWallet(ctx, walletID)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", wallet.ErrWalletNotFound, walletID)
+		}
+		return nil, err
+	}
+	balances, err := a.ledger.GetAccountsBalance(ctx, &ledgerv1.GetAccountsBalanceRequest{
+		AccountIds: []string{walletAccount.LedgerAccountID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(balances.GetBalances()) == 0 {
+		return nil, fmt.Errorf("%w: ledger account %s of wallet %s", ledger.ErrAccountNotFound, walletAccount.LedgerAccountID, walletID)
+	}
+	balance := balances.GetBalances()[0]
+	curr, err := currency.Currencies.GetByID(balance.GetCurrencyId())
+	if err != nil {
+		return nil, err
+	}
+	return &walletv1.GetWalletBalanceResponse{
+		WalletId:         walletAccount.WalletID,
+		WalletBalance:    balance.GetBalance(),
+		WalletStatus:     walletv1.WalletStatus(walletAccount.WalletStatus),
+		Currency:         curr.Name,
+		AvailableBalance: balance.GetAvailableBalance(),
+		UpdatedAt:        balance.GetUpdatedAt(),
+	}, nil
+}
+
+// systemWallet returns the wallet owned by the system for the type and currency. The wallet is created along with its ledger account
+// when the wallet doesn't exist yet.
+func (a *API) systemWallet(ctx context.Context, walletType walletv1.WalletType, curr *currency.Currency) (walletpg.WalletAccount, error) {
+	params := walletpg.GetSystemWalletParams{
+		WalletType: int32(walletType),
+		CurrencyID: curr.ID,
+	}
+	systemWallet, err := a.queries.GetSystemWallet(ctx, params)
+	if !errors.Is(err, postgres.ErrNoRows) {
+		return systemWallet, err
+	}
+
+	walletID := uuid.NewString()
+	createdAt := time.Now()
+	_, err = a.ledger.CreateAccounts(ctx, &ledgerv1.CreateLedgerAccountsRequest{
+		Accounts: []*ledgerv1.CreateLedgerAccountsRequest_Account{
+			{
+				Name: walletLedgerAccountName(walletType, systemUserID+"_"+curr.Name),
+				// The deposit and loan wallets are where the money enters the system, so their balances go below zero for every deposit.
+				AllowNegative: walletType == walletv1.WalletType_WALLET_TYPE_DEPOSIT || walletType == walletv1.WalletType_WALLET_TYPE_LOAN,
+				CurrencyId:    curr.ID,
+				Description:   fmt.Sprintf("%s system wallet %s for %s", walletType, walletID, curr.Name),
+			},
+		},
+	}, func(ctx context.Context, pg *postgres.Postgres, accounts []ledger.AccountInfo) error {
+		return walletpg.New(pg).CreateWallet(ctx, walletpg.CreateWalletParams{
+			WalletID:        walletID,
+			LedgerAccountID: accounts[0].AccountID,
+			UserID:          systemUserID,
+			WalletStatus:    int32(walletv1.WalletStatus_WALLET_STATUS_ACTIVE),
+			WalletOwner:     int32(walletv1.WalletOwner_WALLET_OWNER_SYSTEM),
+			WalletType:      int32(walletType),
+			CurrencyID:      curr.ID,
+			CreatedAt:       createdAt,
+		})
+	})
+	// The unique violation happens when the system wallet is created concurrently, so we can use the wallet that is already created.
+	if err != nil && !errors.Is(err, postgres.ErrUniqueViolation) {
+		return walletpg.WalletAccount{}, err
+	}
+	return a.queries.GetSystemWallet(ctx, params)
+}
+
+// walletLedgerAccountName returns the name of the ledger account of the wallet, for example wallet_main_user_id.
+func walletLedgerAccountName(walletType walletv1.WalletType, userID string) string {
+	name := strings.ToLower(strings.TrimPrefix(walletType.String(), "WALLET_TYPE_"))
+	// The enum name of the chargeback wallet has a typo, so normalize it to keep the account name readable.
+	if walletType == walletv1.WalletType_WALLET_TYE_CHARGEBACK {
+		name = "chargeback"
+	}
+	return "wallet_" + name + "_" + userID
+}
@@ -0,0 +1,155 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+	ledgerapi "github.com/studio-asd/go-example/services/ledger/api"
+	"github.com/studio-asd/go-example/services/wallet"
+)
+
+func TestCreateWalletAccount(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "public")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), ledgerapi.New(th.Postgres(), ledgerapi.Options{}), Options{})
+
+	created, err := api.CreateWalletAccount(context.Background(), &walletv1.CreateWalletAccountRequest{
+		UserId:     "user_1",
+		WalletType: walletv1.WalletType_WALLET_TYPE_MAIN,
+		Currency:   "IDR",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("user wallets", func(t *testing.T) {
+		walletUser, err := api.queries.GetWalletUser(context.Background(), "user_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		wallets, err := api.queries.GetUserWallets(context.Background(), "user_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The intermediary and chargeback wallets are created along with the first wallet of the user.
+		if len(wallets) != 3 {
+			t.Fatalf("expecting 3 wallets but got %d", len(wallets))
+		}
+		expect := map[string]walletv1.WalletType{
+			created.GetWalletId():           walletv1.WalletType_WALLET_TYPE_MAIN,
+			walletUser.IntermediaryWalletID: walletv1.WalletType_WALLET_TYPE_INTERMEDIARY,
+			walletUser.ChargebackWalletID:   walletv1.WalletType_WALLET_TYE_CHARGEBACK,
+		}
+		for _, w := range wallets {
+			if walletv1.WalletType(w.WalletType) != expect[w.WalletID] {
+				t.Fatalf("expecting wallet %s to be %s but got %s", w.WalletID, expect[w.WalletID], walletv1.WalletType(w.WalletType))
+			}
+		}
+	})
+
+	t.Run("balance", func(t *testing.T) {
+		byUser, err := api.GetWalletBalance(context.Background(), &walletv1.GetWalletBalanceRequest{
+			FilterParams: &walletv1.GetWalletBalanceRequest_UserId{UserId: "user_1"},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if byUser.GetWalletId() != created.GetWalletId() {
+			t.Fatalf("expecting wallet %s but got %s", created.GetWalletId(), byUser.GetWalletId())
+		}
+		if byUser.GetWalletBalance() != "0" || byUser.GetCurrency() != "IDR" {
+			t.Fatalf("expecting balance 0 IDR but got %s %s", byUser.GetWalletBalance(), byUser.GetCurrency())
+		}
+		_, err = api.GetWalletBalance(context.Background(), &walletv1.GetWalletBalanceRequest{
+			FilterParams: &walletv1.GetWalletBalanceRequest_UserId{UserId: "not_found"},
+		})
+		if !errors.Is(err, wallet.ErrWalletNotFound) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrWalletNotFound, err)
+		}
+	})
+
+	t.Run("savings wallet", func(t *testing.T) {
+		if _, err := api.CreateWalletAccount(context.Background(), &walletv1.CreateWalletAccountRequest{
+			UserId:     "user_1",
+			WalletType: walletv1.WalletType_WALLET_TYPE_SAVINGS,
+			Currency:   "IDR",
+		}); err != nil {
+			t.Fatal(err)
+		}
+		wallets, err := api.queries.GetUserWallets(context.Background(), "user_1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(wallets) != 4 {
+			t.Fatalf("expecting 4 wallets but got %d", len(wallets))
+		}
+	})
+
+	tests := []struct {
+		name   string
+		req    *walletv1.CreateWalletAccountRequest
+		expect error
+	}{
+		{
+			name: "duplicate main wallet",
+			req: &walletv1.CreateWalletAccountRequest{
+				UserId:     "user_1",
+				WalletType: walletv1.WalletType_WALLET_TYPE_MAIN,
+				Currency:   "IDR",
+			},
+			expect: wallet.ErrWalletAlreadyExists,
+		},
+		{
+			name: "currency mismatch",
+			req: &walletv1.CreateWalletAccountRequest{
+				UserId:     "user_1",
+				WalletType: walletv1.WalletType_WALLET_TYPE_SAVINGS,
+				Currency:   "USD",
+			},
+			expect: wallet.ErrWalletCurrencyMismatch,
+		},
+		{
+			name: "intermediary wallet",
+			req: &walletv1.CreateWalletAccountRequest{
+				UserId:     "user_2",
+				WalletType: walletv1.WalletType_WALLET_TYPE_INTERMEDIARY,
+				Currency:   "IDR",
+			},
+			expect: wallet.ErrInvalidWalletType,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := api.CreateWalletAccount(context.Background(), test.req)
+			if !errors.Is(err, test.expect) {
+				t.Fatalf("expecting error %v but got %v", test.expect, err)
+			}
+		})
+	}
+}
+
+func TestWalletLedgerAccountName(t *testing.T) {
+	tests := []struct {
+		walletType walletv1.WalletType
+		expect     string
+	}{
+		{walletType: walletv1.WalletType_WALLET_TYPE_MAIN, expect: "wallet_main_user_1"},
+		{walletType: walletv1.WalletType_WALLET_TYPE_INTERMEDIARY, expect: "wallet_intermediary_user_1"},
+		{walletType: walletv1.WalletType_WALLET_TYE_CHARGEBACK, expect: "wallet_chargeback_user_1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.walletType.String(), func(t *testing.T) {
+			if got := walletLedgerAccountName(test.walletType, "user_1"); got != test.expect {
+				t.Fatalf("expecting account name %s but got %s", test.expect, got)
+			}
+		})
+	}
+}
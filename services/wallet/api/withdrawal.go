@@ -0,0 +1,494 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/studio-asd/pkg/postgres"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	"github.com/studio-asd/go-example/services/wallet"
+	walletpg "github.com/studio-asd/go-example/services/wallet/internal/postgres"
+)
+
+// CreateWithdrawal moves the amount of the withdrawal from the main wallet to the intermediary wallet of the user. The withdrawal is
+// pending until the transfer to the bank or e-wallet is confirmed, failed or cancelled. Parking the money in the intermediary wallet
+// keeps the global withdrawal wallet free from the money that might go back to the user. The withdrawal with a payment gateway vendor
+// is disbursed through the payment gateway right away, and transitioned based on the result of the disbursement.
+func (a *API) CreateWithdrawal(ctx context.Context, req *walletv1.CreateWithdrawalRequest) (*walletv1.CreateWithdrawalResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	curr, err := currency.Currencies.GetByName(req.GetCurrency())
+	if err != nil {
+		return nil, err
+	}
+	amount, err := currency.ParseMoney(req.GetAmount(), curr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", wallet.ErrInvalidAmount, err)
+	}
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: withdrawal amount must be positive", wallet.ErrInvalidAmount)
+	}
+	var gateway wallet.PaymentGateway
+	if req.GetPaymentGatewayVendor() != "" {
+		gateway, err = a.paymentGateway(req.GetPaymentGatewayVendor())
+		if err != nil {
+			return nil, err
+		}
+	}
+	channel, destination := withdrawalChannel(req)
+	fee, err := a.withdrawalFee(ctx, channel, amount)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawal, err := a.createWithdrawal(ctx, req, amount, fee)
+	// The unique violation happens when the withdrawal with the same idempotency key is recorded concurrently. Retry once, so the
+	// recorded withdrawal is returned.
+	if errors.Is(err, postgres.ErrUniqueViolation) {
+		withdrawal, err = a.createWithdrawal(ctx, req, amount, fee)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// The pending withdrawal is re-submitted when the request is retried, as the payment gateway only disburses the money once.
+	if gateway != nil {
+		withdrawal = a.submitDisbursement(ctx, gateway, withdrawal, destination)
+	}
+	return &walletv1.CreateWithdrawalResponse{Withdrawal: withdrawal}, nil
+}
+
+func (a *API) createWithdrawal(ctx context.Context, req *walletv1.CreateWithdrawalRequest, amount, fee currency.Money) (*walletv1.Withdrawal, error) {
+	transactionParams := walletpg.GetWalletTransactionByIdempotencyKeyParams{
+		TransactionType: int32(walletv1.TransactionType_TX_TYPE_WITHDRAWAL),
+		IdempotencyKey:  req.GetIdempotencyKey(),
+	}
+	recorded, err := a.queries.GetWalletTransactionByIdempotencyKey(ctx, transactionParams)
+	if err == nil {
+		return a.replayWithdrawal(ctx, req, amount, recorded.TransactionID)
+	}
+	if !errors.Is(err, postgres.ErrNoRows) {
+		return nil, err
+	}
+
+	walletUser, err := a.queries.GetWalletUser(ctx, req.GetUserId())
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", wallet.ErrWalletUserNotFound, req.GetUserId())
+		}
+		return nil, err
+	}
+	userWallet, err := a.queries.GetUserWalletByType(ctx, walletpg.GetUserWalletByTypeParams{
+		UserID:     req.GetUserId(),
+		WalletType: int32(walletv1.WalletType_WALLET_TYPE_MAIN),
+	})
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, fmt.Errorf("%w: user %s doesn't have a main wallet", wallet.ErrWalletNotFound, req.GetUserId())
+		}
+		return nil, err
+	}
+	if userWallet.WalletStatus != int32(walletv1.WalletStatus_WALLET_STATUS_ACTIVE) {
+		return nil, fmt.Errorf("%w: wallet %s is %s", wallet.ErrWalletNotActive, userWallet.WalletID, walletv1.WalletStatus(userWallet.WalletStatus))
+	}
+	if userWallet.CurrencyID != amount.CurrencyID() {
+		return nil, fmt.Errorf("%w: wallet %s doesn't accept %s", wallet.ErrWalletCurrencyMismatch, userWallet.WalletID, amount.Currency().Name)
+	}
+	intermediaryWallet, err := a.queries.GetWallet(ctx, walletUser.IntermediaryWalletID)
+	if err != nil {
+		return nil, err
+	}
+	withdrawalWallet, err := a.systemWallet(ctx, walletv1.WalletType_WALLET_TYPE_WITHDRAWAL, amount.Currency())
+	if err != nil {
+		return nil, err
+	}
+	finalAmount, err := amount.Sub(fee)
+	if err != nil {
+		return nil, err
+	}
+
+	channel, destination := withdrawalChannel(req)
+	withdrawal := walletpg.WalletWithdrawal{
+		TransactionID:            uuid.NewString(),
+		WithdrawalWalletID:       withdrawalWallet.WalletID,
+		UserWalletID:             userWallet.WalletID,
+		UserIntermediaryWalletID: intermediaryWallet.WalletID,
+		Amount:                   amount.Amount(),
+		WithdrawalFee:            fee.Amount(),
+		FinalAmount:              finalAmount.Amount(),
+		WithdrawalStatus:         int32(walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING),
+		WithdrawalChannel:        int32(channel),
+		WithdrawalViaPg:          req.GetPaymentGatewayVendor() != "",
+		WithdrawalPgVendor:       sql.NullString{String: req.GetPaymentGatewayVendor(), Valid: req.GetPaymentGatewayVendor() != ""},
+		CreatedAt:                time.Now(),
+	}
+
+	var withdrawalRecorded bool
+	_, err = a.ledger.Transact(ctx, &ledgerv1.TransactRequest{
+		IdempotencyKey: "wallet_withdrawal_create:" + req.GetIdempotencyKey(),
+		MovementEntries: []*ledgerv1.MovementEntry{
+			{
+				FromAccountId: userWallet.LedgerAccountID,
+				ToAccountId:   intermediaryWallet.LedgerAccountID,
+				Amount:        amount.Amount().String(),
+				ClientId:      withdrawal.TransactionID,
+			},
+		},
+	}, func(ctx context.Context, pg *postgres.Postgres, _ ledger.MovementInfo) error {
+		q := walletpg.New(pg)
+		if err := q.CreateWalletTransaction(ctx, walletpg.CreateWalletTransactionParams{
+			TransactionID:     withdrawal.TransactionID,
+			TransactionType:   transactionParams.TransactionType,
+			TransactionStatus: int32(walletv1.TransactionStatus_TRANSACTION_STATUS_PENDING),
+			IdempotencyKey:    req.GetIdempotencyKey(),
+			CreatedAt:         withdrawal.CreatedAt,
+		}); err != nil {
+			return err
+		}
+		if err := q.CreateWalletWithdrawal(ctx, walletpg.CreateWalletWithdrawalParams{
+			TransactionID:            withdrawal.TransactionID,
+			WithdrawalWalletID:       withdrawal.WithdrawalWalletID,
+			UserWalletID:             withdrawal.UserWalletID,
+			UserIntermediaryWalletID: withdrawal.UserIntermediaryWalletID,
+			Amount:                   withdrawal.Amount,
+			WithdrawalFee:            withdrawal.WithdrawalFee,
+			FinalAmount:              withdrawal.FinalAmount,
+			WithdrawalStatus:         withdrawal.WithdrawalStatus,
+			WithdrawalChannel:        withdrawal.WithdrawalChannel,
+			WithdrawalViaPg:          withdrawal.WithdrawalViaPg,
+			WithdrawalPgVendor:       withdrawal.WithdrawalPgVendor,
+			CreatedAt:                withdrawal.CreatedAt,
+		}); err != nil {
+			return err
+		}
+
+		var err error
+		switch channel {
+		case walletv1.WithdrawalChannel_CHANNEL_BANK:
+			err = q.CreateWalletBankWithdrawal(ctx, walletpg.CreateWalletBankWithdrawalParams{
+				TransactionID: withdrawal.TransactionID,
+				BankName:      destination,
+				CreatedAt:     withdrawal.CreatedAt,
+			})
+		case walletv1.WithdrawalChannel_CHANNEL_EWALLET:
+			err = q.CreateWalletEwalletWithdrawal(ctx, walletpg.CreateWalletEwalletWithdrawalParams{
+				TransactionID: withdrawal.TransactionID,
+				EwalletName:   destination,
+				CreatedAt:     withdrawal.CreatedAt,
+			})
+		}
+		withdrawalRecorded = err == nil
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The ledger replays the movement without invoking the function when the movement with the same idempotency key is recorded
+	// concurrently. In this case the withdrawal is recorded by the other request, so we return the recorded withdrawal.
+	if !withdrawalRecorded {
+		recorded, err := a.queries.GetWalletTransactionByIdempotencyKey(ctx, transactionParams)
+		if err != nil {
+			return nil, err
+		}
+		return a.replayWithdrawal(ctx, req, amount, recorded.TransactionID)
+	}
+	return newWithdrawal(withdrawal, amount.Currency()), nil
+}
+
+// replayWithdrawal returns the recorded withdrawal of the idempotency key. The withdrawal must be recorded for the same user and
+// amount, otherwise the idempotency key is re-used for a different withdrawal.
+func (a *API) replayWithdrawal(ctx context.Context, req *walletv1.CreateWithdrawalRequest, amount currency.Money, transactionID string) (*walletv1.Withdrawal, error) {
+	withdrawal, err := a.queries.GetWalletWithdrawal(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	userWallet, err := a.queries.GetWallet(ctx, withdrawal.UserWalletID)
+	if err != nil {
+		return nil, err
+	}
+	if userWallet.UserID != req.GetUserId() || userWallet.CurrencyID != amount.CurrencyID() || !withdrawal.Amount.Equal(amount.Amount()) {
+		return nil, fmt.Errorf("%w: %s", wallet.ErrWithdrawalConflict, req.GetIdempotencyKey())
+	}
+	return newWithdrawal(withdrawal, amount.Currency()), nil
+}
+
+// ConfirmWithdrawal moves the money of the pending withdrawal from the intermediary wallet to the withdrawal wallet, and the fee of the
+// withdrawal to the fee wallet.
+func (a *API) ConfirmWithdrawal(ctx context.Context, req *walletv1.ConfirmWithdrawalRequest) (*walletv1.ConfirmWithdrawalResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	withdrawal, err := a.transitionWithdrawal(ctx, req.GetTransactionId(), walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS)
+	if err != nil {
+		return nil, err
+	}
+	return &walletv1.ConfirmWithdrawalResponse{Withdrawal: withdrawal}, nil
+}
+
+// FailWithdrawal returns the money of the pending withdrawal from the intermediary wallet to the wallet of the user. The withdrawal
+// is failed when the bank or e-wallet rejects the transfer.
+func (a *API) FailWithdrawal(ctx context.Context, req *walletv1.FailWithdrawalRequest) (*walletv1.FailWithdrawalResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	withdrawal, err := a.transitionWithdrawal(ctx, req.GetTransactionId(), walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_FAILED)
+	if err != nil {
+		return nil, err
+	}
+	return &walletv1.FailWithdrawalResponse{Withdrawal: withdrawal}, nil
+}
+
+// CancelWithdrawal returns the money of the pending withdrawal from the intermediary wallet to the wallet of the user. The withdrawal
+// is cancelled when the transfer is aborted before it is sent to the bank or e-wallet.
+func (a *API) CancelWithdrawal(ctx context.Context, req *walletv1.CancelWithdrawalRequest) (*walletv1.CancelWithdrawalResponse, error) {
+	if err := validator.Validate(req); err != nil {
+		return nil, err
+	}
+	withdrawal, err := a.transitionWithdrawal(ctx, req.GetTransactionId(), walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_CANCELLED)
+	if err != nil {
+		return nil, err
+	}
+	return &walletv1.CancelWithdrawalResponse{Withdrawal: withdrawal}, nil
+}
+
+// transitionWithdrawal moves the pending withdrawal to the final status along with its money. Only the pending withdrawal can be
+// transitioned, but the transition to the current status of the withdrawal returns the withdrawal as is, because the callback of the
+// bank or payment gateway might be delivered more than once.
+func (a *API) transitionWithdrawal(ctx context.Context, transactionID string, status walletv1.WithdrawalStatus) (*walletv1.Withdrawal, error) {
+	withdrawal, err := a.queries.GetWalletWithdrawal(ctx, transactionID)
+	if err != nil {
+		if errors.Is(err, postgres.ErrNoRows) {
+			return nil, fmt.Errorf("%w: %s", wallet.ErrWithdrawalNotFound, transactionID)
+		}
+		return nil, err
+	}
+	userWallet, err := a.queries.GetWallet(ctx, withdrawal.UserWalletID)
+	if err != nil {
+		return nil, err
+	}
+	curr, err := currency.Currencies.GetByID(userWallet.CurrencyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkWithdrawalTransition(withdrawal, status); err != nil {
+		if errors.Is(err, errWithdrawalTransitioned) {
+			return newWithdrawal(withdrawal, curr), nil
+		}
+		return nil, err
+	}
+	entries, err := a.withdrawalMovementEntries(ctx, withdrawal, status, userWallet, curr)
+	if err != nil {
+		return nil, err
+	}
+
+	transitionedAt := sql.NullTime{Time: time.Now(), Valid: true}
+	var transitioned bool
+	_, err = a.ledger.Transact(ctx, &ledgerv1.TransactRequest{
+		IdempotencyKey:  fmt.Sprintf("wallet_withdrawal_transition:%s:%s", transactionID, status),
+		MovementEntries: entries,
+	}, func(ctx context.Context, pg *postgres.Postgres, _ ledger.MovementInfo) error {
+		q := walletpg.New(pg)
+		// Lock the withdrawal so the concurrent transition to a different status is rolled back along with its movement.
+		locked, err := q.GetWalletWithdrawalForUpdate(ctx, transactionID)
+		if err != nil {
+			return err
+		}
+		if err := checkWithdrawalTransition(locked, status); err != nil {
+			return err
+		}
+		if err := q.UpdateWalletWithdrawalStatus(ctx, walletpg.UpdateWalletWithdrawalStatusParams{
+			WithdrawalStatus: int32(status),
+			UpdatedAt:        transitionedAt,
+			FinishedAt:       transitionedAt,
+			TransactionID:    transactionID,
+		}); err != nil {
+			return err
+		}
+		if err := q.UpdateWalletTransactionStatus(ctx, walletpg.UpdateWalletTransactionStatusParams{
+			TransactionStatus: int32(withdrawalTransactionStatus(status)),
+			UpdatedAt:         transitionedAt,
+			FinishedAt:        transitionedAt,
+			TransactionID:     transactionID,
+		}); err != nil {
+			return err
+		}
+		transitioned = true
+		return nil
+	})
+	if err != nil {
+		// The withdrawal is transitioned to the same status by the concurrent request after we checked the status.
+		if errors.Is(err, errWithdrawalTransitioned) {
+			return a.getWithdrawal(ctx, transactionID, curr)
+		}
+		return nil, err
+	}
+	if !transitioned {
+		// The ledger replays the movement without invoking the function when the movement of the transition is recorded concurrently.
+		return a.getWithdrawal(ctx, transactionID, curr)
+	}
+	withdrawal.WithdrawalStatus = int32(status)
+	withdrawal.UpdatedAt = transitionedAt
+	withdrawal.FinishedAt = transitionedAt
+	return newWithdrawal(withdrawal, curr), nil
+}
+
+// withdrawalMovementEntries returns the movement entries to transition the withdrawal. The money in the intermediary wallet is moved to
+// the withdrawal and fee wallets when the withdrawal succeeds, otherwise the whole amount goes back to the wallet of the user.
+func (a *API) withdrawalMovementEntries(ctx context.Context, withdrawal walletpg.WalletWithdrawal, status walletv1.WithdrawalStatus, userWallet walletpg.WalletAccount, curr *currency.Currency) ([]*ledgerv1.MovementEntry, error) {
+	intermediaryWallet, err := a.queries.GetWallet(ctx, withdrawal.UserIntermediaryWalletID)
+	if err != nil {
+		return nil, err
+	}
+	if status != walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS {
+		return []*ledgerv1.MovementEntry{
+			{
+				FromAccountId: intermediaryWallet.LedgerAccountID,
+				ToAccountId:   userWallet.LedgerAccountID,
+				Amount:        withdrawal.Amount.String(),
+				ClientId:      withdrawal.TransactionID,
+			},
+		}, nil
+	}
+
+	withdrawalWallet, err := a.queries.GetWallet(ctx, withdrawal.WithdrawalWalletID)
+	if err != nil {
+		return nil, err
+	}
+	entries := []*ledgerv1.MovementEntry{
+		{
+			FromAccountId: intermediaryWallet.LedgerAccountID,
+			ToAccountId:   withdrawalWallet.LedgerAccountID,
+			Amount:        withdrawal.FinalAmount.String(),
+			ClientId:      withdrawal.TransactionID,
+		},
+	}
+	if withdrawal.WithdrawalFee.IsPositive() {
+		feeWallet, err := a.systemWallet(ctx, walletv1.WalletType_WALLET_TYPE_FEE, curr)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, &ledgerv1.MovementEntry{
+			FromAccountId: intermediaryWallet.LedgerAccountID,
+			ToAccountId:   feeWallet.LedgerAccountID,
+			Amount:        withdrawal.WithdrawalFee.String(),
+			ClientId:      withdrawal.TransactionID,
+		})
+	}
+	return entries, nil
+}
+
+func (a *API) getWithdrawal(ctx context.Context, transactionID string, curr *currency.Currency) (*walletv1.Withdrawal, error) {
+	withdrawal, err := a.queries.GetWalletWithdrawal(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	return newWithdrawal(withdrawal, curr), nil
+}
+
+// withdrawalFee returns the fee of the withdrawal from the fee calculator. The fee must be less than the amount of the withdrawal,
+// so there is always money to be sent to the user.
+func (a *API) withdrawalFee(ctx context.Context, channel walletv1.WithdrawalChannel, amount currency.Money) (currency.Money, error) {
+	if a.options.WithdrawalFee == nil {
+		return currency.NewMoney(decimal.Zero, amount.Currency()), nil
+	}
+	fee, err := a.options.WithdrawalFee.WithdrawalFee(ctx, channel, amount)
+	if err != nil {
+		return currency.Money{}, err
+	}
+	if fee.CurrencyID() != amount.CurrencyID() || fee.IsNegative() {
+		return currency.Money{}, fmt.Errorf("%w: invalid withdrawal fee %s", wallet.ErrInvalidAmount, fee)
+	}
+	// Truncate the fee to the exponent of the currency in favor of the user.
+	fee = currency.NewMoney(amount.Currency().NormalizeDecimal(fee.Amount()), amount.Currency())
+	cmp, err := fee.Cmp(amount)
+	if err != nil {
+		return currency.Money{}, err
+	}
+	if cmp >= 0 {
+		return currency.Money{}, fmt.Errorf("%w: withdrawal fee %s must be less than the amount %s", wallet.ErrInvalidAmount, fee, amount)
+	}
+	return fee, nil
+}
+
+// errWithdrawalTransitioned is returned when the withdrawal is already transitioned to the requested status.
+var errWithdrawalTransitioned = errors.New("withdrawal is already transitioned")
+
+// checkWithdrawalTransition checks whether the withdrawal can be transitioned to the status. The pending withdrawal can be transitioned
+// to any final status, while the finished withdrawal can't be transitioned anymore.
+func checkWithdrawalTransition(withdrawal walletpg.WalletWithdrawal, status walletv1.WithdrawalStatus) error {
+	current := walletv1.WithdrawalStatus(withdrawal.WithdrawalStatus)
+	switch {
+	case current == status:
+		return errWithdrawalTransitioned
+	case current != walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING:
+		return fmt.Errorf("%w: withdrawal %s is already %s", wallet.ErrInvalidWithdrawalTransition, withdrawal.TransactionID, current)
+	}
+	switch status {
+	case walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS,
+		walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_FAILED,
+		walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_CANCELLED:
+		return nil
+	default:
+		return fmt.Errorf("%w: withdrawal %s can't be transitioned to %s", wallet.ErrInvalidWithdrawalTransition, withdrawal.TransactionID, status)
+	}
+}
+
+// withdrawalTransactionStatus returns the status of the wallet transaction for the withdrawal status.
+func withdrawalTransactionStatus(status walletv1.WithdrawalStatus) walletv1.TransactionStatus {
+	switch status {
+	case walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS:
+		return walletv1.TransactionStatus_TRANSACTION_STATUS_SUCCESS
+	case walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING:
+		return walletv1.TransactionStatus_TRANSACTION_STATUS_PENDING
+	case walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_CANCELLED:
+		return walletv1.TransactionStatus_TRANSACTION_STATUS_CANCELLED
+	case walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_FAILED:
+		return walletv1.TransactionStatus_TRANSACTION_STATUS_FAILED
+	default:
+		return walletv1.TransactionStatus_TRANSACTION_STATUS_UNSPECIFIED
+	}
+}
+
+// withdrawalChannel returns the channel of the withdrawal along with the name of the bank or e-wallet that receives the money.
+func withdrawalChannel(req *walletv1.CreateWithdrawalRequest) (walletv1.WithdrawalChannel, string) {
+	switch destination := req.GetDestination().(type) {
+	case *walletv1.CreateWithdrawalRequest_Bank_:
+		return walletv1.WithdrawalChannel_CHANNEL_BANK, destination.Bank.GetBankName()
+	case *walletv1.CreateWithdrawalRequest_Ewallet_:
+		return walletv1.WithdrawalChannel_CHANNEL_EWALLET, destination.Ewallet.GetEwalletName()
+	default:
+		return walletv1.WithdrawalChannel_CHANNEL_UNSPECIFIED, ""
+	}
+}
+
+func newWithdrawal(withdrawal walletpg.WalletWithdrawal, curr *currency.Currency) *walletv1.Withdrawal {
+	w := &walletv1.Withdrawal{
+		TransactionId:        withdrawal.TransactionID,
+		UserWalletId:         withdrawal.UserWalletID,
+		Amount:               withdrawal.Amount.String(),
+		WithdrawalFee:        withdrawal.WithdrawalFee.String(),
+		FinalAmount:          withdrawal.FinalAmount.String(),
+		Currency:             curr.Name,
+		WithdrawalStatus:     walletv1.WithdrawalStatus(withdrawal.WithdrawalStatus),
+		WithdrawalChannel:    walletv1.WithdrawalChannel(withdrawal.WithdrawalChannel),
+		PaymentGatewayVendor: withdrawal.WithdrawalPgVendor.String,
+		CreatedAt:            timestamppb.New(withdrawal.CreatedAt),
+	}
+	if withdrawal.UpdatedAt.Valid {
+		w.UpdatedAt = timestamppb.New(withdrawal.UpdatedAt.Time)
+	}
+	if withdrawal.FinishedAt.Valid {
+		w.FinishedAt = timestamppb.New(withdrawal.FinishedAt.Time)
+	}
+	return w
+}
@@ -0,0 +1,335 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	ledgerv1 "github.com/studio-asd/go-example/proto/api/ledger/v1"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+	"github.com/studio-asd/go-example/services/ledger"
+	ledgerapi "github.com/studio-asd/go-example/services/ledger/api"
+	"github.com/studio-asd/go-example/services/wallet"
+	walletpg "github.com/studio-asd/go-example/services/wallet/internal/postgres"
+)
+
+// flatWithdrawalFee charges 500 for every withdrawal.
+var flatWithdrawalFee = wallet.WithdrawalFeeFunc(func(_ context.Context, _ walletv1.WithdrawalChannel, amount currency.Money) (currency.Money, error) {
+	return currency.NewMoney(decimal.NewFromInt(500), amount.Currency()), nil
+})
+
+func TestWithdrawal(t *testing.T) {
+	t.Parallel()
+
+	th, err := testHelper.ForkPostgresSchema(context.Background(), testHelper.Postgres(), "public")
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := New(th.Postgres(), ledgerapi.New(th.Postgres(), ledgerapi.Options{}), Options{WithdrawalFee: flatWithdrawalFee})
+	if _, err := api.CreateWalletAccount(context.Background(), &walletv1.CreateWalletAccountRequest{
+		UserId:     "user_1",
+		WalletType: walletv1.WalletType_WALLET_TYPE_MAIN,
+		Currency:   "IDR",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.Deposit(context.Background(), &walletv1.DepositRequest{
+		UserId:           "user_1",
+		Amount:           "10000",
+		Currency:         "IDR",
+		ChannelReference: "payment_1",
+		Channel: &walletv1.DepositRequest_Bank_{
+			Bank: &walletv1.DepositRequest_Bank{BankName: "bca"},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	walletUser, err := api.queries.GetWalletUser(context.Background(), "user_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectBalance := func(t *testing.T, walletID, expect string) {
+		t.Helper()
+		balance, err := api.walletBalance(context.Background(), walletID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if balance.GetWalletBalance() != expect {
+			t.Fatalf("expecting balance of wallet %s to be %s but got %s", walletID, expect, balance.GetWalletBalance())
+		}
+	}
+	systemWalletID := func(t *testing.T, walletType walletv1.WalletType) string {
+		t.Helper()
+		systemWallet, err := api.queries.GetSystemWallet(context.Background(), walletpg.GetSystemWalletParams{
+			WalletType: int32(walletType),
+			CurrencyID: currency.IDR.ID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return systemWallet.WalletID
+	}
+	createReq := func(idempotencyKey, amount string) *walletv1.CreateWithdrawalRequest {
+		return &walletv1.CreateWithdrawalRequest{
+			UserId:         "user_1",
+			Amount:         amount,
+			Currency:       "IDR",
+			IdempotencyKey: idempotencyKey,
+			Destination: &walletv1.CreateWithdrawalRequest_Bank_{
+				Bank: &walletv1.CreateWithdrawalRequest_Bank{BankName: "bca"},
+			},
+		}
+	}
+
+	created, err := api.CreateWithdrawal(context.Background(), createReq("withdrawal_1", "5000"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	withdrawal := created.GetWithdrawal()
+
+	// The withdrawal system wallet is a hot account that cannot have negative balance, so only its credits are recorded to the
+	// balance shards.
+	withdrawalWallet, err := api.queries.GetSystemWallet(context.Background(), walletpg.GetSystemWalletParams{
+		WalletType: int32(walletv1.WalletType_WALLET_TYPE_WITHDRAWAL),
+		CurrencyID: currency.IDR.ID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := api.ledger.CreateBalanceShards(context.Background(), &ledgerv1.CreateBalanceShardsRequest{
+		AccountId: withdrawalWallet.LedgerAccountID,
+		Shards:    4,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("pending", func(t *testing.T) {
+		if withdrawal.GetWithdrawalStatus() != walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING {
+			t.Fatalf("expecting status %s but got %s", walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING, withdrawal.GetWithdrawalStatus())
+		}
+		if withdrawal.GetWithdrawalFee() != "500" || withdrawal.GetFinalAmount() != "4500" {
+			t.Fatalf("expecting fee 500 and final amount 4500 but got %s and %s", withdrawal.GetWithdrawalFee(), withdrawal.GetFinalAmount())
+		}
+		expectBalance(t, withdrawal.GetUserWalletId(), "5000")
+		expectBalance(t, walletUser.IntermediaryWalletID, "5000")
+
+		replayed, err := api.CreateWithdrawal(context.Background(), createReq("withdrawal_1", "5000"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if replayed.GetWithdrawal().GetTransactionId() != withdrawal.GetTransactionId() {
+			t.Fatalf("expecting transaction %s but got %s", withdrawal.GetTransactionId(), replayed.GetWithdrawal().GetTransactionId())
+		}
+		_, err = api.CreateWithdrawal(context.Background(), createReq("withdrawal_1", "6000"))
+		if !errors.Is(err, wallet.ErrWithdrawalConflict) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrWithdrawalConflict, err)
+		}
+		expectBalance(t, withdrawal.GetUserWalletId(), "5000")
+	})
+
+	t.Run("confirm", func(t *testing.T) {
+		confirmReq := &walletv1.ConfirmWithdrawalRequest{TransactionId: withdrawal.GetTransactionId()}
+		confirmed, err := api.ConfirmWithdrawal(context.Background(), confirmReq)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if confirmed.GetWithdrawal().GetWithdrawalStatus() != walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS {
+			t.Fatalf("expecting status %s but got %s", walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS, confirmed.GetWithdrawal().GetWithdrawalStatus())
+		}
+		if confirmed.GetWithdrawal().GetFinishedAt() == nil {
+			t.Fatal("expecting finished_at to be set")
+		}
+		expectBalance(t, walletUser.IntermediaryWalletID, "0")
+		expectBalance(t, systemWalletID(t, walletv1.WalletType_WALLET_TYPE_WITHDRAWAL), "4500")
+		expectBalance(t, systemWalletID(t, walletv1.WalletType_WALLET_TYPE_FEE), "500")
+
+		// The callback might be delivered more than once, so confirming the confirmed withdrawal doesn't move the money again.
+		if _, err := api.ConfirmWithdrawal(context.Background(), confirmReq); err != nil {
+			t.Fatal(err)
+		}
+		expectBalance(t, systemWalletID(t, walletv1.WalletType_WALLET_TYPE_WITHDRAWAL), "4500")
+
+		_, err = api.FailWithdrawal(context.Background(), &walletv1.FailWithdrawalRequest{TransactionId: withdrawal.GetTransactionId()})
+		if !errors.Is(err, wallet.ErrInvalidWithdrawalTransition) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrInvalidWithdrawalTransition, err)
+		}
+		transaction, err := api.queries.GetWalletTransactionByIdempotencyKey(context.Background(), walletpg.GetWalletTransactionByIdempotencyKeyParams{
+			TransactionType: int32(walletv1.TransactionType_TX_TYPE_WITHDRAWAL),
+			IdempotencyKey:  "withdrawal_1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if transaction.TransactionStatus != int32(walletv1.TransactionStatus_TRANSACTION_STATUS_SUCCESS) {
+			t.Fatalf("expecting transaction status %s but got %s", walletv1.TransactionStatus_TRANSACTION_STATUS_SUCCESS, walletv1.TransactionStatus(transaction.TransactionStatus))
+		}
+	})
+
+	t.Run("fail and cancel", func(t *testing.T) {
+		failed, err := api.CreateWithdrawal(context.Background(), createReq("withdrawal_2", "2000"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectBalance(t, withdrawal.GetUserWalletId(), "3000")
+		if _, err := api.FailWithdrawal(context.Background(), &walletv1.FailWithdrawalRequest{TransactionId: failed.GetWithdrawal().GetTransactionId()}); err != nil {
+			t.Fatal(err)
+		}
+		// The whole amount including the fee goes back to the user.
+		expectBalance(t, withdrawal.GetUserWalletId(), "5000")
+
+		cancelled, err := api.CreateWithdrawal(context.Background(), createReq("withdrawal_3", "2000"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := api.CancelWithdrawal(context.Background(), &walletv1.CancelWithdrawalRequest{TransactionId: cancelled.GetWithdrawal().GetTransactionId()}); err != nil {
+			t.Fatal(err)
+		}
+		expectBalance(t, withdrawal.GetUserWalletId(), "5000")
+		expectBalance(t, walletUser.IntermediaryWalletID, "0")
+
+		_, err = api.ConfirmWithdrawal(context.Background(), &walletv1.ConfirmWithdrawalRequest{TransactionId: cancelled.GetWithdrawal().GetTransactionId()})
+		if !errors.Is(err, wallet.ErrInvalidWithdrawalTransition) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrInvalidWithdrawalTransition, err)
+		}
+	})
+
+	t.Run("insufficient balance", func(t *testing.T) {
+		_, err := api.CreateWithdrawal(context.Background(), createReq("withdrawal_4", "100000"))
+		if !errors.Is(err, ledger.ErrInsufficientBalance) {
+			t.Fatalf("expecting error %v but got %v", ledger.ErrInsufficientBalance, err)
+		}
+	})
+
+	// The ledger idempotency keys of the creation and the transition of the withdrawal never collide, even when the client key
+	// looks like the key of the transition.
+	t.Run("client key of transition", func(t *testing.T) {
+		idempotencyKey := withdrawal.GetTransactionId() + ":" + walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS.String()
+		created, err := api.CreateWithdrawal(context.Background(), createReq(idempotencyKey, "1000"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created.GetWithdrawal().GetTransactionId() == withdrawal.GetTransactionId() {
+			t.Fatal("expecting a new withdrawal to be created")
+		}
+		expectBalance(t, withdrawal.GetUserWalletId(), "4000")
+		expectBalance(t, walletUser.IntermediaryWalletID, "1000")
+	})
+
+	t.Run("withdrawal not found", func(t *testing.T) {
+		_, err := api.ConfirmWithdrawal(context.Background(), &walletv1.ConfirmWithdrawalRequest{TransactionId: "not_found"})
+		if !errors.Is(err, wallet.ErrWithdrawalNotFound) {
+			t.Fatalf("expecting error %v but got %v", wallet.ErrWithdrawalNotFound, err)
+		}
+	})
+}
+
+func TestCheckWithdrawalTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		current walletv1.WithdrawalStatus
+		status  walletv1.WithdrawalStatus
+		expect  error
+	}{
+		{
+			name:    "pending to success",
+			current: walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING,
+			status:  walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS,
+		},
+		{
+			name:    "pending to failed",
+			current: walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING,
+			status:  walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_FAILED,
+		},
+		{
+			name:    "pending to cancelled",
+			current: walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_PENDING,
+			status:  walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_CANCELLED,
+		},
+		{
+			name:    "success to success",
+			current: walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS,
+			status:  walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS,
+			expect:  errWithdrawalTransitioned,
+		},
+		{
+			name:    "success to failed",
+			current: walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS,
+			status:  walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_FAILED,
+			expect:  wallet.ErrInvalidWithdrawalTransition,
+		},
+		{
+			name:    "cancelled to success",
+			current: walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_CANCELLED,
+			status:  walletv1.WithdrawalStatus_WITHDRAWAL_STATUS_SCUCESS,
+			expect:  wallet.ErrInvalidWithdrawalTransition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := checkWithdrawalTransition(walletpg.WalletWithdrawal{WithdrawalStatus: int32(test.current)}, test.status)
+			if !errors.Is(err, test.expect) {
+				t.Fatalf("expecting error %v but got %v", test.expect, err)
+			}
+		})
+	}
+}
+
+func TestWithdrawalFee(t *testing.T) {
+	amount := currency.NewMoney(decimal.NewFromInt(1000), currency.IDR)
+
+	tests := []struct {
+		name      string
+		fee       wallet.WithdrawalFeeCalculator
+		expectFee string
+		expectErr error
+	}{
+		{
+			name:      "no fee calculator",
+			expectFee: "0",
+		},
+		{
+			name:      "flat fee",
+			fee:       flatWithdrawalFee,
+			expectFee: "500",
+		},
+		{
+			name: "fee truncated to the currency exponent",
+			fee: wallet.WithdrawalFeeFunc(func(_ context.Context, _ walletv1.WithdrawalChannel, amount currency.Money) (currency.Money, error) {
+				return currency.NewMoney(decimal.RequireFromString("10.75"), amount.Currency()), nil
+			}),
+			expectFee: "10",
+		},
+		{
+			name: "fee takes the whole amount",
+			fee: wallet.WithdrawalFeeFunc(func(_ context.Context, _ walletv1.WithdrawalChannel, amount currency.Money) (currency.Money, error) {
+				return amount, nil
+			}),
+			expectErr: wallet.ErrInvalidAmount,
+		},
+		{
+			name: "fee in different currency",
+			fee: wallet.WithdrawalFeeFunc(func(_ context.Context, _ walletv1.WithdrawalChannel, _ currency.Money) (currency.Money, error) {
+				return currency.NewMoney(decimal.NewFromInt(1), currency.USD), nil
+			}),
+			expectErr: wallet.ErrInvalidAmount,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := &API{options: Options{WithdrawalFee: test.fee}}
+			fee, err := api.withdrawalFee(context.Background(), walletv1.WithdrawalChannel_CHANNEL_BANK, amount)
+			if !errors.Is(err, test.expectErr) {
+				t.Fatalf("expecting error %v but got %v", test.expectErr, err)
+			}
+			if err == nil && fee.Amount().String() != test.expectFee {
+				t.Fatalf("expecting fee %s but got %s", test.expectFee, fee.Amount().String())
+			}
+		})
+	}
+}
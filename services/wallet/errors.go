@@ -0,0 +1,25 @@
+package wallet
+
+import "errors"
+
+var (
+	ErrWalletNotFound              = errors.New("wallet not found")
+	ErrWalletUserNotFound          = errors.New("wallet user not found")
+	ErrWalletAlreadyExists         = errors.New("wallet already exists")
+	ErrInvalidWalletType           = errors.New("invalid wallet type")
+	ErrWalletCurrencyMismatch      = errors.New("wallet currency is different with the other wallets of the user")
+	ErrWalletNotActive             = errors.New("wallet is not active")
+	ErrWalletNotOwned              = errors.New("wallet is not owned by the user")
+	ErrInvalidAmount               = errors.New("invalid amount")
+	ErrDepositConflict             = errors.New("deposit channel reference already used with different deposit details")
+	ErrDepositChannelNotAllowed    = errors.New("deposit channel is not allowed")
+	ErrWithdrawalConflict          = errors.New("idempotency key already used with different withdrawal details")
+	ErrWithdrawalNotFound          = errors.New("withdrawal not found")
+	ErrInvalidWithdrawalTransition = errors.New("invalid withdrawal status transition")
+	ErrPaymentGatewayNotFound      = errors.New("payment gateway not found")
+	ErrDisbursementNotFound        = errors.New("disbursement not found")
+	ErrInvalidWebhookSignature     = errors.New("invalid webhook signature")
+	ErrInvalidWebhookEvent         = errors.New("invalid webhook event")
+	ErrInvalidTransfer             = errors.New("invalid transfer")
+	ErrTransferConflict            = errors.New("idempotency key already used with different transfer details")
+)
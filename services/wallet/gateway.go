@@ -0,0 +1,80 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+)
+
+// DisbursementStatus is the status of the disbursement inside the payment gateway.
+type DisbursementStatus int
+
+const (
+	DisbursementStatusPending DisbursementStatus = iota + 1
+	DisbursementStatusSuccess
+	DisbursementStatusFailed
+)
+
+func (d DisbursementStatus) String() string {
+	switch d {
+	case DisbursementStatusPending:
+		return "pending"
+	case DisbursementStatusSuccess:
+		return "success"
+	case DisbursementStatusFailed:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
+// Disbursement is the request to the payment gateway to send the money of the withdrawal to the bank or e-wallet of the user.
+type Disbursement struct {
+	// ReferenceID is the transaction id of the withdrawal. The payment gateway must only disburse the money once for each reference id,
+	// so the disbursement can be safely resubmitted.
+	ReferenceID string
+	Amount      currency.Money
+	Channel     walletv1.WithdrawalChannel
+	// Destination is the name of the bank or e-wallet that receives the money.
+	Destination string
+}
+
+// WebhookEventType is the type of the event sent by the payment gateway via webhook.
+type WebhookEventType int
+
+const (
+	// WebhookEventDisbursement is sent when the disbursement of the withdrawal is finished.
+	WebhookEventDisbursement WebhookEventType = iota + 1
+	// WebhookEventPayment is sent when the user pays a deposit through the payment gateway.
+	WebhookEventPayment
+)
+
+// WebhookEvent is the event sent by the payment gateway, the event is only returned after its signature is verified.
+type WebhookEvent struct {
+	Type WebhookEventType
+	// ReferenceID is the transaction id of the withdrawal for the disbursement event, and the payment id of the deposit for the
+	// payment event.
+	ReferenceID string
+	// DisbursementStatus is the status of the disbursement, only set for the disbursement event.
+	DisbursementStatus DisbursementStatus
+	// UserID, Amount and Currency are the deposit paid by the user, only set for the payment event.
+	UserID   string
+	Amount   string
+	Currency string
+}
+
+// PaymentGateway sends the money outside of the wallet system and notifies the money that comes in from the outside. The payment
+// gateway notifies the result of the disbursements and the payments via signed webhooks.
+type PaymentGateway interface {
+	// Vendor returns the name of the payment gateway vendor, the name is recorded as the vendor of the withdrawals and deposits.
+	Vendor() string
+	// SubmitDisbursement submits the disbursement and returns its status. The status is pending when the result of the disbursement
+	// is notified later via webhook.
+	SubmitDisbursement(ctx context.Context, disbursement Disbursement) (DisbursementStatus, error)
+	// DisbursementStatus returns the current status of the disbursement of the reference id.
+	DisbursementStatus(ctx context.Context, referenceID string) (DisbursementStatus, error)
+	// VerifyWebhook verifies the signature of the webhook payload and returns the event inside the payload. ErrInvalidWebhookSignature
+	// is returned when the signature doesn't match.
+	VerifyWebhook(ctx context.Context, payload []byte, signature string) (WebhookEvent, error)
+}
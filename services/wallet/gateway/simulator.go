@@ -0,0 +1,237 @@
+package gateway
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/studio-asd/go-example/services/wallet"
+)
+
+var _ wallet.PaymentGateway = (*Simulator)(nil)
+
+// Outcome is the result of the disbursements submitted to the simulator.
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFail    Outcome = "fail"
+)
+
+// SimulatorConfig is the configuration of the payment gateway simulator.
+type SimulatorConfig struct {
+	// Vendor is the vendor name of the simulator, the default vendor name is "simulator".
+	Vendor string `yaml:"vendor"`
+	// Secret is the secret to sign and verify the webhooks. The secret is not read from the configuration file, so it is never
+	// committed together with the configuration.
+	Secret string `yaml:"-"`
+	// Outcome is the result of the disbursements, the disbursements succeed by default.
+	Outcome Outcome `yaml:"outcome"`
+	// Delay delays the result of the disbursements. The disbursement stays pending until the delay passes and the result is sent
+	// via the webhook. Without delay, the result is returned immediately when the disbursement is submitted.
+	Delay time.Duration `yaml:"delay"`
+	// Webhook delivers the signed webhook of the simulator, for example by invoking the webhook handler of the wallet api.
+	Webhook func(ctx context.Context, vendor string, payload []byte, signature string) error `yaml:"-"`
+}
+
+// Simulator is an in-process payment gateway. The simulator is useful for local development and testing as it behaves like
+// the real payment gateway, including the signed webhooks, without sending the money anywhere.
+type Simulator struct {
+	config SimulatorConfig
+
+	mu            sync.Mutex
+	disbursements map[string]wallet.DisbursementStatus
+	// timers are the timers of the delayed disbursements keyed by the reference id, the timer is removed once the disbursement
+	// is finished.
+	timers map[string]*time.Timer
+}
+
+func NewSimulator(config SimulatorConfig) *Simulator {
+	if config.Vendor == "" {
+		config.Vendor = "simulator"
+	}
+	if config.Outcome == "" {
+		config.Outcome = OutcomeSuccess
+	}
+	return &Simulator{
+		config:        config,
+		disbursements: make(map[string]wallet.DisbursementStatus),
+		timers:        make(map[string]*time.Timer),
+	}
+}
+
+func (s *Simulator) Vendor() string {
+	return s.config.Vendor
+}
+
+// SetOutcome changes the result of the disbursements submitted after the change.
+func (s *Simulator) SetOutcome(outcome Outcome) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.config.Outcome = outcome
+}
+
+// SubmitDisbursement records the disbursement with the configured outcome. The disbursement is only recorded once for each reference
+// id, so the resubmitted disbursement returns its current status.
+func (s *Simulator) SubmitDisbursement(ctx context.Context, disbursement wallet.Disbursement) (wallet.DisbursementStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if status, ok := s.disbursements[disbursement.ReferenceID]; ok {
+		return status, nil
+	}
+	status := wallet.DisbursementStatusSuccess
+	if s.config.Outcome == OutcomeFail {
+		status = wallet.DisbursementStatusFailed
+	}
+	if s.config.Delay <= 0 {
+		s.disbursements[disbursement.ReferenceID] = status
+		return status, nil
+	}
+
+	s.disbursements[disbursement.ReferenceID] = wallet.DisbursementStatusPending
+	s.timers[disbursement.ReferenceID] = time.AfterFunc(s.config.Delay, func() {
+		s.finishDisbursement(disbursement.ReferenceID, status)
+	})
+	return wallet.DisbursementStatusPending, nil
+}
+
+// finishDisbursement updates the status of the delayed disbursement and sends the result via the webhook.
+func (s *Simulator) finishDisbursement(referenceID string, status wallet.DisbursementStatus) {
+	s.mu.Lock()
+	s.disbursements[referenceID] = status
+	delete(s.timers, referenceID)
+	s.mu.Unlock()
+
+	err := s.deliver(context.Background(), webhookPayload{
+		Type:        webhookTypeDisbursement,
+		ReferenceID: referenceID,
+		Status:      status.String(),
+	})
+	if err != nil {
+		slog.Error("Failed to deliver the disbursement webhook", "vendor", s.config.Vendor, "reference_id", referenceID, "error", err)
+	}
+}
+
+func (s *Simulator) DisbursementStatus(ctx context.Context, referenceID string) (wallet.DisbursementStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status, ok := s.disbursements[referenceID]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", wallet.ErrDisbursementNotFound, referenceID)
+	}
+	return status, nil
+}
+
+// Pay simulates the user paying a deposit through the payment gateway, the payment is sent via the webhook.
+func (s *Simulator) Pay(ctx context.Context, userID, paymentID, amount, currency string) error {
+	payload, signature, err := s.PaymentWebhook(userID, paymentID, amount, currency)
+	if err != nil || s.config.Webhook == nil {
+		return err
+	}
+	return s.config.Webhook(ctx, s.config.Vendor, payload, signature)
+}
+
+// PaymentWebhook returns the signed webhook payload of the payment without delivering it.
+func (s *Simulator) PaymentWebhook(userID, paymentID, amount, currency string) ([]byte, string, error) {
+	payload, err := json.Marshal(webhookPayload{
+		Type:        webhookTypePayment,
+		ReferenceID: paymentID,
+		UserID:      userID,
+		Amount:      amount,
+		Currency:    currency,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return payload, s.Sign(payload), nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of the payload.
+func (s *Simulator) Sign(payload []byte) string {
+	return hex.EncodeToString(s.mac(payload))
+}
+
+func (s *Simulator) mac(payload []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(s.config.Secret))
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func (s *Simulator) VerifyWebhook(ctx context.Context, payload []byte, signature string) (wallet.WebhookEvent, error) {
+	expect, err := hex.DecodeString(signature)
+	if err != nil || !hmac.Equal(expect, s.mac(payload)) {
+		return wallet.WebhookEvent{}, wallet.ErrInvalidWebhookSignature
+	}
+
+	var p webhookPayload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return wallet.WebhookEvent{}, fmt.Errorf("%w: %v", wallet.ErrInvalidWebhookEvent, err)
+	}
+	event := wallet.WebhookEvent{ReferenceID: p.ReferenceID}
+	switch p.Type {
+	case webhookTypeDisbursement:
+		event.Type = wallet.WebhookEventDisbursement
+		switch p.Status {
+		case wallet.DisbursementStatusPending.String():
+			event.DisbursementStatus = wallet.DisbursementStatusPending
+		case wallet.DisbursementStatusSuccess.String():
+			event.DisbursementStatus = wallet.DisbursementStatusSuccess
+		case wallet.DisbursementStatusFailed.String():
+			event.DisbursementStatus = wallet.DisbursementStatusFailed
+		default:
+			return wallet.WebhookEvent{}, fmt.Errorf("%w: unknown disbursement status %s", wallet.ErrInvalidWebhookEvent, p.Status)
+		}
+	case webhookTypePayment:
+		event.Type = wallet.WebhookEventPayment
+		event.UserID = p.UserID
+		event.Amount = p.Amount
+		event.Currency = p.Currency
+	default:
+		return wallet.WebhookEvent{}, fmt.Errorf("%w: unknown event type %s", wallet.ErrInvalidWebhookEvent, p.Type)
+	}
+	return event, nil
+}
+
+// Close stops the pending delayed disbursements, the stopped disbursements stay pending.
+func (s *Simulator) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for referenceID, timer := range s.timers {
+		timer.Stop()
+		delete(s.timers, referenceID)
+	}
+}
+
+func (s *Simulator) deliver(ctx context.Context, p webhookPayload) error {
+	if s.config.Webhook == nil {
+		return nil
+	}
+	payload, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	return s.config.Webhook(ctx, s.config.Vendor, payload, s.Sign(payload))
+}
+
+const (
+	webhookTypeDisbursement = "disbursement"
+	webhookTypePayment      = "payment"
+)
+
+// webhookPayload is the JSON payload of the simulator webhooks.
+type webhookPayload struct {
+	Type        string `json:"type"`
+	ReferenceID string `json:"reference_id"`
+	Status      string `json:"status,omitempty"`
+	UserID      string `json:"user_id,omitempty"`
+	Amount      string `json:"amount,omitempty"`
+	Currency    string `json:"currency,omitempty"`
+}
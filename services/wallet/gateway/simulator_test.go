@@ -0,0 +1,173 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+	"github.com/studio-asd/go-example/services/wallet"
+)
+
+func newTestDisbursement(referenceID string) wallet.Disbursement {
+	return wallet.Disbursement{
+		ReferenceID: referenceID,
+		Amount:      currency.NewMoney(decimal.NewFromInt(1000), currency.IDR),
+		Channel:     walletv1.WithdrawalChannel_CHANNEL_BANK,
+		Destination: "bca",
+	}
+}
+
+func TestSimulatorDisbursement(t *testing.T) {
+	t.Parallel()
+
+	simulator := NewSimulator(SimulatorConfig{Secret: "secret"})
+	status, err := simulator.SubmitDisbursement(context.Background(), newTestDisbursement("withdrawal_1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != wallet.DisbursementStatusSuccess {
+		t.Fatalf("expecting status %s but got %s", wallet.DisbursementStatusSuccess, status)
+	}
+
+	// The outcome only affects the new disbursements, the resubmitted disbursement keeps its status.
+	simulator.SetOutcome(OutcomeFail)
+	status, err = simulator.SubmitDisbursement(context.Background(), newTestDisbursement("withdrawal_1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != wallet.DisbursementStatusSuccess {
+		t.Fatalf("expecting status %s but got %s", wallet.DisbursementStatusSuccess, status)
+	}
+	status, err = simulator.SubmitDisbursement(context.Background(), newTestDisbursement("withdrawal_2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != wallet.DisbursementStatusFailed {
+		t.Fatalf("expecting status %s but got %s", wallet.DisbursementStatusFailed, status)
+	}
+
+	if _, err := simulator.DisbursementStatus(context.Background(), "not_found"); !errors.Is(err, wallet.ErrDisbursementNotFound) {
+		t.Fatalf("expecting error %v but got %v", wallet.ErrDisbursementNotFound, err)
+	}
+}
+
+func TestSimulatorDelayedDisbursement(t *testing.T) {
+	t.Parallel()
+
+	events := make(chan wallet.WebhookEvent, 1)
+	var simulator *Simulator
+	simulator = NewSimulator(SimulatorConfig{
+		Secret: "secret",
+		Delay:  time.Millisecond * 10,
+		Webhook: func(ctx context.Context, vendor string, payload []byte, signature string) error {
+			event, err := simulator.VerifyWebhook(ctx, payload, signature)
+			if err != nil {
+				return err
+			}
+			events <- event
+			return nil
+		},
+	})
+	defer simulator.Close()
+
+	status, err := simulator.SubmitDisbursement(context.Background(), newTestDisbursement("withdrawal_1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != wallet.DisbursementStatusPending {
+		t.Fatalf("expecting status %s but got %s", wallet.DisbursementStatusPending, status)
+	}
+
+	select {
+	case event := <-events:
+		if event.Type != wallet.WebhookEventDisbursement || event.ReferenceID != "withdrawal_1" || event.DisbursementStatus != wallet.DisbursementStatusSuccess {
+			t.Fatalf("unexpected webhook event %+v", event)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the webhook is not delivered")
+	}
+	status, err = simulator.DisbursementStatus(context.Background(), "withdrawal_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status != wallet.DisbursementStatusSuccess {
+		t.Fatalf("expecting status %s but got %s", wallet.DisbursementStatusSuccess, status)
+	}
+	simulator.mu.Lock()
+	timers := len(simulator.timers)
+	simulator.mu.Unlock()
+	if timers != 0 {
+		t.Fatalf("expecting the timer of the finished disbursement to be removed but got %d timers", timers)
+	}
+}
+
+func TestSimulatorVerifyWebhook(t *testing.T) {
+	t.Parallel()
+
+	simulator := NewSimulator(SimulatorConfig{Secret: "secret"})
+	payload, signature, err := simulator.PaymentWebhook("user_1", "payment_1", "10000", "IDR")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	event, err := simulator.VerifyWebhook(context.Background(), payload, signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := wallet.WebhookEvent{
+		Type:        wallet.WebhookEventPayment,
+		ReferenceID: "payment_1",
+		UserID:      "user_1",
+		Amount:      "10000",
+		Currency:    "IDR",
+	}
+	if event != expect {
+		t.Fatalf("expecting event %+v but got %+v", expect, event)
+	}
+
+	tests := []struct {
+		name      string
+		payload   []byte
+		signature string
+		expect    error
+	}{
+		{
+			name:      "tampered payload",
+			payload:   []byte(`{"type":"payment","reference_id":"payment_1","user_id":"user_2","amount":"10000","currency":"IDR"}`),
+			signature: signature,
+			expect:    wallet.ErrInvalidWebhookSignature,
+		},
+		{
+			name:      "signed with other secret",
+			payload:   payload,
+			signature: NewSimulator(SimulatorConfig{Secret: "other"}).Sign(payload),
+			expect:    wallet.ErrInvalidWebhookSignature,
+		},
+		{
+			name:      "invalid signature encoding",
+			payload:   payload,
+			signature: "not_hex",
+			expect:    wallet.ErrInvalidWebhookSignature,
+		},
+		{
+			name:      "unknown event type",
+			payload:   []byte(`{"type":"refund","reference_id":"payment_1"}`),
+			signature: simulator.Sign([]byte(`{"type":"refund","reference_id":"payment_1"}`)),
+			expect:    wallet.ErrInvalidWebhookEvent,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := simulator.VerifyWebhook(context.Background(), test.payload, test.signature)
+			if !errors.Is(err, test.expect) {
+				t.Fatalf("expecting error %v but got %v", test.expect, err)
+			}
+		})
+	}
+}
@@ -7,7 +7,10 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"time"
+
+	"github.com/shopspring/decimal"
 )
 
 const createWallet = `-- name: CreateWallet :exec
@@ -16,9 +19,11 @@ INSERT INTO wallet_accounts(
 	ledger_account_id,
 	user_id,
 	wallet_status,
+	wallet_owner,
 	wallet_type,
+	currency_id,
 	created_at
-) VALUES($1,$2,$3,$4,$5, $6)
+) VALUES($1,$2,$3,$4,$5,$6,$7,$8)
 `
 
 type CreateWalletParams struct {
@@ -26,7 +31,9 @@ type CreateWalletParams struct {
 	LedgerAccountID string
 	UserID          string
 	WalletStatus    int32
+	WalletOwner     int32
 	WalletType      int32
+	CurrencyID      int32
 	CreatedAt       time.Time
 }
 
@@ -36,8 +43,600 @@ func (q *Queries) CreateWallet(ctx context.Context, arg CreateWalletParams) erro
 		arg.LedgerAccountID,
 		arg.UserID,
 		arg.WalletStatus,
+		arg.WalletOwner,
 		arg.WalletType,
+		arg.CurrencyID,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createWalletBankWithdrawal = `-- name: CreateWalletBankWithdrawal :exec
+INSERT INTO wallet_bank_withdrawals(
+	transaction_id,
+	bank_name,
+	created_at
+) VALUES($1,$2,$3)
+`
+
+type CreateWalletBankWithdrawalParams struct {
+	TransactionID string
+	BankName      string
+	CreatedAt     time.Time
+}
+
+func (q *Queries) CreateWalletBankWithdrawal(ctx context.Context, arg CreateWalletBankWithdrawalParams) error {
+	_, err := q.db.Exec(ctx, createWalletBankWithdrawal,
+		arg.TransactionID,
+		arg.BankName,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createWalletDeposit = `-- name: CreateWalletDeposit :exec
+INSERT INTO wallet_deposits(
+	transaction_id,
+	deposit_wallet_id,
+	user_wallet_id,
+	amount,
+	deposit_status,
+	deposit_channel,
+	channel_reference,
+	channel_name,
+	movement_id,
+	created_at,
+	finished_at
+) VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)
+`
+
+type CreateWalletDepositParams struct {
+	TransactionID    string
+	DepositWalletID  string
+	UserWalletID     string
+	Amount           decimal.Decimal
+	DepositStatus    int32
+	DepositChannel   int32
+	ChannelReference string
+	ChannelName      string
+	MovementID       sql.NullString
+	CreatedAt        time.Time
+	FinishedAt       sql.NullTime
+}
+
+func (q *Queries) CreateWalletDeposit(ctx context.Context, arg CreateWalletDepositParams) error {
+	_, err := q.db.Exec(ctx, createWalletDeposit,
+		arg.TransactionID,
+		arg.DepositWalletID,
+		arg.UserWalletID,
+		arg.Amount,
+		arg.DepositStatus,
+		arg.DepositChannel,
+		arg.ChannelReference,
+		arg.ChannelName,
+		arg.MovementID,
 		arg.CreatedAt,
+		arg.FinishedAt,
+	)
+	return err
+}
+
+const createWalletEwalletWithdrawal = `-- name: CreateWalletEwalletWithdrawal :exec
+INSERT INTO wallet_ewallet_withdrawals(
+	transaction_id,
+	ewallet_name,
+	created_at
+) VALUES($1,$2,$3)
+`
+
+type CreateWalletEwalletWithdrawalParams struct {
+	TransactionID string
+	EwalletName   string
+	CreatedAt     time.Time
+}
+
+func (q *Queries) CreateWalletEwalletWithdrawal(ctx context.Context, arg CreateWalletEwalletWithdrawalParams) error {
+	_, err := q.db.Exec(ctx, createWalletEwalletWithdrawal,
+		arg.TransactionID,
+		arg.EwalletName,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createWalletTransaction = `-- name: CreateWalletTransaction :exec
+INSERT INTO wallet_transactions(
+	transaction_id,
+	transaction_type,
+	transaction_status,
+	idempotency_key,
+	created_at,
+	finished_at
+) VALUES($1,$2,$3,$4,$5,$6)
+`
+
+type CreateWalletTransactionParams struct {
+	TransactionID     string
+	TransactionType   int32
+	TransactionStatus int32
+	IdempotencyKey    string
+	CreatedAt         time.Time
+	FinishedAt        sql.NullTime
+}
+
+func (q *Queries) CreateWalletTransaction(ctx context.Context, arg CreateWalletTransactionParams) error {
+	_, err := q.db.Exec(ctx, createWalletTransaction,
+		arg.TransactionID,
+		arg.TransactionType,
+		arg.TransactionStatus,
+		arg.IdempotencyKey,
+		arg.CreatedAt,
+		arg.FinishedAt,
+	)
+	return err
+}
+
+const createWalletTransfer = `-- name: CreateWalletTransfer :exec
+INSERT INTO wallet_transfers(
+	transaction_id,
+	from_wallet_id,
+	to_wallet_id,
+	amount,
+	created_at
+) VALUES($1,$2,$3,$4,$5)
+`
+
+type CreateWalletTransferParams struct {
+	TransactionID string
+	FromWalletID  string
+	ToWalletID    string
+	Amount        decimal.Decimal
+	CreatedAt     time.Time
+}
+
+func (q *Queries) CreateWalletTransfer(ctx context.Context, arg CreateWalletTransferParams) error {
+	_, err := q.db.Exec(ctx, createWalletTransfer,
+		arg.TransactionID,
+		arg.FromWalletID,
+		arg.ToWalletID,
+		arg.Amount,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createWalletUser = `-- name: CreateWalletUser :exec
+INSERT INTO wallet_users(
+	user_id,
+	user_type,
+	user_status,
+	intermediary_wallet_id,
+	chargeback_wallet_id,
+	created_at
+) VALUES($1,$2,$3,$4,$5,$6)
+`
+
+type CreateWalletUserParams struct {
+	UserID               string
+	UserType             string
+	UserStatus           int32
+	IntermediaryWalletID string
+	ChargebackWalletID   string
+	CreatedAt            time.Time
+}
+
+func (q *Queries) CreateWalletUser(ctx context.Context, arg CreateWalletUserParams) error {
+	_, err := q.db.Exec(ctx, createWalletUser,
+		arg.UserID,
+		arg.UserType,
+		arg.UserStatus,
+		arg.IntermediaryWalletID,
+		arg.ChargebackWalletID,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const createWalletWithdrawal = `-- name: CreateWalletWithdrawal :exec
+INSERT INTO wallet_withdrawals(
+	transaction_id,
+	withdrawal_wallet_id,
+	user_wallet_id,
+	user_intermediary_wallet_id,
+	amount,
+	withdrawal_fee,
+	final_amount,
+	withdrawal_status,
+	withdrawal_channel,
+	withdrawal_via_pg,
+	withdrawal_pg_vendor,
+	created_at
+) VALUES($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)
+`
+
+type CreateWalletWithdrawalParams struct {
+	TransactionID            string
+	WithdrawalWalletID       string
+	UserWalletID             string
+	UserIntermediaryWalletID string
+	Amount                   decimal.Decimal
+	WithdrawalFee            decimal.Decimal
+	FinalAmount              decimal.Decimal
+	WithdrawalStatus         int32
+	WithdrawalChannel        int32
+	WithdrawalViaPg          bool
+	WithdrawalPgVendor       sql.NullString
+	CreatedAt                time.Time
+}
+
+func (q *Queries) CreateWalletWithdrawal(ctx context.Context, arg CreateWalletWithdrawalParams) error {
+	_, err := q.db.Exec(ctx, createWalletWithdrawal,
+		arg.TransactionID,
+		arg.WithdrawalWalletID,
+		arg.UserWalletID,
+		arg.UserIntermediaryWalletID,
+		arg.Amount,
+		arg.WithdrawalFee,
+		arg.FinalAmount,
+		arg.WithdrawalStatus,
+		arg.WithdrawalChannel,
+		arg.WithdrawalViaPg,
+		arg.WithdrawalPgVendor,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const getSystemWallet = `-- name: GetSystemWallet :one
+SELECT wallet_id, ledger_account_id, user_id, wallet_status, wallet_owner, wallet_type, created_at, updated_at, currency_id
+FROM wallet_accounts
+WHERE wallet_type = $1
+	AND currency_id = $2
+	AND wallet_owner = 1
+`
+
+type GetSystemWalletParams struct {
+	WalletType int32
+	CurrencyID int32
+}
+
+// GetSystemWallet returns the wallet owned by the system for the given type and currency, for example the deposit wallet.
+func (q *Queries) GetSystemWallet(ctx context.Context, arg GetSystemWalletParams) (WalletAccount, error) {
+	row := q.db.QueryRow(ctx, getSystemWallet, arg.WalletType, arg.CurrencyID)
+	var i WalletAccount
+	err := row.Scan(
+		&i.WalletID,
+		&i.LedgerAccountID,
+		&i.UserID,
+		&i.WalletStatus,
+		&i.WalletOwner,
+		&i.WalletType,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CurrencyID,
+	)
+	return i, err
+}
+
+const getUserWalletByType = `-- name: GetUserWalletByType :one
+SELECT wallet_id, ledger_account_id, user_id, wallet_status, wallet_owner, wallet_type, created_at, updated_at, currency_id
+FROM wallet_accounts
+WHERE user_id = $1
+	AND wallet_type = $2
+`
+
+type GetUserWalletByTypeParams struct {
+	UserID     string
+	WalletType int32
+}
+
+// GetUserWalletByType returns the wallet of the user with the type that only allowed once for each user, which are the
+// intermediary, main and chargeback wallets.
+func (q *Queries) GetUserWalletByType(ctx context.Context, arg GetUserWalletByTypeParams) (WalletAccount, error) {
+	row := q.db.QueryRow(ctx, getUserWalletByType, arg.UserID, arg.WalletType)
+	var i WalletAccount
+	err := row.Scan(
+		&i.WalletID,
+		&i.LedgerAccountID,
+		&i.UserID,
+		&i.WalletStatus,
+		&i.WalletOwner,
+		&i.WalletType,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CurrencyID,
+	)
+	return i, err
+}
+
+const getUserWallets = `-- name: GetUserWallets :many
+SELECT wallet_id, ledger_account_id, user_id, wallet_status, wallet_owner, wallet_type, created_at, updated_at, currency_id
+FROM wallet_accounts
+WHERE user_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) GetUserWallets(ctx context.Context, userID string) ([]WalletAccount, error) {
+	rows, err := q.db.Query(ctx, getUserWallets, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WalletAccount
+	for rows.Next() {
+		var i WalletAccount
+		if err := rows.Scan(
+			&i.WalletID,
+			&i.LedgerAccountID,
+			&i.UserID,
+			&i.WalletStatus,
+			&i.WalletOwner,
+			&i.WalletType,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.CurrencyID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWallet = `-- name: GetWallet :one
+SELECT wallet_id, ledger_account_id, user_id, wallet_status, wallet_owner, wallet_type, created_at, updated_at, currency_id
+FROM wallet_accounts
+WHERE wallet_id = $1
+`
+
+func (q *Queries) GetWallet(ctx context.Context, walletID string) (WalletAccount, error) {
+	row := q.db.QueryRow(ctx, getWallet, walletID)
+	var i WalletAccount
+	err := row.Scan(
+		&i.WalletID,
+		&i.LedgerAccountID,
+		&i.UserID,
+		&i.WalletStatus,
+		&i.WalletOwner,
+		&i.WalletType,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CurrencyID,
+	)
+	return i, err
+}
+
+const getWalletDepositByChannelReference = `-- name: GetWalletDepositByChannelReference :one
+SELECT transaction_id, deposit_wallet_id, user_wallet_id, amount, created_at, updated_at, deposit_status, deposit_channel, channel_reference, channel_name, movement_id, finished_at
+FROM wallet_deposits
+WHERE deposit_channel = $1
+	AND channel_reference = $2
+`
+
+type GetWalletDepositByChannelReferenceParams struct {
+	DepositChannel   int32
+	ChannelReference string
+}
+
+func (q *Queries) GetWalletDepositByChannelReference(ctx context.Context, arg GetWalletDepositByChannelReferenceParams) (WalletDeposit, error) {
+	row := q.db.QueryRow(ctx, getWalletDepositByChannelReference, arg.DepositChannel, arg.ChannelReference)
+	var i WalletDeposit
+	err := row.Scan(
+		&i.TransactionID,
+		&i.DepositWalletID,
+		&i.UserWalletID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DepositStatus,
+		&i.DepositChannel,
+		&i.ChannelReference,
+		&i.ChannelName,
+		&i.MovementID,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const getWalletForShare = `-- name: GetWalletForShare :one
+SELECT wallet_id, ledger_account_id, user_id, wallet_status, wallet_owner, wallet_type, created_at, updated_at, currency_id
+FROM wallet_accounts
+WHERE wallet_id = $1
+FOR SHARE
+`
+
+// GetWalletForShare returns the wallet and locks it from being updated, so the wallet status cannot be changed until the
+// transaction is finished.
+func (q *Queries) GetWalletForShare(ctx context.Context, walletID string) (WalletAccount, error) {
+	row := q.db.QueryRow(ctx, getWalletForShare, walletID)
+	var i WalletAccount
+	err := row.Scan(
+		&i.WalletID,
+		&i.LedgerAccountID,
+		&i.UserID,
+		&i.WalletStatus,
+		&i.WalletOwner,
+		&i.WalletType,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.CurrencyID,
+	)
+	return i, err
+}
+
+const getWalletTransactionByIdempotencyKey = `-- name: GetWalletTransactionByIdempotencyKey :one
+SELECT transaction_id, transaction_type, transaction_status, idempotency_key, created_at, updated_at, finished_at
+FROM wallet_transactions
+WHERE transaction_type = $1
+	AND idempotency_key = $2
+`
+
+type GetWalletTransactionByIdempotencyKeyParams struct {
+	TransactionType int32
+	IdempotencyKey  string
+}
+
+func (q *Queries) GetWalletTransactionByIdempotencyKey(ctx context.Context, arg GetWalletTransactionByIdempotencyKeyParams) (WalletTransaction, error) {
+	row := q.db.QueryRow(ctx, getWalletTransactionByIdempotencyKey, arg.TransactionType, arg.IdempotencyKey)
+	var i WalletTransaction
+	err := row.Scan(
+		&i.TransactionID,
+		&i.TransactionType,
+		&i.TransactionStatus,
+		&i.IdempotencyKey,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const getWalletTransfer = `-- name: GetWalletTransfer :one
+SELECT transaction_id, from_wallet_id, to_wallet_id, amount, created_at
+FROM wallet_transfers
+WHERE transaction_id = $1
+`
+
+func (q *Queries) GetWalletTransfer(ctx context.Context, transactionID string) (WalletTransfer, error) {
+	row := q.db.QueryRow(ctx, getWalletTransfer, transactionID)
+	var i WalletTransfer
+	err := row.Scan(
+		&i.TransactionID,
+		&i.FromWalletID,
+		&i.ToWalletID,
+		&i.Amount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getWalletUser = `-- name: GetWalletUser :one
+SELECT user_id, user_type, user_status, intermediary_wallet_id, chargeback_wallet_id, created_at, updated_at
+FROM wallet_users
+WHERE user_id = $1
+`
+
+func (q *Queries) GetWalletUser(ctx context.Context, userID string) (WalletUser, error) {
+	row := q.db.QueryRow(ctx, getWalletUser, userID)
+	var i WalletUser
+	err := row.Scan(
+		&i.UserID,
+		&i.UserType,
+		&i.UserStatus,
+		&i.IntermediaryWalletID,
+		&i.ChargebackWalletID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getWalletWithdrawal = `-- name: GetWalletWithdrawal :one
+SELECT transaction_id, withdrawal_wallet_id, user_wallet_id, user_intermediary_wallet_id, amount, withdrawal_fee, final_amount, withdrawal_status, withdrawal_channel, withdrawal_via_pg, withdrawal_pg_vendor, created_at, updated_at, finished_at
+FROM wallet_withdrawals
+WHERE transaction_id = $1
+`
+
+func (q *Queries) GetWalletWithdrawal(ctx context.Context, transactionID string) (WalletWithdrawal, error) {
+	row := q.db.QueryRow(ctx, getWalletWithdrawal, transactionID)
+	var i WalletWithdrawal
+	err := row.Scan(
+		&i.TransactionID,
+		&i.WithdrawalWalletID,
+		&i.UserWalletID,
+		&i.UserIntermediaryWalletID,
+		&i.Amount,
+		&i.WithdrawalFee,
+		&i.FinalAmount,
+		&i.WithdrawalStatus,
+		&i.WithdrawalChannel,
+		&i.WithdrawalViaPg,
+		&i.WithdrawalPgVendor,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const getWalletWithdrawalForUpdate = `-- name: GetWalletWithdrawalForUpdate :one
+SELECT transaction_id, withdrawal_wallet_id, user_wallet_id, user_intermediary_wallet_id, amount, withdrawal_fee, final_amount, withdrawal_status, withdrawal_channel, withdrawal_via_pg, withdrawal_pg_vendor, created_at, updated_at, finished_at
+FROM wallet_withdrawals
+WHERE transaction_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetWalletWithdrawalForUpdate(ctx context.Context, transactionID string) (WalletWithdrawal, error) {
+	row := q.db.QueryRow(ctx, getWalletWithdrawalForUpdate, transactionID)
+	var i WalletWithdrawal
+	err := row.Scan(
+		&i.TransactionID,
+		&i.WithdrawalWalletID,
+		&i.UserWalletID,
+		&i.UserIntermediaryWalletID,
+		&i.Amount,
+		&i.WithdrawalFee,
+		&i.FinalAmount,
+		&i.WithdrawalStatus,
+		&i.WithdrawalChannel,
+		&i.WithdrawalViaPg,
+		&i.WithdrawalPgVendor,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FinishedAt,
+	)
+	return i, err
+}
+
+const updateWalletTransactionStatus = `-- name: UpdateWalletTransactionStatus :exec
+UPDATE wallet_transactions
+SET transaction_status = $1,
+	updated_at = $2,
+	finished_at = $3
+WHERE transaction_id = $4
+`
+
+type UpdateWalletTransactionStatusParams struct {
+	TransactionStatus int32
+	UpdatedAt         sql.NullTime
+	FinishedAt        sql.NullTime
+	TransactionID     string
+}
+
+func (q *Queries) UpdateWalletTransactionStatus(ctx context.Context, arg UpdateWalletTransactionStatusParams) error {
+	_, err := q.db.Exec(ctx, updateWalletTransactionStatus,
+		arg.TransactionStatus,
+		arg.UpdatedAt,
+		arg.FinishedAt,
+		arg.TransactionID,
+	)
+	return err
+}
+
+const updateWalletWithdrawalStatus = `-- name: UpdateWalletWithdrawalStatus :exec
+UPDATE wallet_withdrawals
+SET withdrawal_status = $1,
+	updated_at = $2,
+	finished_at = $3
+WHERE transaction_id = $4
+`
+
+type UpdateWalletWithdrawalStatusParams struct {
+	WithdrawalStatus int32
+	UpdatedAt        sql.NullTime
+	FinishedAt       sql.NullTime
+	TransactionID    string
+}
+
+func (q *Queries) UpdateWalletWithdrawalStatus(ctx context.Context, arg UpdateWalletWithdrawalStatusParams) error {
+	_, err := q.db.Exec(ctx, updateWalletWithdrawalStatus,
+		arg.WithdrawalStatus,
+		arg.UpdatedAt,
+		arg.FinishedAt,
+		arg.TransactionID,
 	)
 	return err
 }
@@ -19,6 +19,21 @@ type Account struct {
 	CurrencyID      int32
 	CreatedAt       time.Time
 	UpdatedAt       sql.NullTime
+	AccountStatus   int32
+	BalanceShards   int32
+	IsBalanceShard  bool
+}
+
+type AccountHold struct {
+	HoldID          string
+	AccountID       string
+	ClientReference string
+	Amount          decimal.Decimal
+	Reason          string
+	HoldStatus      int32
+	CreatedAt       time.Time
+	ReleasedAt      sql.NullTime
+	UpdatedAt       sql.NullTime
 }
 
 type AccountsBalance struct {
@@ -31,6 +46,7 @@ type AccountsBalance struct {
 	LastLedgerID    string
 	CreatedAt       time.Time
 	UpdatedAt       sql.NullTime
+	HeldAmount      decimal.Decimal
 }
 
 type AccountsBalanceHistory struct {
@@ -59,6 +75,31 @@ type AccountsLedger struct {
 	ReversalOf       sql.NullString
 }
 
+type Currency struct {
+	CurrencyID   int32
+	CurrencyCode string
+	Exponent     int32
+	RoundingMode int32
+	IsActive     bool
+	CreatedAt    time.Time
+	UpdatedAt    sql.NullTime
+}
+
+type LedgerOutbox struct {
+	EventID       int64
+	TransactionID int64
+	EventType     string
+	AggregateID   string
+	Payload       []byte
+	CreatedAt     time.Time
+}
+
+type LedgerOutboxOffset struct {
+	RelayName   string
+	ResumeToken string
+	UpdatedAt   time.Time
+}
+
 type Movement struct {
 	MovementID         string
 	IdempotencyKey     string
@@ -68,6 +109,39 @@ type Movement struct {
 	ReversalMovementID sql.NullString
 }
 
+type MovementsFx struct {
+	MovementID     string
+	FromAccountID  string
+	ToAccountID    string
+	FromCurrencyID int32
+	ToCurrencyID   int32
+	FromAmount     decimal.Decimal
+	ToAmount       decimal.Decimal
+	Rate           decimal.Decimal
+	CreatedAt      time.Time
+}
+
+type PendingMovement struct {
+	PendingMovementID string
+	IdempotencyKey    string
+	PendingStatus     int32
+	MovementID        sql.NullString
+	ExpiresAt         time.Time
+	CreatedAt         time.Time
+	UpdatedAt         sql.NullTime
+}
+
+type PendingMovementEntry struct {
+	PendingMovementID string
+	MovementSequence  int32
+	FromAccountID     string
+	ToAccountID       string
+	CurrencyID        int32
+	Amount            decimal.Decimal
+	ClientID          sql.NullString
+	CreatedAt         time.Time
+}
+
 type ReversedMovement struct {
 	MovementID         string
 	ReversalMovementID string
@@ -75,6 +149,32 @@ type ReversedMovement struct {
 	CreatedAt          time.Time
 }
 
+type ScheduledMovement struct {
+	ScheduleID     string
+	IdempotencyKey string
+	ScheduleStatus int32
+	Recurrence     sql.NullString
+	OccurrenceAt   time.Time
+	NextRunAt      time.Time
+	EndAt          sql.NullTime
+	RunCount       int32
+	Attempts       int32
+	LastError      sql.NullString
+	LastMovementID sql.NullString
+	CreatedAt      time.Time
+	UpdatedAt      sql.NullTime
+}
+
+type ScheduledMovementEntry struct {
+	ScheduleID       string
+	MovementSequence int32
+	FromAccountID    string
+	ToAccountID      string
+	Amount           decimal.Decimal
+	ClientID         sql.NullString
+	CreatedAt        time.Time
+}
+
 type WalletAccount struct {
 	WalletID        string
 	LedgerAccountID string
@@ -84,6 +184,7 @@ type WalletAccount struct {
 	WalletType      int32
 	CreatedAt       time.Time
 	UpdatedAt       sql.NullTime
+	CurrencyID      int32
 }
 
 type WalletBankWithdrawal struct {
@@ -101,12 +202,18 @@ type WalletChargeback struct {
 }
 
 type WalletDeposit struct {
-	TransactionID   string
-	DepositWalletID string
-	UserWalletID    string
-	Amount          decimal.Decimal
-	CreatedAt       time.Time
-	UpdatedAt       sql.NullTime
+	TransactionID    string
+	DepositWalletID  string
+	UserWalletID     string
+	Amount           decimal.Decimal
+	CreatedAt        time.Time
+	UpdatedAt        sql.NullTime
+	DepositStatus    int32
+	DepositChannel   int32
+	ChannelReference string
+	ChannelName      string
+	MovementID       sql.NullString
+	FinishedAt       sql.NullTime
 }
 
 type WalletEwalletWithdrawal struct {
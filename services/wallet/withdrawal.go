@@ -0,0 +1,21 @@
+package wallet
+
+import (
+	"context"
+
+	"github.com/studio-asd/go-example/internal/currency"
+	walletv1 "github.com/studio-asd/go-example/proto/api/wallet/v1"
+)
+
+// WithdrawalFeeCalculator calculates the fee of the withdrawal. The fee is deducted from the withdrawal amount, so the money sent to
+// the user is the amount minus the fee. The fee must be in the currency of the amount.
+type WithdrawalFeeCalculator interface {
+	WithdrawalFee(ctx context.Context, channel walletv1.WithdrawalChannel, amount currency.Money) (currency.Money, error)
+}
+
+// WithdrawalFeeFunc is an adapter to use an ordinary function as the WithdrawalFeeCalculator.
+type WithdrawalFeeFunc func(ctx context.Context, channel walletv1.WithdrawalChannel, amount currency.Money) (currency.Money, error)
+
+func (f WithdrawalFeeFunc) WithdrawalFee(ctx context.Context, channel walletv1.WithdrawalChannel, amount currency.Money) (currency.Money, error) {
+	return f(ctx, channel, amount)
+}